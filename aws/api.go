@@ -107,6 +107,25 @@ type UserPolicies struct {
 	ByGroup  map[string][]string
 }
 
+// roleIdsByArn lists the roles once per sync, for the functions to be linked
+// to their execution role, given by its ARN whereas roles are identified by their id
+func (s *Access) roleIdsByArn() (map[string]string, error) {
+	s.once.Do(func() {
+		ids := make(map[string]string)
+		s.once.err = s.ListRolesPages(&iam.ListRolesInput{}, func(out *iam.ListRolesOutput, lastPage bool) bool {
+			for _, role := range out.Roles {
+				ids[awssdk.StringValue(role.Arn)] = awssdk.StringValue(role.RoleId)
+			}
+			return out.Marker != nil
+		})
+		s.once.result = ids
+	})
+	if s.once.err != nil {
+		return nil, s.once.err
+	}
+	return s.once.result.(map[string]string), nil
+}
+
 func (s *Access) GetUserPolicies(username string) (*UserPolicies, error) {
 	var wg sync.WaitGroup
 
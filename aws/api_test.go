@@ -18,6 +18,7 @@ package aws
 
 import (
 	"fmt"
+	"net"
 	"reflect"
 	"sort"
 	"strings"
@@ -25,9 +26,12 @@ import (
 	"time"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/s3"
 	p "github.com/wallix/awless/cloud/properties"
@@ -244,7 +248,42 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 	}
 
 	routeTables := []*ec2.RouteTable{
-		{RouteTableId: awssdk.String("rt_1"), VpcId: awssdk.String("vpc_1"), Associations: []*ec2.RouteTableAssociation{{RouteTableId: awssdk.String("rt_1"), SubnetId: awssdk.String("sub_1")}}},
+		{RouteTableId: awssdk.String("rt_1"), VpcId: awssdk.String("vpc_1"), Associations: []*ec2.RouteTableAssociation{{RouteTableId: awssdk.String("rt_1"), SubnetId: awssdk.String("sub_1")}}, Routes: []*ec2.Route{
+			{DestinationCidrBlock: awssdk.String("0.0.0.0/0"), NatGatewayId: awssdk.String("nat_1")},
+			{DestinationCidrBlock: awssdk.String("10.1.0.0/16"), NetworkInterfaceId: awssdk.String("eni_2")},
+		}},
+	}
+
+	networkInterfaces := []*ec2.NetworkInterface{
+		{NetworkInterfaceId: awssdk.String("eni_1"), SubnetId: awssdk.String("sub_1"), VpcId: awssdk.String("vpc_1"), Groups: []*ec2.GroupIdentifier{{GroupId: awssdk.String("secgroup_1")}}, Attachment: &ec2.NetworkInterfaceAttachment{AttachmentId: awssdk.String("attach_1"), InstanceId: awssdk.String("inst_1")}},
+		{NetworkInterfaceId: awssdk.String("eni_2"), SubnetId: awssdk.String("sub_2"), VpcId: awssdk.String("vpc_1")},
+	}
+
+	elasticIPs := []*ec2.Address{
+		{AllocationId: awssdk.String("eip_1"), PublicIp: awssdk.String("1.2.3.4"), Domain: awssdk.String("vpc"), InstanceId: awssdk.String("inst_1"), NetworkInterfaceId: awssdk.String("eni_1"), AssociationId: awssdk.String("assoc_1")},
+		{AllocationId: awssdk.String("eip_2"), PublicIp: awssdk.String("2.3.4.5"), Domain: awssdk.String("vpc"), NetworkInterfaceId: awssdk.String("eni_2")},
+	}
+
+	natGateways := []*ec2.NatGateway{
+		{NatGatewayId: awssdk.String("nat_1"), SubnetId: awssdk.String("sub_2"), VpcId: awssdk.String("vpc_1"), State: awssdk.String("available"), NatGatewayAddresses: []*ec2.NatGatewayAddress{{AllocationId: awssdk.String("eip_2"), NetworkInterfaceId: awssdk.String("eni_2"), PublicIp: awssdk.String("2.3.4.5")}}},
+	}
+
+	volumes := []*ec2.Volume{
+		{VolumeId: awssdk.String("vol_1"), AvailabilityZone: awssdk.String("eu-west-1a"), Attachments: []*ec2.VolumeAttachment{{VolumeId: awssdk.String("vol_1"), InstanceId: awssdk.String("inst_1")}}},
+		{VolumeId: awssdk.String("vol_2"), AvailabilityZone: awssdk.String("eu-west-1a"), SnapshotId: awssdk.String("snap_1")},
+	}
+
+	snapshots := []*ec2.Snapshot{
+		{SnapshotId: awssdk.String("snap_1"), VolumeId: awssdk.String("vol_1"), State: awssdk.String("completed"), VolumeSize: awssdk.Int64(8)},
+		{SnapshotId: awssdk.String("snap_2"), VolumeId: awssdk.String("vol_1"), State: awssdk.String("completed"), VolumeSize: awssdk.Int64(8)},
+	}
+
+	images := []*ec2.Image{
+		{ImageId: awssdk.String("ami_1"), Name: awssdk.String("web"), State: awssdk.String("available"), CreationDate: awssdk.String("2017-03-01T10:15:30.000Z"), RootDeviceName: awssdk.String("/dev/sda1"), BlockDeviceMappings: []*ec2.BlockDeviceMapping{
+			{DeviceName: awssdk.String("/dev/sdb"), Ebs: &ec2.EbsBlockDevice{SnapshotId: awssdk.String("snap_2")}},
+			{DeviceName: awssdk.String("/dev/sda1"), Ebs: &ec2.EbsBlockDevice{SnapshotId: awssdk.String("snap_1")}},
+		}},
+		{ImageId: awssdk.String("ami_2"), Name: awssdk.String("instance-store"), RootDeviceName: awssdk.String("/dev/sda1"), BlockDeviceMappings: []*ec2.BlockDeviceMapping{{DeviceName: awssdk.String("/dev/sdb"), VirtualName: awssdk.String("ephemeral0")}}},
 	}
 
 	//ELB
@@ -271,16 +310,28 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 		"tg_2": {{Target: &elbv2.TargetDescription{Id: awssdk.String("inst_2"), Port: awssdk.Int64(80)}}, {Target: &elbv2.TargetDescription{Id: awssdk.String("inst_3"), Port: awssdk.Int64(80)}}},
 	}
 
-	mock := &mockEc2{vpcs: vpcs, securityGroups: securityGroups, subnets: subnets, instances: instances, keyPairs: keypairs, internetGateways: igws, routeTables: routeTables}
+	//Autoscaling
+	launchConfigs := []*autoscaling.LaunchConfiguration{
+		{LaunchConfigurationName: awssdk.String("launchconfig_1"), ImageId: awssdk.String("ami-1234")},
+	}
+	scalingGroups := []*autoscaling.Group{
+		{AutoScalingGroupName: awssdk.String("scalinggroup_1"), LaunchConfigurationName: awssdk.String("launchconfig_1"), VPCZoneIdentifier: awssdk.String("sub_1,sub_2"), TargetGroupARNs: []*string{awssdk.String("tg_1")}, Instances: []*autoscaling.Instance{{InstanceId: awssdk.String("inst_1")}}},
+	}
+	scalingPolicies := []*autoscaling.ScalingPolicy{
+		{PolicyARN: awssdk.String("scalingpolicy_1"), PolicyName: awssdk.String("scale_up"), AutoScalingGroupName: awssdk.String("scalinggroup_1"), ScalingAdjustment: awssdk.Int64(2)},
+	}
+
+	mock := &mockEc2{vpcs: vpcs, securityGroups: securityGroups, subnets: subnets, instances: instances, keyPairs: keypairs, internetGateways: igws, routeTables: routeTables, elasticIPs: elasticIPs, natGateways: natGateways, networkInterfaces: networkInterfaces, volumes: volumes, snapshots: snapshots, images: images}
 	mockLb := &mockELB{loadBalancerPages: lbPages, targetGroups: targetGroups, listeners: listeners, targetHealths: targetHealths}
-	infra := Infra{EC2API: mock, ELBV2API: mockLb, RDSAPI: &mockRDS{}, region: "eu-west-1"}
+	mockAutoScaling := &mockAutoScaling{launchConfigs: launchConfigs, groups: scalingGroups, policies: scalingPolicies}
+	infra := Infra{EC2API: mock, ELBV2API: mockLb, RDSAPI: &mockRDS{}, AutoScalingAPI: mockAutoScaling, region: "eu-west-1"}
 	InfraService = &infra
 
 	g, err := infra.FetchResources()
 	if err != nil {
 		t.Fatal(err)
 	}
-	resources, err := g.GetAllResources("region", "instance", "vpc", "securitygroup", "subnet", "keypair", "internetgateway", "routetable", "loadbalancer", "targetgroup", "listener")
+	resources, err := g.GetAllResources("region", "instance", "vpc", "securitygroup", "subnet", "keypair", "internetgateway", "routetable", "loadbalancer", "targetgroup", "listener", "volume", "snapshot", "image", "elasticip", "natgateway", "networkinterface", "launchconfiguration", "scalinggroup", "scalingpolicy")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -293,6 +344,12 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 		if p, ok := res.Properties[p.Vpcs].([]string); ok {
 			sort.Strings(p)
 		}
+		if p, ok := res.Properties[p.Subnets].([]string); ok {
+			sort.Strings(p)
+		}
+		if routes, ok := res.Properties[p.Routes].([]*graph.Route); ok {
+			sort.Slice(routes, func(i, j int) bool { return routes[i].Destination.String() < routes[j].Destination.String() })
+		}
 	}
 
 	expected := map[string]*graph.Resource{
@@ -312,41 +369,69 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 		"sub_4":       resourcetest.Subnet("sub_4").Build(),
 		"my_key_pair": resourcetest.Keypair("my_key_pair").Build(),
 		"igw_1":       resourcetest.InternetGw("igw_1").Prop(p.Vpcs, []string{"vpc_2"}).Build(),
-		"rt_1":        resourcetest.RouteTable("rt_1").Prop(p.Vpc, "vpc_1").Prop(p.Main, false).Build(),
-		"lb_1":        resourcetest.LoadBalancer("lb_1").Prop(p.Name, "my_loadbalancer").Prop(p.Vpc, "vpc_1").Build(),
-		"lb_2":        resourcetest.LoadBalancer("lb_2").Prop(p.Vpc, "vpc_2").Build(),
-		"lb_3":        resourcetest.LoadBalancer("lb_3").Prop(p.Vpc, "vpc_1").Build(),
-		"tg_1":        resourcetest.TargetGroup("tg_1").Prop(p.Vpc, "vpc_1").Build(),
-		"tg_2":        resourcetest.TargetGroup("tg_2").Prop(p.Vpc, "vpc_2").Build(),
-		"list_1":      resourcetest.Listener("list_1").Prop(p.LoadBalancer, "lb_1").Build(),
-		"list_1.2":    resourcetest.Listener("list_1.2").Prop(p.LoadBalancer, "lb_1").Build(),
-		"list_2":      resourcetest.Listener("list_2").Prop(p.LoadBalancer, "lb_2").Build(),
-		"list_3":      resourcetest.Listener("list_3").Prop(p.LoadBalancer, "lb_3").Build(),
+		"rt_1": resourcetest.RouteTable("rt_1").Prop(p.Vpc, "vpc_1").Prop(p.Main, false).Prop(p.Routes, []*graph.Route{
+			{Destination: mustParseCIDR("0.0.0.0/0"), Targets: []*graph.RouteTarget{{Type: graph.NatTarget, Ref: "nat_1"}}},
+			{Destination: mustParseCIDR("10.1.0.0/16"), Targets: []*graph.RouteTarget{{Type: graph.NetworkInterfaceTarget, Ref: "eni_2"}}},
+		}).Build(),
+		"vol_1":           resourcetest.Volume("vol_1").Prop(p.AvailabilityZone, "eu-west-1a").Build(),
+		"vol_2":           resourcetest.Volume("vol_2").Prop(p.AvailabilityZone, "eu-west-1a").Build(),
+		"snap_1":          resourcetest.Snapshot("snap_1").Prop(p.Volume, "vol_1").Prop(p.State, "completed").Prop(p.Size, 8).Build(),
+		"snap_2":          resourcetest.Snapshot("snap_2").Prop(p.Volume, "vol_1").Prop(p.State, "completed").Prop(p.Size, 8).Build(),
+		"ami_1":           resourcetest.Image("ami_1").Prop(p.Name, "web").Prop(p.State, "available").Prop(p.Created, time.Date(2017, 3, 1, 10, 15, 30, 0, time.UTC)).Prop(p.RootDevice, "/dev/sda1").Build(),
+		"ami_2":           resourcetest.Image("ami_2").Prop(p.Name, "instance-store").Prop(p.RootDevice, "/dev/sda1").Build(),
+		"eni_1":           resourcetest.NetworkInterface("eni_1").Prop(p.Subnet, "sub_1").Prop(p.Vpc, "vpc_1").Prop(p.SecurityGroups, []string{"secgroup_1"}).Prop(p.Instance, "inst_1").Prop(p.Attachment, "attach_1").Build(),
+		"eni_2":           resourcetest.NetworkInterface("eni_2").Prop(p.Subnet, "sub_2").Prop(p.Vpc, "vpc_1").Build(),
+		"eip_1":           resourcetest.ElasticIP("eip_1").Prop(p.PublicIP, "1.2.3.4").Prop(p.Domain, "vpc").Prop(p.Instance, "inst_1").Prop(p.NetworkInterface, "eni_1").Prop(p.Association, "assoc_1").Build(),
+		"eip_2":           resourcetest.ElasticIP("eip_2").Prop(p.PublicIP, "2.3.4.5").Prop(p.Domain, "vpc").Prop(p.NetworkInterface, "eni_2").Build(),
+		"nat_1":           resourcetest.NatGateway("nat_1").Prop(p.Subnet, "sub_2").Prop(p.Vpc, "vpc_1").Prop(p.State, "available").Prop(p.PublicIP, "2.3.4.5").Prop(p.NetworkInterface, "eni_2").Build(),
+		"lb_1":            resourcetest.LoadBalancer("lb_1").Prop(p.Name, "my_loadbalancer").Prop(p.Vpc, "vpc_1").Build(),
+		"lb_2":            resourcetest.LoadBalancer("lb_2").Prop(p.Vpc, "vpc_2").Build(),
+		"lb_3":            resourcetest.LoadBalancer("lb_3").Prop(p.Vpc, "vpc_1").Build(),
+		"tg_1":            resourcetest.TargetGroup("tg_1").Prop(p.Vpc, "vpc_1").Build(),
+		"tg_2":            resourcetest.TargetGroup("tg_2").Prop(p.Vpc, "vpc_2").Build(),
+		"list_1":          resourcetest.Listener("list_1").Prop(p.LoadBalancer, "lb_1").Build(),
+		"list_1.2":        resourcetest.Listener("list_1.2").Prop(p.LoadBalancer, "lb_1").Build(),
+		"list_2":          resourcetest.Listener("list_2").Prop(p.LoadBalancer, "lb_2").Build(),
+		"list_3":          resourcetest.Listener("list_3").Prop(p.LoadBalancer, "lb_3").Build(),
+		"launchconfig_1":  resourcetest.LaunchConfiguration("launchconfig_1").Prop(p.Name, "launchconfig_1").Prop(p.Image, "ami-1234").Build(),
+		"scalinggroup_1":  resourcetest.ScalingGroup("scalinggroup_1").Prop(p.Name, "scalinggroup_1").Prop(p.LaunchConfigurationName, "launchconfig_1").Prop(p.Subnets, []string{"sub_1", "sub_2"}).Build(),
+		"scalingpolicy_1": resourcetest.ScalingPolicy("scalingpolicy_1").Prop(p.Name, "scale_up").Prop(p.Arn, "scalingpolicy_1").Prop(p.ScalingGroupName, "scalinggroup_1").Prop(p.ScalingAdjustment, 2).Build(),
 	}
 
 	expectedChildren := map[string][]string{
-		"eu-west-1": {"igw_1", "my_key_pair", "vpc_1", "vpc_2"},
-		"lb_1":      {"list_1", "list_1.2"},
-		"lb_2":      {"list_2"},
-		"lb_3":      {"list_3"},
-		"sub_1":     {"inst_1"},
-		"sub_2":     {"inst_2"},
-		"sub_3":     {"inst_3", "inst_4"},
-		"vpc_1":     {"lb_1", "lb_3", "rt_1", "secgroup_1", "secgroup_2", "sub_1", "sub_2", "tg_1"},
-		"vpc_2":     {"lb_2", "sub_3", "tg_2"},
+		"eu-west-1":      {"ami_2", "eip_1", "eip_2", "igw_1", "launchconfig_1", "my_key_pair", "vpc_1", "vpc_2"},
+		"lb_1":           {"list_1", "list_1.2"},
+		"lb_2":           {"list_2"},
+		"lb_3":           {"list_3"},
+		"scalinggroup_1": {"scalingpolicy_1"},
+		"sub_1":          {"eni_1", "inst_1", "scalinggroup_1"},
+		"sub_2":          {"eni_2", "inst_2", "nat_1", "scalinggroup_1"},
+		"snap_1":         {"ami_1"},
+		"sub_3":          {"inst_3", "inst_4"},
+		"vol_1":          {"snap_1", "snap_2"},
+		"vpc_1":          {"lb_1", "lb_3", "rt_1", "secgroup_1", "secgroup_2", "sub_1", "sub_2", "tg_1"},
+		"vpc_2":          {"lb_2", "sub_3", "tg_2"},
 	}
 
 	expectedAppliedOn := map[string][]string{
-		"igw_1":       {"vpc_2"},
-		"lb_1":        {"tg_1"},
-		"lb_2":        {"tg_2"},
-		"lb_3":        {"tg_1"},
-		"my_key_pair": {"inst_4"},
-		"rt_1":        {"sub_1"},
-		"secgroup_1":  {"inst_2", "inst_4", "lb_3"},
-		"secgroup_2":  {"inst_4", "lb_3"},
-		"tg_1":        {"inst_1"},
-		"tg_2":        {"inst_2", "inst_3"},
+		"ami_1":          {"snap_2"},
+		"eip_1":          {"eni_1"},
+		"eip_2":          {"eni_2"},
+		"eni_1":          {"inst_1"},
+		"igw_1":          {"vpc_2"},
+		"lb_1":           {"tg_1"},
+		"lb_2":           {"tg_2"},
+		"lb_3":           {"tg_1"},
+		"launchconfig_1": {"scalinggroup_1"},
+		"my_key_pair":    {"inst_4"},
+		"rt_1":           {"eni_2", "nat_1", "sub_1"},
+		"scalinggroup_1": {"inst_1"},
+		"secgroup_1":     {"eni_1", "inst_2", "inst_4", "lb_3"},
+		"secgroup_2":     {"inst_4", "lb_3"},
+		"tg_1":           {"inst_1", "scalinggroup_1"},
+		"tg_2":           {"inst_2", "inst_3"},
+		"vol_1":          {"inst_1"},
+		"vol_2":          {"snap_1"},
 	}
 
 	compareResources(t, g, resources, expected, expectedChildren, expectedAppliedOn)
@@ -488,6 +573,126 @@ func TestBuildDnsRdfGraph(t *testing.T) {
 	compareResources(t, g, resources, expected, expectedChildren, expectedAppliedOn)
 }
 
+func TestBuildLambdaRdfGraph(t *testing.T) {
+	functionPages := [][]*lambda.FunctionConfiguration{
+		{
+			{FunctionArn: awssdk.String("arn:aws:lambda:eu-west-1:123456789012:function:func_1"), FunctionName: awssdk.String("func_1"), Runtime: awssdk.String("python2.7"), MemorySize: awssdk.Int64(128), Timeout: awssdk.Int64(3), CodeSize: awssdk.Int64(1024), LastModified: awssdk.String("2017-03-01T10:15:30.000+0000"), Role: awssdk.String("arn:aws:iam::123456789012:role/service-role/role_1")},
+		},
+		{
+			{FunctionArn: awssdk.String("arn:aws:lambda:eu-west-1:123456789012:function:func_2"), FunctionName: awssdk.String("func_2"), Runtime: awssdk.String("nodejs4.3"), VpcConfig: &lambda.VpcConfigResponse{
+				VpcId:            awssdk.String("vpc_1"),
+				SubnetIds:        []*string{awssdk.String("sub_1"), awssdk.String("sub_2")},
+				SecurityGroupIds: []*string{awssdk.String("secgroup_1")},
+			}},
+			{FunctionArn: awssdk.String("arn:aws:lambda:eu-west-1:123456789012:function:func_3"), FunctionName: awssdk.String("func_3"), Role: awssdk.String("arn:aws:iam::123456789012:role/role_unknown")},
+		},
+	}
+	iamMock := &mockIam{roles: []*iam.RoleDetail{{RoleId: awssdk.String("role_1"), RoleName: awssdk.String("role_1"), Arn: awssdk.String("arn:aws:iam::123456789012:role/service-role/role_1")}}}
+	AccessService = &Access{IAMAPI: iamMock}
+
+	lambdaService := Lambda{LambdaAPI: &mockLambda{functionPages: functionPages}, region: "eu-west-1"}
+
+	g, err := lambdaService.FetchResources()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resources, err := g.GetAllResources("region", "function")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Sort slice properties in resources
+	for _, res := range resources {
+		if subnets, ok := res.Properties[p.Subnets].([]string); ok {
+			sort.Strings(subnets)
+		}
+	}
+
+	expected := map[string]*graph.Resource{
+		"eu-west-1": resourcetest.Region("eu-west-1").Build(),
+		"arn:aws:lambda:eu-west-1:123456789012:function:func_1": resourcetest.Function("arn:aws:lambda:eu-west-1:123456789012:function:func_1").Prop(p.Name, "func_1").Prop(p.Arn, "arn:aws:lambda:eu-west-1:123456789012:function:func_1").Prop(p.Runtime, "python2.7").
+			Prop(p.Memory, 128).Prop(p.Timeout, 3).Prop(p.CodeSize, 1024).Prop(p.Modified, time.Date(2017, 3, 1, 10, 15, 30, 0, time.UTC)).Prop(p.Role, "arn:aws:iam::123456789012:role/service-role/role_1").Build(),
+		"arn:aws:lambda:eu-west-1:123456789012:function:func_2": resourcetest.Function("arn:aws:lambda:eu-west-1:123456789012:function:func_2").Prop(p.Name, "func_2").Prop(p.Arn, "arn:aws:lambda:eu-west-1:123456789012:function:func_2").Prop(p.Runtime, "nodejs4.3").
+			Prop(p.Vpc, "vpc_1").Prop(p.Subnets, []string{"sub_1", "sub_2"}).Prop(p.SecurityGroups, []string{"secgroup_1"}).Build(),
+		"arn:aws:lambda:eu-west-1:123456789012:function:func_3": resourcetest.Function("arn:aws:lambda:eu-west-1:123456789012:function:func_3").Prop(p.Name, "func_3").Prop(p.Arn, "arn:aws:lambda:eu-west-1:123456789012:function:func_3").Prop(p.Role, "arn:aws:iam::123456789012:role/role_unknown").Build(),
+	}
+	expectedChildren := map[string][]string{
+		"eu-west-1": {"arn:aws:lambda:eu-west-1:123456789012:function:func_1", "arn:aws:lambda:eu-west-1:123456789012:function:func_3"},
+	}
+	expectedAppliedOn := map[string][]string{}
+
+	compareResources(t, g, resources, expected, expectedChildren, expectedAppliedOn)
+
+	func1 := expected["arn:aws:lambda:eu-west-1:123456789012:function:func_1"]
+	if got, want := mustGetDependingOnId(g, func1), []string{"role_1"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	func2 := expected["arn:aws:lambda:eu-west-1:123456789012:function:func_2"]
+	if got, want := mustGetDependingOnId(g, func2), []string{"secgroup_1"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	func3 := expected["arn:aws:lambda:eu-west-1:123456789012:function:func_3"]
+	if got := mustGetDependingOnId(g, func3); len(got) != 0 {
+		t.Fatalf("got %v, want none", got)
+	}
+	if got, want := iamMock.listRolesCalls, 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+
+	AccessService = nil
+	if _, err = lambdaService.FetchResources(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBuildMonitoringRdfGraph(t *testing.T) {
+	alarmPages := [][]*cloudwatch.MetricAlarm{
+		{
+			{AlarmName: awssdk.String("alarm_1"), AlarmArn: awssdk.String("arn:aws:cloudwatch:eu-west-1:123456789012:alarm:alarm_1"), StateValue: awssdk.String("OK"), Namespace: awssdk.String("AWS/EC2"), MetricName: awssdk.String("CPUUtilization"), Statistic: awssdk.String("Average"), Threshold: awssdk.Float64(75.5), ActionsEnabled: awssdk.Bool(true),
+				Dimensions: []*cloudwatch.Dimension{{Name: awssdk.String("InstanceId"), Value: awssdk.String("inst_1")}}, AlarmActions: []*string{awssdk.String("arn:aws:sns:eu-west-1:123456789012:topic_1")}},
+		},
+		{
+			{AlarmName: awssdk.String("alarm_2"), AlarmArn: awssdk.String("arn:aws:cloudwatch:eu-west-1:123456789012:alarm:alarm_2"), StateValue: awssdk.String("ALARM"), Threshold: awssdk.Float64(10), ActionsEnabled: awssdk.Bool(false),
+				Dimensions: []*cloudwatch.Dimension{{Name: awssdk.String("LoadBalancer"), Value: awssdk.String("app/lb_1/50dc6c495c0c9188")}, {Name: awssdk.String("DBInstanceIdentifier"), Value: awssdk.String("db_1")}}},
+		},
+	}
+
+	monitoring := Monitoring{CloudWatchAPI: &mockCloudWatch{alarmPages: alarmPages}, region: "eu-west-1"}
+
+	g, err := monitoring.FetchResources()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resources, err := g.GetAllResources("region", "alarm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Sort slice properties in resources
+	for _, res := range resources {
+		if dimensions, ok := res.Properties[p.Dimensions].([]string); ok {
+			sort.Strings(dimensions)
+		}
+	}
+
+	expected := map[string]*graph.Resource{
+		"eu-west-1": resourcetest.Region("eu-west-1").Build(),
+		"alarm_1": resourcetest.Alarm("alarm_1").Prop(p.Name, "alarm_1").Prop(p.Arn, "arn:aws:cloudwatch:eu-west-1:123456789012:alarm:alarm_1").Prop(p.State, "OK").Prop(p.Namespace, "AWS/EC2").Prop(p.Metric, "CPUUtilization").
+			Prop(p.Statistic, "Average").Prop(p.Threshold, "75.5").Prop(p.ActionsEnabled, true).Prop(p.Dimensions, []string{"InstanceId:inst_1"}).Prop(p.Actions, []string{"arn:aws:sns:eu-west-1:123456789012:topic_1"}).Build(),
+		"alarm_2": resourcetest.Alarm("alarm_2").Prop(p.Name, "alarm_2").Prop(p.Arn, "arn:aws:cloudwatch:eu-west-1:123456789012:alarm:alarm_2").Prop(p.State, "ALARM").Prop(p.Threshold, "10").Prop(p.ActionsEnabled, false).
+			Prop(p.Dimensions, []string{"DBInstanceIdentifier:db_1", "LoadBalancer:app/lb_1/50dc6c495c0c9188"}).Build(),
+	}
+	expectedChildren := map[string][]string{
+		"eu-west-1": {"alarm_1", "alarm_2"},
+	}
+	expectedAppliedOn := map[string][]string{
+		"alarm_1": {"inst_1"},
+		"alarm_2": {"arn:aws:elasticloadbalancing:eu-west-1:123456789012:loadbalancer/app/lb_1/50dc6c495c0c9188", "db_1"},
+	}
+
+	compareResources(t, g, resources, expected, expectedChildren, expectedAppliedOn)
+}
+
 func TestBuildEmptyRdfGraphWhenNoData(t *testing.T) {
 
 	expectG := graph.NewGraph()
@@ -505,7 +710,7 @@ func TestBuildEmptyRdfGraphWhenNoData(t *testing.T) {
 		t.Fatalf("got [%s]\nwant [%s]", result, expectG.MustMarshal())
 	}
 
-	infra := Infra{EC2API: &mockEc2{}, ELBV2API: &mockELB{}, RDSAPI: &mockRDS{}, region: "eu-west-1"}
+	infra := Infra{EC2API: &mockEc2{}, ELBV2API: &mockELB{}, RDSAPI: &mockRDS{}, AutoScalingAPI: &mockAutoScaling{}, region: "eu-west-1"}
 
 	g, err = infra.FetchResources()
 	if err != nil {
@@ -562,6 +767,26 @@ func mustGetAppliedOnId(g *graph.Graph, res *graph.Resource) []string {
 	return ids
 }
 
+func mustGetDependingOnId(g *graph.Graph, res *graph.Resource) []string {
+	resources, err := g.ListResourcesDependingOn(res)
+	if err != nil {
+		panic(err)
+	}
+	var ids []string
+	for _, r := range resources {
+		ids = append(ids, r.Id())
+	}
+	return ids
+}
+
+func mustParseCIDR(s string) *net.IPNet {
+	_, ipnet, err := net.ParseCIDR(s)
+	if err != nil {
+		panic(err)
+	}
+	return ipnet
+}
+
 func compareResources(t *testing.T, g *graph.Graph, resources []*graph.Resource, expected map[string]*graph.Resource, expectedChildren, expectedAppliedOn map[string][]string) {
 	if got, want := len(resources), len(expected); got != want {
 		t.Fatalf("got %d, want %d", got, want)
@@ -0,0 +1,219 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/driver"
+)
+
+const (
+	// NotFoundState is the state to check for a resource to be deleted
+	NotFoundState = "not-found"
+	// ExistsState is the state to check for a resource to exist, whatever its actual state
+	ExistsState = "exists"
+
+	defaultCheckInterval = 5 * time.Second
+	maxCheckInterval     = time.Minute
+)
+
+// CheckDriver provides the 'check' action on all the given resource types.
+// It waits for a resource to reach a state by fetching it by type and id,
+// retrying with exponential backoff until the timeout expires or the
+// context is cancelled.
+type CheckDriver struct {
+	dryRun bool
+	logger *logger.Logger
+	fetch  func(string, string) (*graph.Graph, error)
+	types  []string
+}
+
+func (d *CheckDriver) SetDryRun(dry bool)         { d.dryRun = dry }
+func (d *CheckDriver) SetLogger(l *logger.Logger) { d.logger = l }
+func NewCheckDriver(fetch func(t, id string) (*graph.Graph, error), types ...string) driver.Driver {
+	return &CheckDriver{false, logger.DiscardLogger, fetch, types}
+}
+
+func (d *CheckDriver) Lookup(lookups ...string) (driver.DriverFn, error) {
+	if len(lookups) != 2 || lookups[0] != "check" {
+		return nil, driver.ErrDriverFnNotFound
+	}
+	entity := lookups[1]
+	for _, t := range d.types {
+		if t == entity {
+			if d.dryRun {
+				return func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+					return d.Check_DryRun(ctx, entity, params)
+				}, nil
+			}
+			return func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+				return d.Check(ctx, entity, params)
+			}, nil
+		}
+	}
+	return nil, driver.ErrDriverFnNotFound
+}
+
+func (d *CheckDriver) Check_DryRun(ctx context.Context, entity string, params map[string]interface{}) (interface{}, error) {
+	if _, _, err := checkParams(entity, params); err != nil {
+		return nil, err
+	}
+
+	// the resource may not exist yet, only the permission to describe it is checked
+	if _, err := d.fetchByID(ctx, entity, fmt.Sprint(params["id"])); err != nil && !isNotFoundErr(err) && !isMalformedErr(err) {
+		return nil, fmt.Errorf("dry run: check %s: %s", entity, err)
+	}
+
+	d.logger.Verbosef("dry run: check %s ok", entity)
+	return fakeDryRunId(entity), nil
+}
+
+func (d *CheckDriver) Check(ctx context.Context, entity string, params map[string]interface{}) (interface{}, error) {
+	timeout, interval, err := checkParams(entity, params)
+	if err != nil {
+		return nil, err
+	}
+	id, expected := fmt.Sprint(params["id"]), strings.ToLower(fmt.Sprint(params["state"]))
+
+	deadline := time.Now().Add(timeout)
+	for {
+		current, err := d.currentState(ctx, entity, id)
+		if err != nil {
+			return nil, fmt.Errorf("check %s: %s", entity, err)
+		}
+		if current == expected || (expected == ExistsState && current != NotFoundState) {
+			d.logger.Verbosef("check %s state '%s' done", entity, expected)
+			return nil, nil
+		}
+
+		remaining := deadline.Sub(time.Now())
+		if remaining <= 0 {
+			return nil, fmt.Errorf("check %s: timeout of %s expired (state '%s', expect '%s')", entity, timeout, current, expected)
+		}
+		if interval > remaining {
+			interval = remaining
+		}
+		d.logger.Infof("%s %s state '%s', expect '%s', retry in %s (timeout %s).", entity, id, current, expected, interval, timeout)
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return nil, fmt.Errorf("check %s: %s (state '%s', expect '%s')", entity, ctx.Err(), current, expected)
+		}
+
+		if interval *= 2; interval > maxCheckInterval {
+			interval = maxCheckInterval
+		}
+	}
+}
+
+func (d *CheckDriver) currentState(ctx context.Context, entity, id string) (string, error) {
+	g, err := d.fetchByID(ctx, entity, id)
+	if isNotFoundErr(err) {
+		return NotFoundState, nil
+	}
+	if err != nil {
+		return "", err
+	}
+	resources, err := g.GetAllResources(entity)
+	if err != nil {
+		return "", err
+	}
+	for _, res := range resources {
+		if res.Id() != id {
+			continue
+		}
+		if state, ok := res.Properties[properties.State]; ok {
+			return strings.ToLower(fmt.Sprint(state)), nil
+		}
+		return ExistsState, nil
+	}
+	return NotFoundState, nil
+}
+
+// fetchByID fetches the resource, returning as soon as the context is cancelled
+func (d *CheckDriver) fetchByID(ctx context.Context, entity, id string) (*graph.Graph, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	type fetched struct {
+		g   *graph.Graph
+		err error
+	}
+	done := make(chan fetched, 1)
+	go func() {
+		g, err := d.fetch(entity, id)
+		done <- fetched{g, err}
+	}()
+	select {
+	case f := <-done:
+		return f.g, f.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// isNotFoundErr returns true when describing a resource by id failed as it
+// does not exist (ex: InvalidInstanceID.NotFound, DBSubnetGroupNotFoundFault)
+func isNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		code := awsErr.Code()
+		return strings.HasSuffix(code, notFound) || strings.HasSuffix(code, notFound+"Fault")
+	}
+	return false
+}
+
+// isMalformedErr returns true when describing a resource failed on a malformed
+// id, as the fake ids of dry runs (ex: InvalidInstanceID.Malformed)
+func isMalformedErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return strings.HasSuffix(awsErr.Code(), ".Malformed")
+	}
+	return false
+}
+
+func checkParams(entity string, params map[string]interface{}) (timeout time.Duration, interval time.Duration, err error) {
+	for _, val := range []string{"id", "state", "timeout"} {
+		if _, ok := params[val]; !ok {
+			return timeout, interval, fmt.Errorf("check %s: missing required param '%s'", entity, val)
+		}
+	}
+
+	seconds, ok := params["timeout"].(int)
+	if !ok {
+		return timeout, interval, fmt.Errorf("check %s: timeout param is not int", entity)
+	}
+	timeout = time.Duration(seconds) * time.Second
+
+	interval = defaultCheckInterval
+	if v, ok := params["interval"]; ok {
+		seconds, ok := v.(int)
+		if !ok || seconds < 1 {
+			return timeout, interval, fmt.Errorf("check %s: interval param is not a positive int", entity)
+		}
+		interval = time.Duration(seconds) * time.Second
+	}
+
+	return timeout, interval, nil
+}
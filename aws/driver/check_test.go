@@ -0,0 +1,183 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+	"github.com/wallix/awless/template/driver"
+)
+
+func TestCheckDriver(t *testing.T) {
+	var states []string
+	var fetchCount int
+	var fetchErr error
+	fetch := func(typ, id string) (*graph.Graph, error) {
+		fetchCount++
+		if fetchErr != nil {
+			return nil, fetchErr
+		}
+		g := graph.NewGraph()
+		switch id {
+		case "i-1234":
+			if fetchCount <= len(states) && states[fetchCount-1] != "" {
+				g.AddResource(resourcetest.Instance("i-1234").Prop(properties.State, states[fetchCount-1]).Build())
+			} else {
+				return nil, awserr.New("InvalidInstanceID.NotFound", "The instance ID 'i-1234' does not exist", nil)
+			}
+		case "i-5678":
+			g.AddResource(resourcetest.Instance("i-5678").Build())
+		}
+		return g, nil
+	}
+	driv := NewCheckDriver(fetch, "instance", "subnet")
+
+	t.Run("lookup", func(t *testing.T) {
+		if _, err := driv.Lookup("check", "instance"); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := driv.Lookup("check", "vpc"); err != driver.ErrDriverFnNotFound {
+			t.Fatalf("got %v, want %v", err, driver.ErrDriverFnNotFound)
+		}
+		if _, err := driv.Lookup("create", "instance"); err != driver.ErrDriverFnNotFound {
+			t.Fatalf("got %v, want %v", err, driver.ErrDriverFnNotFound)
+		}
+	})
+
+	checkFn, err := driv.Lookup("check", "instance")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("state reached after retry", func(t *testing.T) {
+		states, fetchCount = []string{"pending", "Running"}, 0
+		if _, err := checkFn(context.Background(), map[string]interface{}{"id": "i-1234", "state": "running", "timeout": 10, "interval": 1}); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := fetchCount, 2; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		states, fetchCount = []string{""}, 0
+		if _, err := checkFn(context.Background(), map[string]interface{}{"id": "i-1234", "state": NotFoundState, "timeout": 0}); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("exists without state", func(t *testing.T) {
+		states, fetchCount = nil, 0
+		if _, err := checkFn(context.Background(), map[string]interface{}{"id": "i-5678", "state": ExistsState, "timeout": 0}); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		states, fetchCount = []string{"pending"}, 0
+		_, err := checkFn(context.Background(), map[string]interface{}{"id": "i-1234", "state": "running", "timeout": 0})
+		if err == nil || !strings.Contains(err.Error(), "timeout") {
+			t.Fatalf("expected timeout error, got %v", err)
+		}
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		states, fetchCount = []string{"pending", "pending"}, 0
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := checkFn(ctx, map[string]interface{}{"id": "i-1234", "state": "running", "timeout": 600, "interval": 60})
+		if err == nil || !strings.Contains(err.Error(), "context canceled") {
+			t.Fatalf("expected cancellation error, got %v", err)
+		}
+		if got, want := fetchCount, 0; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+	})
+
+	t.Run("cancelled while fetching", func(t *testing.T) {
+		release, fetched := make(chan struct{}), make(chan struct{})
+		hung, err := NewCheckDriver(func(typ, id string) (*graph.Graph, error) {
+			<-release
+			close(fetched)
+			return graph.NewGraph(), nil
+		}, "instance").Lookup("check", "instance")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() { close(release); <-fetched }()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		_, err = hung(ctx, map[string]interface{}{"id": "i-1234", "state": "running", "timeout": 600})
+		if err == nil || !strings.Contains(err.Error(), "context deadline exceeded") {
+			t.Fatalf("expected cancellation error, got %v", err)
+		}
+		if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+			t.Fatalf("check not stopped on cancellation, took %s", elapsed)
+		}
+	})
+
+	t.Run("fetch error", func(t *testing.T) {
+		fetchErr = awserr.New("UnauthorizedOperation", "You are not authorized to perform this operation.", nil)
+		defer func() { fetchErr = nil }()
+		if _, err := checkFn(context.Background(), map[string]interface{}{"id": "i-1234", "state": "running", "timeout": 10}); err == nil || !strings.Contains(err.Error(), "UnauthorizedOperation") {
+			t.Fatalf("expected fetch error, got %v", err)
+		}
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		driv.SetDryRun(true)
+		defer driv.SetDryRun(false)
+		dryRunFn, err := driv.Lookup("check", "instance")
+		if err != nil {
+			t.Fatal(err)
+		}
+		states, fetchCount = nil, 0
+		if _, err := dryRunFn(context.Background(), map[string]interface{}{"id": "i-1234", "state": "running", "timeout": 10}); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := fetchCount, 1; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		fetchErr = awserr.New("InvalidInstanceID.Malformed", "Invalid id: 'i-12'", nil)
+		if _, err := dryRunFn(context.Background(), map[string]interface{}{"id": "i-12", "state": "running", "timeout": 10}); err != nil {
+			t.Fatal(err)
+		}
+		fetchErr = awserr.New("UnauthorizedOperation", "You are not authorized to perform this operation.", nil)
+		if _, err := dryRunFn(context.Background(), map[string]interface{}{"id": "i-1234", "state": "running", "timeout": 10}); err == nil || !strings.Contains(err.Error(), "dry run: check instance: UnauthorizedOperation") {
+			t.Fatalf("expected permission error, got %v", err)
+		}
+		fetchErr = nil
+		if _, err := dryRunFn(context.Background(), map[string]interface{}{"id": "i-1234", "state": "running"}); err == nil {
+			t.Fatal("expected error for missing timeout")
+		}
+		if _, err := dryRunFn(context.Background(), map[string]interface{}{"id": "i-1234", "state": "running", "timeout": "ten"}); err == nil {
+			t.Fatal("expected error for invalid timeout")
+		}
+		if _, err := dryRunFn(context.Background(), map[string]interface{}{"id": "i-1234", "state": "running", "timeout": 10, "interval": 0}); err == nil {
+			t.Fatal("expected error for invalid interval")
+		}
+	})
+}
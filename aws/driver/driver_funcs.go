@@ -17,6 +17,7 @@ limitations under the License.
 package aws
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -30,16 +31,19 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/mitchellh/ioprogress"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/console"
+	"github.com/wallix/awless/template/driver"
 )
 
-func (d *IamDriver) Attach_Policy_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *IamDriver) Attach_Policy_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	if _, ok := params["arn"]; !ok {
 		return nil, errors.New("attach policy: missing required params 'arn'")
 	}
@@ -55,7 +59,7 @@ func (d *IamDriver) Attach_Policy_DryRun(params map[string]interface{}) (interfa
 	return nil, nil
 }
 
-func (d *IamDriver) Attach_Policy(params map[string]interface{}) (interface{}, error) {
+func (d *IamDriver) Attach_Policy(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	user, hasUser := params["user"]
 	group, hasGroup := params["group"]
 
@@ -75,7 +79,7 @@ func (d *IamDriver) Attach_Policy(params map[string]interface{}) (interface{}, e
 	return nil, errors.New("missing one of 'user, group' param")
 }
 
-func (d *IamDriver) Detach_Policy_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *IamDriver) Detach_Policy_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	if _, ok := params["arn"]; !ok {
 		return nil, errors.New("detach policy: missing required params 'arn'")
 	}
@@ -91,7 +95,7 @@ func (d *IamDriver) Detach_Policy_DryRun(params map[string]interface{}) (interfa
 	return nil, nil
 }
 
-func (d *IamDriver) Detach_Policy(params map[string]interface{}) (interface{}, error) {
+func (d *IamDriver) Detach_Policy(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	user, hasUser := params["user"]
 	group, hasGroup := params["group"]
 
@@ -149,7 +153,7 @@ func performCall(d *IamDriver, desc string, input interface{}, fn interface{}, s
 	return
 }
 
-func (d *IamDriver) Create_Accesskey_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *IamDriver) Create_Accesskey_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	if _, ok := params["user"]; !ok {
 		return nil, errors.New("create accesskey: missing required params 'user'")
 	}
@@ -158,7 +162,7 @@ func (d *IamDriver) Create_Accesskey_DryRun(params map[string]interface{}) (inte
 	return nil, nil
 }
 
-func (d *IamDriver) Create_Accesskey(params map[string]interface{}) (interface{}, error) {
+func (d *IamDriver) Create_Accesskey(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &iam.CreateAccessKeyInput{}
 	var err error
 
@@ -191,84 +195,12 @@ func (d *IamDriver) Create_Accesskey(params map[string]interface{}) (interface{}
 	id := aws.StringValue(output.AccessKey.AccessKeyId)
 
 	d.logger.Verbosef("create accesskey '%s' done", id)
-	return id, nil
-}
-
-func (d *Ec2Driver) Check_Instance_DryRun(params map[string]interface{}) (interface{}, error) {
-	input := &ec2.DescribeInstancesInput{}
-	input.DryRun = aws.Bool(true)
-
-	for _, val := range []string{"state", "id", "timeout"} {
-		if _, ok := params[val]; !ok {
-			return nil, fmt.Errorf("check instance: missing required param '%s'", val)
-		}
-	}
-
-	if _, ok := params["timeout"].(int); !ok {
-		return nil, errors.New("check instance: timeout param is not int")
-	}
-
-	// Required params
-	err := setFieldWithType(params["id"], input, "InstanceIds", awsstringslice)
-	if err != nil {
-		return nil, err
-	}
-
-	_, err = d.DescribeInstances(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound):
-			id := fakeDryRunId("instance")
-			d.logger.Verbose("dry run: check instance ok")
-			return id, nil
-		}
-	}
-	return nil, fmt.Errorf("dry run: check instance: %s", err)
-}
-
-func (d *Ec2Driver) Check_Instance(params map[string]interface{}) (interface{}, error) {
-	input := &ec2.DescribeInstancesInput{}
-
-	// Required params
-	err := setFieldWithType(params["id"], input, "InstanceIds", awsstringslice)
-	if err != nil {
-		return nil, err
-	}
-
-	timeout := time.Duration(params["timeout"].(int)) * time.Second
-	timer := time.NewTimer(timeout)
-	retry := 5 * time.Second
-	for {
-		select {
-		case <-time.After(retry):
-			output, err := d.DescribeInstances(input)
-			if err != nil {
-				return nil, fmt.Errorf("check instance: %s", err)
-			}
-
-			if res := output.Reservations; len(res) > 0 {
-				if instances := output.Reservations[0].Instances; len(instances) > 0 {
-					for _, inst := range instances {
-						if aws.StringValue(inst.InstanceId) == params["id"] {
-							currentStatus := aws.StringValue(inst.State.Name)
-							if currentStatus == params["state"] {
-								d.logger.Verbosef("check instance status '%s' done", params["state"])
-								timer.Stop()
-								return nil, nil
-							}
-							d.logger.Infof("instance status '%s', expect '%s', retry in %s (timeout %s).", currentStatus, params["state"], retry, timeout)
-						}
-					}
-				}
-			}
-
-		case <-timer.C:
-			return nil, fmt.Errorf("timeout of %s expired", timeout)
-		}
-	}
+	return &driver.Output{ID: id, Sensitive: map[string]interface{}{
+		"Secret": aws.StringValue(output.AccessKey.SecretAccessKey),
+	}}, nil
 }
 
-func (d *Ec2Driver) Create_Tag_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Create_Tag_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.CreateTagsInput{}
 	input.DryRun = aws.Bool(true)
 	var err error
@@ -293,7 +225,7 @@ func (d *Ec2Driver) Create_Tag_DryRun(params map[string]interface{}) (interface{
 	return nil, fmt.Errorf("dry run: create tag: %s", err)
 }
 
-func (d *Ec2Driver) Create_Tag(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Create_Tag(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.CreateTagsInput{}
 	var err error
 
@@ -315,7 +247,7 @@ func (d *Ec2Driver) Create_Tag(params map[string]interface{}) (interface{}, erro
 	return output, nil
 }
 
-func (d *Ec2Driver) Delete_Tag_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Delete_Tag_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.DeleteTagsInput{}
 	input.DryRun = aws.Bool(true)
 	var err error
@@ -340,7 +272,7 @@ func (d *Ec2Driver) Delete_Tag_DryRun(params map[string]interface{}) (interface{
 	return nil, fmt.Errorf("dry run: delete tag: %s", err)
 }
 
-func (d *Ec2Driver) Delete_Tag(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Delete_Tag(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.DeleteTagsInput{}
 	var err error
 
@@ -362,7 +294,7 @@ func (d *Ec2Driver) Delete_Tag(params map[string]interface{}) (interface{}, erro
 	return output, nil
 }
 
-func (d *Ec2Driver) Create_Keypair_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Create_Keypair_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.ImportKeyPairInput{}
 
 	input.DryRun = aws.Bool(true)
@@ -390,7 +322,7 @@ func (d *Ec2Driver) Create_Keypair_DryRun(params map[string]interface{}) (interf
 	return nil, nil
 }
 
-func (d *Ec2Driver) Create_Keypair(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Create_Keypair(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.ImportKeyPairInput{}
 	err := setFieldWithType(params["name"], input, "KeyName", awsstr)
 	if err != nil {
@@ -423,7 +355,7 @@ func (d *Ec2Driver) Create_Keypair(params map[string]interface{}) (interface{},
 	return aws.StringValue(output.KeyName), nil
 }
 
-func (d *Ec2Driver) Update_Securitygroup_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Update_Securitygroup_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	ipPerms, err := buildIpPermissionsFromParams(params)
 	if err != nil {
 		return nil, err
@@ -479,7 +411,7 @@ func (d *Ec2Driver) Update_Securitygroup_DryRun(params map[string]interface{}) (
 	return nil, fmt.Errorf("dry run: update securitygroup: %s", err)
 }
 
-func (d *Ec2Driver) Update_Securitygroup(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Update_Securitygroup(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	ipPerms, err := buildIpPermissionsFromParams(params)
 	if err != nil {
 		return nil, err
@@ -534,7 +466,75 @@ func (d *Ec2Driver) Update_Securitygroup(params map[string]interface{}) (interfa
 	return output, nil
 }
 
-func (d *S3Driver) Create_Storageobject_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Delete_Image_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &ec2.DeregisterImageInput{}
+	input.DryRun = aws.Bool(true)
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "ImageId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = d.DeregisterImage(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound):
+			id := fakeDryRunId("image")
+			d.logger.Verbose("dry run: delete image ok")
+			return id, nil
+		}
+	}
+
+	return nil, fmt.Errorf("dry run: delete image: %s", err)
+}
+
+// Delete_Image deregisters the image then deletes its EBS snapshots
+func (d *Ec2Driver) Delete_Image(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &ec2.DeregisterImageInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "ImageId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	images, err := d.DescribeImages(&ec2.DescribeImagesInput{ImageIds: []*string{input.ImageId}})
+	if err != nil {
+		return nil, fmt.Errorf("delete image: %s", err)
+	}
+	var snapshots []*string
+	for _, image := range images.Images {
+		for _, mapping := range image.BlockDeviceMappings {
+			if mapping.Ebs != nil && aws.StringValue(mapping.Ebs.SnapshotId) != "" {
+				snapshots = append(snapshots, mapping.Ebs.SnapshotId)
+			}
+		}
+	}
+
+	start := time.Now()
+	var output *ec2.DeregisterImageOutput
+	output, err = d.DeregisterImage(input)
+	if err != nil {
+		return nil, fmt.Errorf("delete image: %s", err)
+	}
+	d.logger.ExtraVerbosef("ec2.DeregisterImage call took %s", time.Since(start))
+
+	for _, snapshot := range snapshots {
+		if _, err := d.DeleteSnapshot(&ec2.DeleteSnapshotInput{SnapshotId: snapshot}); err != nil {
+			d.logger.Warningf("delete image '%s': cannot delete snapshot '%s': %s", aws.StringValue(input.ImageId), aws.StringValue(snapshot), err)
+			continue
+		}
+		d.logger.Verbosef("delete snapshot '%s' of image '%s' done", aws.StringValue(snapshot), aws.StringValue(input.ImageId))
+	}
+
+	d.logger.Verbose("delete image done")
+	return output, nil
+}
+
+func (d *S3Driver) Create_Storageobject_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	if _, ok := params["bucket"]; !ok {
 		return nil, errors.New("create storageobject: missing required params 'bucket'")
 	}
@@ -558,12 +558,15 @@ func (d *S3Driver) Create_Storageobject_DryRun(params map[string]interface{}) (i
 	return nil, nil
 }
 
+// progressReadSeeker displays the upload progress of a file, and interrupts
+// the upload with an error on its reads once the context is cancelled
 type progressReadSeeker struct {
+	ctx    context.Context
 	file   *os.File
 	reader *ioprogress.Reader
 }
 
-func newProgressReader(f *os.File) (*progressReadSeeker, error) {
+func newProgressReader(ctx context.Context, f *os.File) (*progressReadSeeker, error) {
 	finfo, err := f.Stat()
 	if err != nil {
 		return nil, err
@@ -585,10 +588,13 @@ func newProgressReader(f *os.File) (*progressReadSeeker, error) {
 		Size:     finfo.Size(),
 	}
 
-	return &progressReadSeeker{file: f, reader: reader}, nil
+	return &progressReadSeeker{ctx: ctx, file: f, reader: reader}, nil
 }
 
 func (pr *progressReadSeeker) Read(p []byte) (int, error) {
+	if err := pr.ctx.Err(); err != nil {
+		return 0, err
+	}
 	return pr.reader.Read(p)
 }
 
@@ -596,7 +602,7 @@ func (pr *progressReadSeeker) Seek(offset int64, whence int) (int64, error) {
 	return pr.file.Seek(offset, whence)
 }
 
-func (d *S3Driver) Create_Storageobject(params map[string]interface{}) (interface{}, error) {
+func (d *S3Driver) Create_Storageobject(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &s3.PutObjectInput{}
 
 	f, err := os.Open(params["file"].(string))
@@ -605,7 +611,7 @@ func (d *S3Driver) Create_Storageobject(params map[string]interface{}) (interfac
 	}
 	defer f.Close()
 
-	progressR, err := newProgressReader(f)
+	progressR, err := newProgressReader(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -636,7 +642,7 @@ func (d *S3Driver) Create_Storageobject(params map[string]interface{}) (interfac
 	return output, nil
 }
 
-func (d *Route53Driver) Create_Record_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *Route53Driver) Create_Record_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	if _, ok := params["zone"]; !ok {
 		return nil, errors.New("create record: missing required params 'zone'")
 	}
@@ -661,7 +667,7 @@ func (d *Route53Driver) Create_Record_DryRun(params map[string]interface{}) (int
 	return nil, nil
 }
 
-func (d *Route53Driver) Create_Record(params map[string]interface{}) (interface{}, error) {
+func (d *Route53Driver) Create_Record(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &route53.ChangeResourceRecordSetsInput{}
 	var err error
 	// Required params
@@ -713,7 +719,7 @@ func (d *Route53Driver) Create_Record(params map[string]interface{}) (interface{
 	return aws.StringValue(output.ChangeInfo.Id), nil
 }
 
-func (d *Route53Driver) Delete_Record_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *Route53Driver) Delete_Record_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	if _, ok := params["zone"]; !ok {
 		return nil, errors.New("delete record: missing required params 'zone'")
 	}
@@ -738,7 +744,7 @@ func (d *Route53Driver) Delete_Record_DryRun(params map[string]interface{}) (int
 	return nil, nil
 }
 
-func (d *Route53Driver) Delete_Record(params map[string]interface{}) (interface{}, error) {
+func (d *Route53Driver) Delete_Record(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &route53.ChangeResourceRecordSetsInput{}
 	var err error
 	// Required params
@@ -782,6 +788,153 @@ func (d *Route53Driver) Delete_Record(params map[string]interface{}) (interface{
 	return aws.StringValue(output.ChangeInfo.Id), nil
 }
 
+func (d *LambdaDriver) Create_Function_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	for _, name := range []string{"name", "handler", "role", "runtime"} {
+		if _, ok := params[name]; !ok {
+			return nil, fmt.Errorf("create function: missing required params '%s'", name)
+		}
+	}
+
+	if err := checkFunctionCodeParams(params); err != nil {
+		return nil, fmt.Errorf("create function: %s", err)
+	}
+
+	d.logger.Verbose("params dry run: create function ok")
+	return nil, nil
+}
+
+// checkFunctionCodeParams verifies that the code of the function is given
+// either as a local zip file or as an S3 object
+func checkFunctionCodeParams(params map[string]interface{}) error {
+	_, hasZip := params["zipfile"]
+	_, hasBucket := params["bucket"]
+	_, hasObject := params["object"]
+
+	switch {
+	case hasZip && (hasBucket || hasObject):
+		return errors.New("expecting either 'zipfile' or 'bucket' and 'object' params, not both")
+	case hasZip:
+		stat, err := os.Stat(fmt.Sprint(params["zipfile"]))
+		if os.IsNotExist(err) {
+			return fmt.Errorf("cannot find file '%s'", params["zipfile"])
+		}
+		if err != nil {
+			return err
+		}
+		if stat.IsDir() {
+			return fmt.Errorf("'%s' is a directory", params["zipfile"])
+		}
+	case hasBucket && hasObject:
+	default:
+		return errors.New("missing function code: expecting 'zipfile' or 'bucket' and 'object' params")
+	}
+	return nil
+}
+
+func (d *LambdaDriver) Create_Function(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &lambda.CreateFunctionInput{Code: &lambda.FunctionCode{}}
+	var err error
+
+	if err = checkFunctionCodeParams(params); err != nil {
+		return nil, fmt.Errorf("create function: %s", err)
+	}
+
+	// Required params
+	err = setFieldWithType(params["name"], input, "FunctionName", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["handler"], input, "Handler", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["role"], input, "Role", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["runtime"], input, "Runtime", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if zipfile, ok := params["zipfile"]; ok {
+		if input.Code.ZipFile, err = ioutil.ReadFile(fmt.Sprint(zipfile)); err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["bucket"]; ok {
+		err = setFieldWithType(params["bucket"], input, "Code.S3Bucket", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["object"]; ok {
+		err = setFieldWithType(params["object"], input, "Code.S3Key", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["objectversion"]; ok {
+		err = setFieldWithType(params["objectversion"], input, "Code.S3ObjectVersion", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["description"]; ok {
+		err = setFieldWithType(params["description"], input, "Description", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["memory"]; ok {
+		err = setFieldWithType(params["memory"], input, "MemorySize", awsint64)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["timeout"]; ok {
+		err = setFieldWithType(params["timeout"], input, "Timeout", awsint64)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["publish"]; ok {
+		err = setFieldWithType(params["publish"], input, "Publish", awsbool)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["subnets"]; ok {
+		if input.VpcConfig == nil {
+			input.VpcConfig = &lambda.VpcConfig{}
+		}
+		err = setFieldWithType(params["subnets"], input, "VpcConfig.SubnetIds", awsstringslice)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["groups"]; ok {
+		if input.VpcConfig == nil {
+			input.VpcConfig = &lambda.VpcConfig{}
+		}
+		err = setFieldWithType(params["groups"], input, "VpcConfig.SecurityGroupIds", awsstringslice)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	var output *lambda.FunctionConfiguration
+	output, err = d.CreateFunction(input)
+	if err != nil {
+		return nil, fmt.Errorf("create function: %s", err)
+	}
+	d.logger.ExtraVerbosef("lambda.CreateFunction call took %s", time.Since(start))
+	d.logger.Verbose("create function done")
+	return aws.StringValue(output.FunctionArn), nil
+}
+
 func buildIpPermissionsFromParams(params map[string]interface{}) ([]*ec2.IpPermission, error) {
 	if _, ok := params["cidr"].(string); !ok {
 		return nil, fmt.Errorf("invalid cidr '%v'", params["cidr"])
@@ -855,3 +1008,129 @@ func fakeDryRunId(entity string) string {
 		return fmt.Sprintf("dryrunid-%d", suffix)
 	}
 }
+
+var createAlarmRequiredParams = []string{"name", "operator", "metric", "namespace", "evaluation-periods", "period", "statistic", "threshold"}
+
+func (d *CloudwatchDriver) Create_Alarm_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	for _, key := range createAlarmRequiredParams {
+		if _, ok := params[key]; !ok {
+			return nil, fmt.Errorf("create alarm: missing required params '%s'", key)
+		}
+	}
+	if err := d.checkNoAlarm(fmt.Sprint(params["name"])); err != nil {
+		return nil, fmt.Errorf("dry run: create alarm: %s", err)
+	}
+
+	d.logger.Verbose("params dry run: create alarm ok")
+	return nil, nil
+}
+
+// Create_Alarm creates an alarm with PutMetricAlarm, which would otherwise
+// overwrite an existing alarm of the same name (then deleted by the revert)
+func (d *CloudwatchDriver) Create_Alarm(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &cloudwatch.PutMetricAlarmInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["name"], input, "AlarmName", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["operator"], input, "ComparisonOperator", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["metric"], input, "MetricName", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["namespace"], input, "Namespace", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["evaluation-periods"], input, "EvaluationPeriods", awsint64)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["period"], input, "Period", awsint64)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["statistic"], input, "Statistic", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["threshold"], input, "Threshold", awsfloat)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["enabled"]; ok {
+		err = setFieldWithType(params["enabled"], input, "ActionsEnabled", awsbool)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["alarm-actions"]; ok {
+		err = setFieldWithType(params["alarm-actions"], input, "AlarmActions", awsstringslice)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["insufficientdata-actions"]; ok {
+		err = setFieldWithType(params["insufficientdata-actions"], input, "InsufficientDataActions", awsstringslice)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["ok-actions"]; ok {
+		err = setFieldWithType(params["ok-actions"], input, "OKActions", awsstringslice)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["description"]; ok {
+		err = setFieldWithType(params["description"], input, "AlarmDescription", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["dimensions"]; ok {
+		err = setFieldWithType(params["dimensions"], input, "Dimensions", awsdimensionslice)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["unit"]; ok {
+		err = setFieldWithType(params["unit"], input, "Unit", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err = d.checkNoAlarm(aws.StringValue(input.AlarmName)); err != nil {
+		return nil, fmt.Errorf("create alarm: %s", err)
+	}
+
+	start := time.Now()
+	if _, err = d.PutMetricAlarm(input); err != nil {
+		return nil, fmt.Errorf("create alarm: %s", err)
+	}
+	d.logger.ExtraVerbosef("cloudwatch.PutMetricAlarm call took %s", time.Since(start))
+	id := aws.StringValue(input.AlarmName)
+
+	d.logger.Verbosef("create alarm '%s' done", id)
+	return id, nil
+}
+
+func (d *CloudwatchDriver) checkNoAlarm(name string) error {
+	out, err := d.DescribeAlarms(&cloudwatch.DescribeAlarmsInput{AlarmNames: []*string{aws.String(name)}})
+	if err != nil {
+		return err
+	}
+	if len(out.MetricAlarms) > 0 {
+		return fmt.Errorf("alarm '%s' already exists", name)
+	}
+	return nil
+}
@@ -17,12 +17,16 @@ limitations under the License.
 package aws
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
@@ -74,11 +78,11 @@ func TestDriver(t *testing.T) {
 			return nil
 		}
 
-		id, err := driv.Create_Vpc(map[string]interface{}{"cidr": cidr})
+		id, err := driv.Create_Vpc(context.Background(), map[string]interface{}{"cidr": cidr})
 		if err != nil {
 			t.Fatal(err)
 		}
-		if got, want := id.(string), "mynewvpc"; got != want {
+		if got, want := id.(*driver.Output).ID, "mynewvpc"; got != want {
 			t.Fatalf("got %s, want %s", got, want)
 		}
 	})
@@ -96,11 +100,11 @@ func TestDriver(t *testing.T) {
 			return nil
 		}
 
-		id, err := driv.Create_Subnet(map[string]interface{}{"cidr": cidr, "vpc": vpc})
+		id, err := driv.Create_Subnet(context.Background(), map[string]interface{}{"cidr": cidr, "vpc": vpc})
 		if err != nil {
 			t.Fatal(err)
 		}
-		if got, want := id.(string), "mynewsubnet"; got != want {
+		if got, want := id.(*driver.Output).ID, "mynewsubnet"; got != want {
 			t.Fatalf("got %s, want %s", got, want)
 		}
 	})
@@ -143,11 +147,14 @@ func TestDriver(t *testing.T) {
 			return nil
 		}
 
-		id, err := driv.Create_Instance(map[string]interface{}{"image": image, "type": typ, "subnet": subnet, "count": count, "name": name})
+		id, err := driv.Create_Instance(context.Background(), map[string]interface{}{"image": image, "type": typ, "subnet": subnet, "count": count, "name": name})
 		if err != nil {
 			t.Fatal(err)
 		}
-		if got, want := id.(string), "mynewinstance"; got != want {
+		if got, want := id.(*driver.Output).ID, "mynewinstance"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got, want := id.(*driver.Output).Properties["PrivateIP"], "10.0.0.12"; got != want {
 			t.Fatalf("got %s, want %s", got, want)
 		}
 		if got, want := tagNameCreated, true; got != want {
@@ -241,6 +248,63 @@ func TestBuildIpPermissionsFromParams(t *testing.T) {
 	}
 }
 
+func TestCreateAlarm(t *testing.T) {
+	awsMock := &mockCloudwatch{alarms: []string{"existing"}}
+	driv := NewCloudwatchDriver(awsMock).(*CloudwatchDriver)
+	params := func(name string) map[string]interface{} {
+		return map[string]interface{}{"name": name, "operator": "GreaterThanThreshold", "metric": "CPUUtilization", "namespace": "AWS/EC2",
+			"evaluation-periods": 1, "period": 60, "statistic": "Average", "threshold": 75.5, "dimensions": []string{"InstanceId:i-1234"}}
+	}
+
+	id, err := driv.Create_Alarm(context.Background(), params("new"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := id, "new"; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := aws.StringValue(awsMock.put.Dimensions[0].Value), "i-1234"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	awsMock.put = nil
+	if _, err = driv.Create_Alarm(context.Background(), params("existing")); err == nil || !strings.Contains(err.Error(), "alarm 'existing' already exists") {
+		t.Fatalf("got %v, want already exists error", err)
+	}
+	if awsMock.put != nil {
+		t.Fatal("existing alarm overwritten")
+	}
+	if _, err = driv.Create_Alarm_DryRun(context.Background(), params("existing")); err == nil {
+		t.Fatal("expected error got none")
+	}
+	if _, err = driv.Create_Alarm_DryRun(context.Background(), params("new")); err != nil {
+		t.Fatal(err)
+	}
+}
+
+type mockCloudwatch struct {
+	cloudwatchiface.CloudWatchAPI
+	alarms []string
+	put    *cloudwatch.PutMetricAlarmInput
+}
+
+func (m *mockCloudwatch) DescribeAlarms(input *cloudwatch.DescribeAlarmsInput) (*cloudwatch.DescribeAlarmsOutput, error) {
+	out := &cloudwatch.DescribeAlarmsOutput{}
+	for _, name := range m.alarms {
+		for _, wanted := range input.AlarmNames {
+			if name == aws.StringValue(wanted) {
+				out.MetricAlarms = append(out.MetricAlarms, &cloudwatch.MetricAlarm{AlarmName: aws.String(name)})
+			}
+		}
+	}
+	return out, nil
+}
+
+func (m *mockCloudwatch) PutMetricAlarm(input *cloudwatch.PutMetricAlarmInput) (*cloudwatch.PutMetricAlarmOutput, error) {
+	m.put = input
+	return &cloudwatch.PutMetricAlarmOutput{}, nil
+}
+
 type mockIam struct {
 	iamiface.IAMAPI
 }
@@ -283,7 +347,7 @@ func (m *mockEc2) RunInstances(input *ec2.RunInstancesInput) (*ec2.Reservation,
 	if err := m.verifyInstanceInput(input); err != nil {
 		return nil, err
 	}
-	return &ec2.Reservation{Instances: []*ec2.Instance{{InstanceId: aws.String("mynewinstance")}}}, nil
+	return &ec2.Reservation{Instances: []*ec2.Instance{{InstanceId: aws.String("mynewinstance"), PrivateIpAddress: aws.String("10.0.0.12")}}}, nil
 }
 
 func (m *mockEc2) CreateTags(input *ec2.CreateTagsInput) (*ec2.CreateTagsOutput, error) {
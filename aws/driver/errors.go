@@ -0,0 +1,114 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/wallix/awless/template/driver"
+)
+
+// Only errors for which the AWS call has been rejected without effect are
+// retried: timeouts and internal errors are left to the SDK retries since
+// calls such as create instance are not idempotent and may have succeeded.
+var (
+	throttlingCodes = map[string]bool{
+		"Throttling":                             true,
+		"ThrottlingException":                    true,
+		"ThrottledException":                     true,
+		"RequestThrottled":                       true,
+		"RequestThrottledException":              true,
+		"RequestLimitExceeded":                   true,
+		"TooManyRequestsException":               true,
+		"ProvisionedThroughputExceededException": true,
+		"SlowDown":                               true,
+		"PriorRequestNotComplete":                true,
+	}
+
+	// resources referenced by id right after their creation (eventual consistency)
+	notFoundYetCodes = map[string]bool{
+		"NoSuchEntity":                       true,
+		"InvalidInstanceID.NotFound":         true,
+		"InvalidGroup.NotFound":              true,
+		"InvalidVpcID.NotFound":              true,
+		"InvalidSubnetID.NotFound":           true,
+		"InvalidRouteTableID.NotFound":       true,
+		"InvalidInternetGatewayID.NotFound":  true,
+		"InvalidNetworkInterfaceID.NotFound": true,
+		"InvalidAllocationID.NotFound":       true,
+		"InvalidVolume.NotFound":             true,
+		"InvalidAMIID.NotFound":              true,
+		"InvalidSnapshot.NotFound":           true,
+		"InvalidNatGatewayID.NotFound":       true,
+	}
+
+	conflictCodes = map[string]bool{
+		"DependencyViolation":             true,
+		"IncorrectState":                  true,
+		"IncorrectInstanceState":          true,
+		"InvalidDBInstanceState":          true,
+		"InvalidDBSubnetGroupStateFault":  true,
+		"ResourceInUse":                   true,
+		"ResourceInUseException":          true,
+		"ConcurrentModification":          true,
+		"ConcurrentModificationException": true,
+		"OperationAborted":                true,
+		"Conflict":                        true,
+		"ConflictException":               true,
+	}
+
+	// matches AWS error codes in messages (ex: 'create vpc: InvalidVpcID.NotFound: ...')
+	errorCodeRegex = regexp.MustCompile(`\b([A-Z][A-Za-z0-9]*(\.[A-Za-z0-9]+)*): `)
+)
+
+// ClassifyError returns the kind of the error of an AWS call, to know whether
+// the driver function may succeed when retried. Errors wrapped in messages
+// by driver functions are classified from the AWS error code they contain.
+func ClassifyError(err error) driver.ErrorKind {
+	if err == nil {
+		return driver.FatalError
+	}
+
+	var codes []string
+	if awsErr, ok := err.(awserr.Error); ok {
+		codes = append(codes, awsErr.Code())
+	} else {
+		for _, match := range errorCodeRegex.FindAllStringSubmatch(err.Error(), -1) {
+			codes = append(codes, match[1])
+		}
+	}
+
+	// EC2 rejecting an instance profile not yet visible from IAM
+	if strings.Contains(err.Error(), "Invalid IAM Instance Profile") {
+		return driver.NotFoundYetError
+	}
+
+	for _, code := range codes {
+		switch {
+		case throttlingCodes[code]:
+			return driver.ThrottlingError
+		case notFoundYetCodes[code]:
+			return driver.NotFoundYetError
+		case conflictCodes[code]:
+			return driver.ConflictError
+		}
+	}
+
+	return driver.FatalError
+}
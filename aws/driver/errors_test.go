@@ -0,0 +1,54 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/wallix/awless/template/driver"
+)
+
+func TestClassifyError(t *testing.T) {
+	tcases := []struct {
+		err  error
+		kind driver.ErrorKind
+	}{
+		{err: awserr.New("Throttling", "Rate exceeded", nil), kind: driver.ThrottlingError},
+		{err: awserr.New("RequestLimitExceeded", "Request limit exceeded.", nil), kind: driver.ThrottlingError},
+		{err: awserr.New("NoSuchEntity", "Role not found", nil), kind: driver.NotFoundYetError},
+		{err: awserr.New("InvalidVpcID.NotFound", "The vpc ID 'vpc-1' does not exist", nil), kind: driver.NotFoundYetError},
+		{err: awserr.New("DependencyViolation", "resource sg-1 has a dependent object", nil), kind: driver.ConflictError},
+		{err: awserr.New("InvalidParameterValue", "Value (t2.huge) for parameter instanceType is invalid", nil), kind: driver.FatalError},
+		{err: awserr.NewRequestFailure(awserr.New("Unknown", "boom", nil), 503, "req-1"), kind: driver.FatalError},
+		{err: awserr.New("RequestTimeout", "Request timed out", nil), kind: driver.FatalError},
+		{err: awserr.New("InternalError", "An internal error has occurred", nil), kind: driver.FatalError},
+		{err: awserr.New("InvalidKeyPair.NotFound", "The key pair 'typo' does not exist", nil), kind: driver.FatalError},
+		{err: awserr.New("DBSubnetGroupNotFoundFault", "DB subnet group 'typo' not found", nil), kind: driver.FatalError},
+		{err: fmt.Errorf("create instanceprofile: %s", awserr.New("NoSuchEntity", "Role not found", nil)), kind: driver.NotFoundYetError},
+		{err: fmt.Errorf("create instance: %s", awserr.New("InvalidParameterValue", "Value (web) for parameter iamInstanceProfile.name is invalid. Invalid IAM Instance Profile name", nil)), kind: driver.NotFoundYetError},
+		{err: errors.New("create vpc: missing required params"), kind: driver.FatalError},
+	}
+
+	for i, tcase := range tcases {
+		if got, want := ClassifyError(tcase.err), tcase.kind; got != want {
+			t.Fatalf("%d: got %s, want %s", i+1, got, want)
+		}
+	}
+}
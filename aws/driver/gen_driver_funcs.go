@@ -18,6 +18,7 @@ limitations under the License.
 package aws
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -25,14 +26,18 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/wallix/awless/template/driver"
 )
 
 const (
@@ -41,7 +46,7 @@ const (
 )
 
 // This function was auto generated
-func (d *Ec2Driver) Create_Vpc_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Create_Vpc_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.CreateVpcInput{}
 	input.DryRun = aws.Bool(true)
 	var err error
@@ -61,7 +66,7 @@ func (d *Ec2Driver) Create_Vpc_DryRun(params map[string]interface{}) (interface{
 			id := fakeDryRunId("vpc")
 			// Extra param as tag
 			if v, ok := params["name"]; ok {
-				_, err = d.Create_Tag_DryRun(map[string]interface{}{"key": "Name", "value": v, "resource": id})
+				_, err = d.Create_Tag_DryRun(ctx, map[string]interface{}{"key": "Name", "value": v, "resource": id})
 				if err != nil {
 					return nil, fmt.Errorf("dry run: create vpc: adding tags: %s", err)
 				}
@@ -75,7 +80,7 @@ func (d *Ec2Driver) Create_Vpc_DryRun(params map[string]interface{}) (interface{
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Create_Vpc(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Create_Vpc(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.CreateVpcInput{}
 	var err error
 
@@ -98,18 +103,20 @@ func (d *Ec2Driver) Create_Vpc(params map[string]interface{}) (interface{}, erro
 	id := aws.StringValue(output.Vpc.VpcId)
 	// Extra param as tag
 	if v, ok := params["name"]; ok {
-		_, err = d.Create_Tag(map[string]interface{}{"key": "Name", "value": v, "resource": id})
+		_, err = d.Create_Tag(ctx, map[string]interface{}{"key": "Name", "value": v, "resource": id})
 		if err != nil {
 			return nil, fmt.Errorf("create vpc: adding tags: %s", err)
 		}
 	}
 
 	d.logger.Verbosef("create vpc '%s' done", id)
-	return id, nil
+	return &driver.Output{ID: id, Properties: map[string]interface{}{
+		"CidrBlock": aws.StringValue(output.Vpc.CidrBlock),
+	}}, nil
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Delete_Vpc_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Delete_Vpc_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.DeleteVpcInput{}
 	input.DryRun = aws.Bool(true)
 	var err error
@@ -134,7 +141,7 @@ func (d *Ec2Driver) Delete_Vpc_DryRun(params map[string]interface{}) (interface{
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Delete_Vpc(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Delete_Vpc(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.DeleteVpcInput{}
 	var err error
 
@@ -157,7 +164,7 @@ func (d *Ec2Driver) Delete_Vpc(params map[string]interface{}) (interface{}, erro
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Create_Subnet_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Create_Subnet_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.CreateSubnetInput{}
 	input.DryRun = aws.Bool(true)
 	var err error
@@ -187,7 +194,7 @@ func (d *Ec2Driver) Create_Subnet_DryRun(params map[string]interface{}) (interfa
 			id := fakeDryRunId("subnet")
 			// Extra param as tag
 			if v, ok := params["name"]; ok {
-				_, err = d.Create_Tag_DryRun(map[string]interface{}{"key": "Name", "value": v, "resource": id})
+				_, err = d.Create_Tag_DryRun(ctx, map[string]interface{}{"key": "Name", "value": v, "resource": id})
 				if err != nil {
 					return nil, fmt.Errorf("dry run: create subnet: adding tags: %s", err)
 				}
@@ -201,7 +208,7 @@ func (d *Ec2Driver) Create_Subnet_DryRun(params map[string]interface{}) (interfa
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Create_Subnet(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Create_Subnet(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.CreateSubnetInput{}
 	var err error
 
@@ -234,18 +241,21 @@ func (d *Ec2Driver) Create_Subnet(params map[string]interface{}) (interface{}, e
 	id := aws.StringValue(output.Subnet.SubnetId)
 	// Extra param as tag
 	if v, ok := params["name"]; ok {
-		_, err = d.Create_Tag(map[string]interface{}{"key": "Name", "value": v, "resource": id})
+		_, err = d.Create_Tag(ctx, map[string]interface{}{"key": "Name", "value": v, "resource": id})
 		if err != nil {
 			return nil, fmt.Errorf("create subnet: adding tags: %s", err)
 		}
 	}
 
 	d.logger.Verbosef("create subnet '%s' done", id)
-	return id, nil
+	return &driver.Output{ID: id, Properties: map[string]interface{}{
+		"AvailabilityZone": aws.StringValue(output.Subnet.AvailabilityZone),
+		"CidrBlock":        aws.StringValue(output.Subnet.CidrBlock),
+	}}, nil
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Update_Subnet_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Update_Subnet_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	if _, ok := params["id"]; !ok {
 		return nil, errors.New("update subnet: missing required params 'id'")
 	}
@@ -255,7 +265,7 @@ func (d *Ec2Driver) Update_Subnet_DryRun(params map[string]interface{}) (interfa
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Update_Subnet(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Update_Subnet(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.ModifySubnetAttributeInput{}
 	var err error
 
@@ -286,7 +296,7 @@ func (d *Ec2Driver) Update_Subnet(params map[string]interface{}) (interface{}, e
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Delete_Subnet_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Delete_Subnet_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.DeleteSubnetInput{}
 	input.DryRun = aws.Bool(true)
 	var err error
@@ -311,7 +321,7 @@ func (d *Ec2Driver) Delete_Subnet_DryRun(params map[string]interface{}) (interfa
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Delete_Subnet(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Delete_Subnet(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.DeleteSubnetInput{}
 	var err error
 
@@ -334,7 +344,7 @@ func (d *Ec2Driver) Delete_Subnet(params map[string]interface{}) (interface{}, e
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Create_Instance_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Create_Instance_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.RunInstancesInput{}
 	input.DryRun = aws.Bool(true)
 	var err error
@@ -399,7 +409,7 @@ func (d *Ec2Driver) Create_Instance_DryRun(params map[string]interface{}) (inter
 		case code == dryRunOperation, strings.HasSuffix(code, notFound):
 			id := fakeDryRunId("instance")
 			// Required param as tag
-			_, err = d.Create_Tag_DryRun(map[string]interface{}{"key": "Name", "value": params["name"], "resource": id})
+			_, err = d.Create_Tag_DryRun(ctx, map[string]interface{}{"key": "Name", "value": params["name"], "resource": id})
 			if err != nil {
 				return nil, fmt.Errorf("dry run: create instance: adding tags: %s", err)
 			}
@@ -412,7 +422,7 @@ func (d *Ec2Driver) Create_Instance_DryRun(params map[string]interface{}) (inter
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Create_Instance(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Create_Instance(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.RunInstancesInput{}
 	var err error
 
@@ -480,17 +490,19 @@ func (d *Ec2Driver) Create_Instance(params map[string]interface{}) (interface{},
 	d.logger.ExtraVerbosef("ec2.RunInstances call took %s", time.Since(start))
 	id := aws.StringValue(output.Instances[0].InstanceId)
 	// Required param as tag
-	_, err = d.Create_Tag(map[string]interface{}{"key": "Name", "value": params["name"], "resource": id})
+	_, err = d.Create_Tag(ctx, map[string]interface{}{"key": "Name", "value": params["name"], "resource": id})
 	if err != nil {
 		return nil, fmt.Errorf("create instance: adding tags: %s", err)
 	}
 
 	d.logger.Verbosef("create instance '%s' done", id)
-	return id, nil
+	return &driver.Output{ID: id, Properties: map[string]interface{}{
+		"PrivateIP": aws.StringValue(output.Instances[0].PrivateIpAddress),
+	}}, nil
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Update_Instance_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Update_Instance_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.ModifyInstanceAttributeInput{}
 	input.DryRun = aws.Bool(true)
 	var err error
@@ -535,7 +547,7 @@ func (d *Ec2Driver) Update_Instance_DryRun(params map[string]interface{}) (inter
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Update_Instance(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Update_Instance(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.ModifyInstanceAttributeInput{}
 	var err error
 
@@ -578,7 +590,7 @@ func (d *Ec2Driver) Update_Instance(params map[string]interface{}) (interface{},
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Delete_Instance_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Delete_Instance_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.TerminateInstancesInput{}
 	input.DryRun = aws.Bool(true)
 	var err error
@@ -603,7 +615,7 @@ func (d *Ec2Driver) Delete_Instance_DryRun(params map[string]interface{}) (inter
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Delete_Instance(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Delete_Instance(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.TerminateInstancesInput{}
 	var err error
 
@@ -626,7 +638,7 @@ func (d *Ec2Driver) Delete_Instance(params map[string]interface{}) (interface{},
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Start_Instance_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Start_Instance_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.StartInstancesInput{}
 	input.DryRun = aws.Bool(true)
 	var err error
@@ -651,7 +663,7 @@ func (d *Ec2Driver) Start_Instance_DryRun(params map[string]interface{}) (interf
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Start_Instance(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Start_Instance(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.StartInstancesInput{}
 	var err error
 
@@ -676,7 +688,7 @@ func (d *Ec2Driver) Start_Instance(params map[string]interface{}) (interface{},
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Stop_Instance_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Stop_Instance_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.StopInstancesInput{}
 	input.DryRun = aws.Bool(true)
 	var err error
@@ -701,7 +713,7 @@ func (d *Ec2Driver) Stop_Instance_DryRun(params map[string]interface{}) (interfa
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Stop_Instance(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Stop_Instance(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.StopInstancesInput{}
 	var err error
 
@@ -726,7 +738,7 @@ func (d *Ec2Driver) Stop_Instance(params map[string]interface{}) (interface{}, e
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Create_Securitygroup_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Create_Securitygroup_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.CreateSecurityGroupInput{}
 	input.DryRun = aws.Bool(true)
 	var err error
@@ -759,7 +771,7 @@ func (d *Ec2Driver) Create_Securitygroup_DryRun(params map[string]interface{}) (
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Create_Securitygroup(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Create_Securitygroup(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.CreateSecurityGroupInput{}
 	var err error
 
@@ -792,7 +804,7 @@ func (d *Ec2Driver) Create_Securitygroup(params map[string]interface{}) (interfa
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Delete_Securitygroup_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Delete_Securitygroup_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.DeleteSecurityGroupInput{}
 	input.DryRun = aws.Bool(true)
 	var err error
@@ -817,7 +829,7 @@ func (d *Ec2Driver) Delete_Securitygroup_DryRun(params map[string]interface{}) (
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Delete_Securitygroup(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Delete_Securitygroup(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.DeleteSecurityGroupInput{}
 	var err error
 
@@ -840,7 +852,7 @@ func (d *Ec2Driver) Delete_Securitygroup(params map[string]interface{}) (interfa
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Create_Volume_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Create_Volume_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.CreateVolumeInput{}
 	input.DryRun = aws.Bool(true)
 	var err error
@@ -869,7 +881,7 @@ func (d *Ec2Driver) Create_Volume_DryRun(params map[string]interface{}) (interfa
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Create_Volume(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Create_Volume(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.CreateVolumeInput{}
 	var err error
 
@@ -894,11 +906,13 @@ func (d *Ec2Driver) Create_Volume(params map[string]interface{}) (interface{}, e
 	id := aws.StringValue(output.VolumeId)
 
 	d.logger.Verbosef("create volume '%s' done", id)
-	return id, nil
+	return &driver.Output{ID: id, Properties: map[string]interface{}{
+		"AvailabilityZone": aws.StringValue(output.AvailabilityZone),
+	}}, nil
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Delete_Volume_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Delete_Volume_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.DeleteVolumeInput{}
 	input.DryRun = aws.Bool(true)
 	var err error
@@ -923,7 +937,7 @@ func (d *Ec2Driver) Delete_Volume_DryRun(params map[string]interface{}) (interfa
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Delete_Volume(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Delete_Volume(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.DeleteVolumeInput{}
 	var err error
 
@@ -946,7 +960,7 @@ func (d *Ec2Driver) Delete_Volume(params map[string]interface{}) (interface{}, e
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Attach_Volume_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Attach_Volume_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.AttachVolumeInput{}
 	input.DryRun = aws.Bool(true)
 	var err error
@@ -979,7 +993,7 @@ func (d *Ec2Driver) Attach_Volume_DryRun(params map[string]interface{}) (interfa
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Attach_Volume(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Attach_Volume(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.AttachVolumeInput{}
 	var err error
 
@@ -1012,7 +1026,7 @@ func (d *Ec2Driver) Attach_Volume(params map[string]interface{}) (interface{}, e
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Detach_Volume_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Detach_Volume_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.DetachVolumeInput{}
 	input.DryRun = aws.Bool(true)
 	var err error
@@ -1053,7 +1067,7 @@ func (d *Ec2Driver) Detach_Volume_DryRun(params map[string]interface{}) (interfa
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Detach_Volume(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Detach_Volume(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.DetachVolumeInput{}
 	var err error
 
@@ -1094,7 +1108,301 @@ func (d *Ec2Driver) Detach_Volume(params map[string]interface{}) (interface{}, e
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Create_Internetgateway_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Create_Snapshot_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &ec2.CreateSnapshotInput{}
+	input.DryRun = aws.Bool(true)
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["volume"], input, "VolumeId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["description"]; ok {
+		err = setFieldWithType(params["description"], input, "Description", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = d.CreateSnapshot(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound):
+			id := fakeDryRunId("snapshot")
+			d.logger.Verbose("dry run: create snapshot ok")
+			return id, nil
+		}
+	}
+
+	return nil, fmt.Errorf("dry run: create snapshot: %s", err)
+}
+
+// This function was auto generated
+func (d *Ec2Driver) Create_Snapshot(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &ec2.CreateSnapshotInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["volume"], input, "VolumeId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["description"]; ok {
+		err = setFieldWithType(params["description"], input, "Description", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	var output *ec2.Snapshot
+	output, err = d.CreateSnapshot(input)
+	output = output
+	if err != nil {
+		return nil, fmt.Errorf("create snapshot: %s", err)
+	}
+	d.logger.ExtraVerbosef("ec2.CreateSnapshot call took %s", time.Since(start))
+	id := aws.StringValue(output.SnapshotId)
+
+	d.logger.Verbosef("create snapshot '%s' done", id)
+	return id, nil
+}
+
+// This function was auto generated
+func (d *Ec2Driver) Delete_Snapshot_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &ec2.DeleteSnapshotInput{}
+	input.DryRun = aws.Bool(true)
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "SnapshotId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = d.DeleteSnapshot(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound):
+			id := fakeDryRunId("snapshot")
+			d.logger.Verbose("dry run: delete snapshot ok")
+			return id, nil
+		}
+	}
+
+	return nil, fmt.Errorf("dry run: delete snapshot: %s", err)
+}
+
+// This function was auto generated
+func (d *Ec2Driver) Delete_Snapshot(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &ec2.DeleteSnapshotInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "SnapshotId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	var output *ec2.DeleteSnapshotOutput
+	output, err = d.DeleteSnapshot(input)
+	output = output
+	if err != nil {
+		return nil, fmt.Errorf("delete snapshot: %s", err)
+	}
+	d.logger.ExtraVerbosef("ec2.DeleteSnapshot call took %s", time.Since(start))
+	d.logger.Verbose("delete snapshot done")
+	return output, nil
+}
+
+// This function was auto generated
+func (d *Ec2Driver) Create_Image_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &ec2.CreateImageInput{}
+	input.DryRun = aws.Bool(true)
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["instance"], input, "InstanceId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["name"], input, "Name", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["description"]; ok {
+		err = setFieldWithType(params["description"], input, "Description", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["noreboot"]; ok {
+		err = setFieldWithType(params["noreboot"], input, "NoReboot", awsbool)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = d.CreateImage(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound):
+			id := fakeDryRunId("image")
+			d.logger.Verbose("dry run: create image ok")
+			return id, nil
+		}
+	}
+
+	return nil, fmt.Errorf("dry run: create image: %s", err)
+}
+
+// This function was auto generated
+func (d *Ec2Driver) Create_Image(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &ec2.CreateImageInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["instance"], input, "InstanceId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["name"], input, "Name", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["description"]; ok {
+		err = setFieldWithType(params["description"], input, "Description", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["noreboot"]; ok {
+		err = setFieldWithType(params["noreboot"], input, "NoReboot", awsbool)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	var output *ec2.CreateImageOutput
+	output, err = d.CreateImage(input)
+	output = output
+	if err != nil {
+		return nil, fmt.Errorf("create image: %s", err)
+	}
+	d.logger.ExtraVerbosef("ec2.CreateImage call took %s", time.Since(start))
+	id := aws.StringValue(output.ImageId)
+
+	d.logger.Verbosef("create image '%s' done", id)
+	return id, nil
+}
+
+// This function was auto generated
+func (d *Ec2Driver) Copy_Image_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &ec2.CopyImageInput{}
+	input.DryRun = aws.Bool(true)
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "SourceImageId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["region"], input, "SourceRegion", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["name"], input, "Name", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["description"]; ok {
+		err = setFieldWithType(params["description"], input, "Description", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["encrypted"]; ok {
+		err = setFieldWithType(params["encrypted"], input, "Encrypted", awsbool)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = d.CopyImage(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound):
+			id := fakeDryRunId("image")
+			d.logger.Verbose("dry run: copy image ok")
+			return id, nil
+		}
+	}
+
+	return nil, fmt.Errorf("dry run: copy image: %s", err)
+}
+
+// This function was auto generated
+func (d *Ec2Driver) Copy_Image(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &ec2.CopyImageInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "SourceImageId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["region"], input, "SourceRegion", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["name"], input, "Name", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["description"]; ok {
+		err = setFieldWithType(params["description"], input, "Description", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["encrypted"]; ok {
+		err = setFieldWithType(params["encrypted"], input, "Encrypted", awsbool)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	var output *ec2.CopyImageOutput
+	output, err = d.CopyImage(input)
+	output = output
+	if err != nil {
+		return nil, fmt.Errorf("copy image: %s", err)
+	}
+	d.logger.ExtraVerbosef("ec2.CopyImage call took %s", time.Since(start))
+	id := aws.StringValue(output.ImageId)
+
+	d.logger.Verbosef("copy image '%s' done", id)
+	return id, nil
+}
+
+// This function was auto generated
+func (d *Ec2Driver) Create_Internetgateway_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.CreateInternetGatewayInput{}
 	input.DryRun = aws.Bool(true)
 	var err error
@@ -1113,7 +1421,7 @@ func (d *Ec2Driver) Create_Internetgateway_DryRun(params map[string]interface{})
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Create_Internetgateway(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Create_Internetgateway(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.CreateInternetGatewayInput{}
 	var err error
 
@@ -1132,7 +1440,7 @@ func (d *Ec2Driver) Create_Internetgateway(params map[string]interface{}) (inter
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Delete_Internetgateway_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Delete_Internetgateway_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.DeleteInternetGatewayInput{}
 	input.DryRun = aws.Bool(true)
 	var err error
@@ -1157,7 +1465,7 @@ func (d *Ec2Driver) Delete_Internetgateway_DryRun(params map[string]interface{})
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Delete_Internetgateway(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Delete_Internetgateway(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.DeleteInternetGatewayInput{}
 	var err error
 
@@ -1180,7 +1488,7 @@ func (d *Ec2Driver) Delete_Internetgateway(params map[string]interface{}) (inter
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Attach_Internetgateway_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Attach_Internetgateway_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.AttachInternetGatewayInput{}
 	input.DryRun = aws.Bool(true)
 	var err error
@@ -1209,7 +1517,7 @@ func (d *Ec2Driver) Attach_Internetgateway_DryRun(params map[string]interface{})
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Attach_Internetgateway(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Attach_Internetgateway(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.AttachInternetGatewayInput{}
 	var err error
 
@@ -1236,7 +1544,7 @@ func (d *Ec2Driver) Attach_Internetgateway(params map[string]interface{}) (inter
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Detach_Internetgateway_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Detach_Internetgateway_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.DetachInternetGatewayInput{}
 	input.DryRun = aws.Bool(true)
 	var err error
@@ -1265,7 +1573,7 @@ func (d *Ec2Driver) Detach_Internetgateway_DryRun(params map[string]interface{})
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Detach_Internetgateway(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Detach_Internetgateway(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.DetachInternetGatewayInput{}
 	var err error
 
@@ -1292,7 +1600,7 @@ func (d *Ec2Driver) Detach_Internetgateway(params map[string]interface{}) (inter
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Create_Routetable_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Create_Routetable_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.CreateRouteTableInput{}
 	input.DryRun = aws.Bool(true)
 	var err error
@@ -1317,7 +1625,7 @@ func (d *Ec2Driver) Create_Routetable_DryRun(params map[string]interface{}) (int
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Create_Routetable(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Create_Routetable(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.CreateRouteTableInput{}
 	var err error
 
@@ -1342,7 +1650,7 @@ func (d *Ec2Driver) Create_Routetable(params map[string]interface{}) (interface{
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Delete_Routetable_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Delete_Routetable_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.DeleteRouteTableInput{}
 	input.DryRun = aws.Bool(true)
 	var err error
@@ -1367,7 +1675,7 @@ func (d *Ec2Driver) Delete_Routetable_DryRun(params map[string]interface{}) (int
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Delete_Routetable(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Delete_Routetable(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.DeleteRouteTableInput{}
 	var err error
 
@@ -1390,7 +1698,7 @@ func (d *Ec2Driver) Delete_Routetable(params map[string]interface{}) (interface{
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Attach_Routetable_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Attach_Routetable_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.AssociateRouteTableInput{}
 	input.DryRun = aws.Bool(true)
 	var err error
@@ -1419,7 +1727,7 @@ func (d *Ec2Driver) Attach_Routetable_DryRun(params map[string]interface{}) (int
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Attach_Routetable(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Attach_Routetable(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.AssociateRouteTableInput{}
 	var err error
 
@@ -1448,7 +1756,7 @@ func (d *Ec2Driver) Attach_Routetable(params map[string]interface{}) (interface{
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Detach_Routetable_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Detach_Routetable_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.DisassociateRouteTableInput{}
 	input.DryRun = aws.Bool(true)
 	var err error
@@ -1473,7 +1781,7 @@ func (d *Ec2Driver) Detach_Routetable_DryRun(params map[string]interface{}) (int
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Detach_Routetable(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Detach_Routetable(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.DisassociateRouteTableInput{}
 	var err error
 
@@ -1496,7 +1804,7 @@ func (d *Ec2Driver) Detach_Routetable(params map[string]interface{}) (interface{
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Create_Route_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Create_Route_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.CreateRouteInput{}
 	input.DryRun = aws.Bool(true)
 	var err error
@@ -1529,7 +1837,7 @@ func (d *Ec2Driver) Create_Route_DryRun(params map[string]interface{}) (interfac
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Create_Route(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Create_Route(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.CreateRouteInput{}
 	var err error
 
@@ -1560,7 +1868,7 @@ func (d *Ec2Driver) Create_Route(params map[string]interface{}) (interface{}, er
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Delete_Route_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Delete_Route_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.DeleteRouteInput{}
 	input.DryRun = aws.Bool(true)
 	var err error
@@ -1589,7 +1897,7 @@ func (d *Ec2Driver) Delete_Route_DryRun(params map[string]interface{}) (interfac
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Delete_Route(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Delete_Route(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &ec2.DeleteRouteInput{}
 	var err error
 
@@ -1616,843 +1924,1905 @@ func (d *Ec2Driver) Delete_Route(params map[string]interface{}) (interface{}, er
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Delete_Keypair_DryRun(params map[string]interface{}) (interface{}, error) {
-	input := &ec2.DeleteKeyPairInput{}
+func (d *Ec2Driver) Create_Elasticip_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &ec2.AllocateAddressInput{}
 	input.DryRun = aws.Bool(true)
 	var err error
 
 	// Required params
-	err = setFieldWithType(params["id"], input, "KeyName", awsstr)
+	err = setFieldWithType(params["domain"], input, "Domain", awsstr)
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = d.DeleteKeyPair(input)
+	_, err = d.AllocateAddress(input)
 	if awsErr, ok := err.(awserr.Error); ok {
 		switch code := awsErr.Code(); {
 		case code == dryRunOperation, strings.HasSuffix(code, notFound):
-			id := fakeDryRunId("keypair")
-			d.logger.Verbose("dry run: delete keypair ok")
+			id := fakeDryRunId("elasticip")
+			d.logger.Verbose("dry run: create elasticip ok")
 			return id, nil
 		}
 	}
 
-	return nil, fmt.Errorf("dry run: delete keypair: %s", err)
+	return nil, fmt.Errorf("dry run: create elasticip: %s", err)
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Delete_Keypair(params map[string]interface{}) (interface{}, error) {
-	input := &ec2.DeleteKeyPairInput{}
+func (d *Ec2Driver) Create_Elasticip(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &ec2.AllocateAddressInput{}
 	var err error
 
 	// Required params
-	err = setFieldWithType(params["id"], input, "KeyName", awsstr)
+	err = setFieldWithType(params["domain"], input, "Domain", awsstr)
 	if err != nil {
 		return nil, err
 	}
 
 	start := time.Now()
-	var output *ec2.DeleteKeyPairOutput
-	output, err = d.DeleteKeyPair(input)
+	var output *ec2.AllocateAddressOutput
+	output, err = d.AllocateAddress(input)
 	output = output
 	if err != nil {
-		return nil, fmt.Errorf("delete keypair: %s", err)
+		return nil, fmt.Errorf("create elasticip: %s", err)
 	}
-	d.logger.ExtraVerbosef("ec2.DeleteKeyPair call took %s", time.Since(start))
-	d.logger.Verbose("delete keypair done")
-	return output, nil
+	d.logger.ExtraVerbosef("ec2.AllocateAddress call took %s", time.Since(start))
+	id := aws.StringValue(output.AllocationId)
+
+	d.logger.Verbosef("create elasticip '%s' done", id)
+	return &driver.Output{ID: id, Properties: map[string]interface{}{
+		"PublicIP": aws.StringValue(output.PublicIp),
+	}}, nil
 }
 
 // This function was auto generated
-func (d *Elbv2Driver) Create_Loadbalancer_DryRun(params map[string]interface{}) (interface{}, error) {
-	if _, ok := params["name"]; !ok {
-		return nil, errors.New("create loadbalancer: missing required params 'name'")
+func (d *Ec2Driver) Delete_Elasticip_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &ec2.ReleaseAddressInput{}
+	input.DryRun = aws.Bool(true)
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "AllocationId", awsstr)
+	if err != nil {
+		return nil, err
 	}
 
-	if _, ok := params["subnets"]; !ok {
-		return nil, errors.New("create loadbalancer: missing required params 'subnets'")
+	_, err = d.ReleaseAddress(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound):
+			id := fakeDryRunId("elasticip")
+			d.logger.Verbose("dry run: delete elasticip ok")
+			return id, nil
+		}
 	}
 
-	d.logger.Verbose("params dry run: create loadbalancer ok")
-	return nil, nil
+	return nil, fmt.Errorf("dry run: delete elasticip: %s", err)
 }
 
 // This function was auto generated
-func (d *Elbv2Driver) Create_Loadbalancer(params map[string]interface{}) (interface{}, error) {
-	input := &elbv2.CreateLoadBalancerInput{}
+func (d *Ec2Driver) Delete_Elasticip(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &ec2.ReleaseAddressInput{}
 	var err error
 
 	// Required params
-	err = setFieldWithType(params["name"], input, "Name", awsstr)
+	err = setFieldWithType(params["id"], input, "AllocationId", awsstr)
 	if err != nil {
 		return nil, err
 	}
-	err = setFieldWithType(params["subnets"], input, "Subnets", awsstringslice)
+
+	start := time.Now()
+	var output *ec2.ReleaseAddressOutput
+	output, err = d.ReleaseAddress(input)
+	output = output
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("delete elasticip: %s", err)
+	}
+	d.logger.ExtraVerbosef("ec2.ReleaseAddress call took %s", time.Since(start))
+	d.logger.Verbose("delete elasticip done")
+	return output, nil
+}
+
+// This function was auto generated
+func (d *Ec2Driver) Attach_Elasticip_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &ec2.AssociateAddressInput{}
+	input.DryRun = aws.Bool(true)
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "AllocationId", awsstr)
+	if err != nil {
+		return nil, err
 	}
 
 	// Extra params
-	if _, ok := params["iptype"]; ok {
-		err = setFieldWithType(params["iptype"], input, "IpAddressType", awsstr)
+	if _, ok := params["instance"]; ok {
+		err = setFieldWithType(params["instance"], input, "InstanceId", awsstr)
 		if err != nil {
 			return nil, err
 		}
 	}
-	if _, ok := params["scheme"]; ok {
-		err = setFieldWithType(params["scheme"], input, "Scheme", awsstr)
+	if _, ok := params["networkinterface"]; ok {
+		err = setFieldWithType(params["networkinterface"], input, "NetworkInterfaceId", awsstr)
 		if err != nil {
 			return nil, err
 		}
 	}
-	if _, ok := params["groups"]; ok {
-		err = setFieldWithType(params["groups"], input, "SecurityGroups", awsstringslice)
+	if _, ok := params["privateip"]; ok {
+		err = setFieldWithType(params["privateip"], input, "PrivateIpAddress", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["allowreassociation"]; ok {
+		err = setFieldWithType(params["allowreassociation"], input, "AllowReassociation", awsbool)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = d.AssociateAddress(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound):
+			id := fakeDryRunId("elasticip")
+			d.logger.Verbose("dry run: attach elasticip ok")
+			return id, nil
+		}
+	}
+
+	return nil, fmt.Errorf("dry run: attach elasticip: %s", err)
+}
+
+// This function was auto generated
+func (d *Ec2Driver) Attach_Elasticip(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &ec2.AssociateAddressInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "AllocationId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["instance"]; ok {
+		err = setFieldWithType(params["instance"], input, "InstanceId", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["networkinterface"]; ok {
+		err = setFieldWithType(params["networkinterface"], input, "NetworkInterfaceId", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["privateip"]; ok {
+		err = setFieldWithType(params["privateip"], input, "PrivateIpAddress", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["allowreassociation"]; ok {
+		err = setFieldWithType(params["allowreassociation"], input, "AllowReassociation", awsbool)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	start := time.Now()
-	var output *elbv2.CreateLoadBalancerOutput
-	output, err = d.CreateLoadBalancer(input)
+	var output *ec2.AssociateAddressOutput
+	output, err = d.AssociateAddress(input)
 	output = output
 	if err != nil {
-		return nil, fmt.Errorf("create loadbalancer: %s", err)
+		return nil, fmt.Errorf("attach elasticip: %s", err)
 	}
-	d.logger.ExtraVerbosef("elbv2.CreateLoadBalancer call took %s", time.Since(start))
-	id := aws.StringValue(output.LoadBalancers[0].LoadBalancerArn)
+	d.logger.ExtraVerbosef("ec2.AssociateAddress call took %s", time.Since(start))
+	id := aws.StringValue(output.AssociationId)
 
-	d.logger.Verbosef("create loadbalancer '%s' done", id)
+	d.logger.Verbosef("attach elasticip '%s' done", id)
 	return id, nil
 }
 
 // This function was auto generated
-func (d *Elbv2Driver) Delete_Loadbalancer_DryRun(params map[string]interface{}) (interface{}, error) {
-	if _, ok := params["id"]; !ok {
-		return nil, errors.New("delete loadbalancer: missing required params 'id'")
+func (d *Ec2Driver) Detach_Elasticip_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &ec2.DisassociateAddressInput{}
+	input.DryRun = aws.Bool(true)
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["association"], input, "AssociationId", awsstr)
+	if err != nil {
+		return nil, err
 	}
 
-	d.logger.Verbose("params dry run: delete loadbalancer ok")
-	return nil, nil
+	_, err = d.DisassociateAddress(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound):
+			id := fakeDryRunId("elasticip")
+			d.logger.Verbose("dry run: detach elasticip ok")
+			return id, nil
+		}
+	}
+
+	return nil, fmt.Errorf("dry run: detach elasticip: %s", err)
 }
 
 // This function was auto generated
-func (d *Elbv2Driver) Delete_Loadbalancer(params map[string]interface{}) (interface{}, error) {
-	input := &elbv2.DeleteLoadBalancerInput{}
+func (d *Ec2Driver) Detach_Elasticip(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &ec2.DisassociateAddressInput{}
 	var err error
 
 	// Required params
-	err = setFieldWithType(params["id"], input, "LoadBalancerArn", awsstr)
+	err = setFieldWithType(params["association"], input, "AssociationId", awsstr)
 	if err != nil {
 		return nil, err
 	}
 
 	start := time.Now()
-	var output *elbv2.DeleteLoadBalancerOutput
-	output, err = d.DeleteLoadBalancer(input)
+	var output *ec2.DisassociateAddressOutput
+	output, err = d.DisassociateAddress(input)
 	output = output
 	if err != nil {
-		return nil, fmt.Errorf("delete loadbalancer: %s", err)
+		return nil, fmt.Errorf("detach elasticip: %s", err)
 	}
-	d.logger.ExtraVerbosef("elbv2.DeleteLoadBalancer call took %s", time.Since(start))
-	d.logger.Verbose("delete loadbalancer done")
+	d.logger.ExtraVerbosef("ec2.DisassociateAddress call took %s", time.Since(start))
+	d.logger.Verbose("detach elasticip done")
 	return output, nil
 }
 
 // This function was auto generated
-func (d *Elbv2Driver) Create_Listener_DryRun(params map[string]interface{}) (interface{}, error) {
-	if _, ok := params["actiontype"]; !ok {
-		return nil, errors.New("create listener: missing required params 'actiontype'")
-	}
-
-	if _, ok := params["target"]; !ok {
-		return nil, errors.New("create listener: missing required params 'target'")
-	}
-
-	if _, ok := params["loadbalancer"]; !ok {
-		return nil, errors.New("create listener: missing required params 'loadbalancer'")
-	}
-
-	if _, ok := params["port"]; !ok {
-		return nil, errors.New("create listener: missing required params 'port'")
+func (d *Ec2Driver) Create_Natgateway_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["elasticip"]; !ok {
+		return nil, errors.New("create natgateway: missing required params 'elasticip'")
 	}
 
-	if _, ok := params["protocol"]; !ok {
-		return nil, errors.New("create listener: missing required params 'protocol'")
+	if _, ok := params["subnet"]; !ok {
+		return nil, errors.New("create natgateway: missing required params 'subnet'")
 	}
 
-	d.logger.Verbose("params dry run: create listener ok")
+	d.logger.Verbose("params dry run: create natgateway ok")
 	return nil, nil
 }
 
 // This function was auto generated
-func (d *Elbv2Driver) Create_Listener(params map[string]interface{}) (interface{}, error) {
-	input := &elbv2.CreateListenerInput{}
+func (d *Ec2Driver) Create_Natgateway(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &ec2.CreateNatGatewayInput{}
 	var err error
 
 	// Required params
-	err = setFieldWithType(params["actiontype"], input, "DefaultActions[0]Type", awsslicestruct)
-	if err != nil {
-		return nil, err
-	}
-	err = setFieldWithType(params["target"], input, "DefaultActions[0]TargetGroupArn", awsslicestruct)
+	err = setFieldWithType(params["elasticip"], input, "AllocationId", awsstr)
 	if err != nil {
 		return nil, err
 	}
-	err = setFieldWithType(params["loadbalancer"], input, "LoadBalancerArn", awsstr)
-	if err != nil {
-		return nil, err
-	}
-	err = setFieldWithType(params["port"], input, "Port", awsint64)
-	if err != nil {
-		return nil, err
-	}
-	err = setFieldWithType(params["protocol"], input, "Protocol", awsstr)
+	err = setFieldWithType(params["subnet"], input, "SubnetId", awsstr)
 	if err != nil {
 		return nil, err
 	}
 
-	// Extra params
-	if _, ok := params["certificate"]; ok {
-		err = setFieldWithType(params["certificate"], input, "Certificates[0]CertificateArn", awsslicestruct)
-		if err != nil {
-			return nil, err
-		}
-	}
-	if _, ok := params["sslpolicy"]; ok {
-		err = setFieldWithType(params["sslpolicy"], input, "SslPolicy", awsstr)
-		if err != nil {
-			return nil, err
-		}
-	}
-
 	start := time.Now()
-	var output *elbv2.CreateListenerOutput
-	output, err = d.CreateListener(input)
+	var output *ec2.CreateNatGatewayOutput
+	output, err = d.CreateNatGateway(input)
 	output = output
 	if err != nil {
-		return nil, fmt.Errorf("create listener: %s", err)
+		return nil, fmt.Errorf("create natgateway: %s", err)
 	}
-	d.logger.ExtraVerbosef("elbv2.CreateListener call took %s", time.Since(start))
-	id := aws.StringValue(output.Listeners[0].ListenerArn)
+	d.logger.ExtraVerbosef("ec2.CreateNatGateway call took %s", time.Since(start))
+	id := aws.StringValue(output.NatGateway.NatGatewayId)
 
-	d.logger.Verbosef("create listener '%s' done", id)
+	d.logger.Verbosef("create natgateway '%s' done", id)
 	return id, nil
 }
 
 // This function was auto generated
-func (d *Elbv2Driver) Delete_Listener_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *Ec2Driver) Delete_Natgateway_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	if _, ok := params["id"]; !ok {
-		return nil, errors.New("delete listener: missing required params 'id'")
+		return nil, errors.New("delete natgateway: missing required params 'id'")
 	}
 
-	d.logger.Verbose("params dry run: delete listener ok")
+	d.logger.Verbose("params dry run: delete natgateway ok")
 	return nil, nil
 }
 
 // This function was auto generated
-func (d *Elbv2Driver) Delete_Listener(params map[string]interface{}) (interface{}, error) {
-	input := &elbv2.DeleteListenerInput{}
+func (d *Ec2Driver) Delete_Natgateway(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &ec2.DeleteNatGatewayInput{}
 	var err error
 
 	// Required params
-	err = setFieldWithType(params["id"], input, "ListenerArn", awsstr)
+	err = setFieldWithType(params["id"], input, "NatGatewayId", awsstr)
 	if err != nil {
 		return nil, err
 	}
 
 	start := time.Now()
-	var output *elbv2.DeleteListenerOutput
-	output, err = d.DeleteListener(input)
+	var output *ec2.DeleteNatGatewayOutput
+	output, err = d.DeleteNatGateway(input)
 	output = output
 	if err != nil {
-		return nil, fmt.Errorf("delete listener: %s", err)
+		return nil, fmt.Errorf("delete natgateway: %s", err)
 	}
-	d.logger.ExtraVerbosef("elbv2.DeleteListener call took %s", time.Since(start))
-	d.logger.Verbose("delete listener done")
+	d.logger.ExtraVerbosef("ec2.DeleteNatGateway call took %s", time.Since(start))
+	d.logger.Verbose("delete natgateway done")
 	return output, nil
 }
 
 // This function was auto generated
-func (d *Elbv2Driver) Create_Targetgroup_DryRun(params map[string]interface{}) (interface{}, error) {
-	if _, ok := params["name"]; !ok {
-		return nil, errors.New("create targetgroup: missing required params 'name'")
-	}
-
-	if _, ok := params["port"]; !ok {
-		return nil, errors.New("create targetgroup: missing required params 'port'")
-	}
-
-	if _, ok := params["protocol"]; !ok {
-		return nil, errors.New("create targetgroup: missing required params 'protocol'")
-	}
-
-	if _, ok := params["vpc"]; !ok {
-		return nil, errors.New("create targetgroup: missing required params 'vpc'")
-	}
-
-	d.logger.Verbose("params dry run: create targetgroup ok")
-	return nil, nil
-}
-
-// This function was auto generated
-func (d *Elbv2Driver) Create_Targetgroup(params map[string]interface{}) (interface{}, error) {
-	input := &elbv2.CreateTargetGroupInput{}
+func (d *Ec2Driver) Create_Networkinterface_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &ec2.CreateNetworkInterfaceInput{}
+	input.DryRun = aws.Bool(true)
 	var err error
 
 	// Required params
-	err = setFieldWithType(params["name"], input, "Name", awsstr)
-	if err != nil {
-		return nil, err
-	}
-	err = setFieldWithType(params["port"], input, "Port", awsint64)
-	if err != nil {
-		return nil, err
-	}
-	err = setFieldWithType(params["protocol"], input, "Protocol", awsstr)
-	if err != nil {
-		return nil, err
-	}
-	err = setFieldWithType(params["vpc"], input, "VpcId", awsstr)
+	err = setFieldWithType(params["subnet"], input, "SubnetId", awsstr)
 	if err != nil {
 		return nil, err
 	}
 
 	// Extra params
-	if _, ok := params["healthcheckinterval"]; ok {
-		err = setFieldWithType(params["healthcheckinterval"], input, "HealthCheckIntervalSeconds", awsint64)
+	if _, ok := params["description"]; ok {
+		err = setFieldWithType(params["description"], input, "Description", awsstr)
 		if err != nil {
 			return nil, err
 		}
 	}
-	if _, ok := params["healthcheckpath"]; ok {
-		err = setFieldWithType(params["healthcheckpath"], input, "HealthCheckPath", awsstr)
+	if _, ok := params["group"]; ok {
+		err = setFieldWithType(params["group"], input, "Groups", awsstringslice)
 		if err != nil {
 			return nil, err
 		}
 	}
-	if _, ok := params["healthcheckport"]; ok {
-		err = setFieldWithType(params["healthcheckport"], input, "HealthCheckPort", awsstr)
+	if _, ok := params["privateip"]; ok {
+		err = setFieldWithType(params["privateip"], input, "PrivateIpAddress", awsstr)
 		if err != nil {
 			return nil, err
 		}
 	}
-	if _, ok := params["healthcheckprotocol"]; ok {
-		err = setFieldWithType(params["healthcheckprotocol"], input, "HealthCheckProtocol", awsstr)
-		if err != nil {
-			return nil, err
+
+	_, err = d.CreateNetworkInterface(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound):
+			id := fakeDryRunId("networkinterface")
+			d.logger.Verbose("dry run: create networkinterface ok")
+			return id, nil
 		}
 	}
-	if _, ok := params["healthchecktimeout"]; ok {
-		err = setFieldWithType(params["healthchecktimeout"], input, "HealthCheckTimeoutSeconds", awsint64)
-		if err != nil {
-			return nil, err
-		}
+
+	return nil, fmt.Errorf("dry run: create networkinterface: %s", err)
+}
+
+// This function was auto generated
+func (d *Ec2Driver) Create_Networkinterface(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &ec2.CreateNetworkInterfaceInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["subnet"], input, "SubnetId", awsstr)
+	if err != nil {
+		return nil, err
 	}
-	if _, ok := params["healthythreshold"]; ok {
-		err = setFieldWithType(params["healthythreshold"], input, "HealthyThresholdCount", awsint64)
+
+	// Extra params
+	if _, ok := params["description"]; ok {
+		err = setFieldWithType(params["description"], input, "Description", awsstr)
 		if err != nil {
 			return nil, err
 		}
 	}
-	if _, ok := params["unhealthythreshold"]; ok {
-		err = setFieldWithType(params["unhealthythreshold"], input, "UnhealthyThresholdCount", awsint64)
+	if _, ok := params["group"]; ok {
+		err = setFieldWithType(params["group"], input, "Groups", awsstringslice)
 		if err != nil {
 			return nil, err
 		}
 	}
-	if _, ok := params["matcher"]; ok {
-		err = setFieldWithType(params["matcher"], input, "Matcher.HttpCode", awsstr)
+	if _, ok := params["privateip"]; ok {
+		err = setFieldWithType(params["privateip"], input, "PrivateIpAddress", awsstr)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	start := time.Now()
-	var output *elbv2.CreateTargetGroupOutput
-	output, err = d.CreateTargetGroup(input)
+	var output *ec2.CreateNetworkInterfaceOutput
+	output, err = d.CreateNetworkInterface(input)
 	output = output
 	if err != nil {
-		return nil, fmt.Errorf("create targetgroup: %s", err)
+		return nil, fmt.Errorf("create networkinterface: %s", err)
 	}
-	d.logger.ExtraVerbosef("elbv2.CreateTargetGroup call took %s", time.Since(start))
-	id := aws.StringValue(output.TargetGroups[0].TargetGroupArn)
+	d.logger.ExtraVerbosef("ec2.CreateNetworkInterface call took %s", time.Since(start))
+	id := aws.StringValue(output.NetworkInterface.NetworkInterfaceId)
 
-	d.logger.Verbosef("create targetgroup '%s' done", id)
-	return id, nil
+	d.logger.Verbosef("create networkinterface '%s' done", id)
+	return &driver.Output{ID: id, Properties: map[string]interface{}{
+		"PrivateIP": aws.StringValue(output.NetworkInterface.PrivateIpAddress),
+	}}, nil
 }
 
 // This function was auto generated
-func (d *Elbv2Driver) Delete_Targetgroup_DryRun(params map[string]interface{}) (interface{}, error) {
-	if _, ok := params["id"]; !ok {
-		return nil, errors.New("delete targetgroup: missing required params 'id'")
+func (d *Ec2Driver) Delete_Networkinterface_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &ec2.DeleteNetworkInterfaceInput{}
+	input.DryRun = aws.Bool(true)
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "NetworkInterfaceId", awsstr)
+	if err != nil {
+		return nil, err
 	}
 
-	d.logger.Verbose("params dry run: delete targetgroup ok")
-	return nil, nil
+	_, err = d.DeleteNetworkInterface(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound):
+			id := fakeDryRunId("networkinterface")
+			d.logger.Verbose("dry run: delete networkinterface ok")
+			return id, nil
+		}
+	}
+
+	return nil, fmt.Errorf("dry run: delete networkinterface: %s", err)
 }
 
 // This function was auto generated
-func (d *Elbv2Driver) Delete_Targetgroup(params map[string]interface{}) (interface{}, error) {
-	input := &elbv2.DeleteTargetGroupInput{}
+func (d *Ec2Driver) Delete_Networkinterface(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &ec2.DeleteNetworkInterfaceInput{}
 	var err error
 
 	// Required params
-	err = setFieldWithType(params["id"], input, "TargetGroupArn", awsstr)
+	err = setFieldWithType(params["id"], input, "NetworkInterfaceId", awsstr)
 	if err != nil {
 		return nil, err
 	}
 
 	start := time.Now()
-	var output *elbv2.DeleteTargetGroupOutput
-	output, err = d.DeleteTargetGroup(input)
+	var output *ec2.DeleteNetworkInterfaceOutput
+	output, err = d.DeleteNetworkInterface(input)
 	output = output
 	if err != nil {
-		return nil, fmt.Errorf("delete targetgroup: %s", err)
+		return nil, fmt.Errorf("delete networkinterface: %s", err)
 	}
-	d.logger.ExtraVerbosef("elbv2.DeleteTargetGroup call took %s", time.Since(start))
-	d.logger.Verbose("delete targetgroup done")
+	d.logger.ExtraVerbosef("ec2.DeleteNetworkInterface call took %s", time.Since(start))
+	d.logger.Verbose("delete networkinterface done")
 	return output, nil
 }
 
 // This function was auto generated
-func (d *Elbv2Driver) Attach_Instance_DryRun(params map[string]interface{}) (interface{}, error) {
-	if _, ok := params["group"]; !ok {
-		return nil, errors.New("attach instance: missing required params 'group'")
+func (d *Ec2Driver) Attach_Networkinterface_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &ec2.AttachNetworkInterfaceInput{}
+	input.DryRun = aws.Bool(true)
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "NetworkInterfaceId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["instance"], input, "InstanceId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["deviceindex"], input, "DeviceIndex", awsint64)
+	if err != nil {
+		return nil, err
 	}
 
-	if _, ok := params["id"]; !ok {
-		return nil, errors.New("attach instance: missing required params 'id'")
+	_, err = d.AttachNetworkInterface(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound):
+			id := fakeDryRunId("networkinterface")
+			d.logger.Verbose("dry run: attach networkinterface ok")
+			return id, nil
+		}
 	}
 
-	d.logger.Verbose("params dry run: attach instance ok")
-	return nil, nil
+	return nil, fmt.Errorf("dry run: attach networkinterface: %s", err)
 }
 
 // This function was auto generated
-func (d *Elbv2Driver) Attach_Instance(params map[string]interface{}) (interface{}, error) {
-	input := &elbv2.RegisterTargetsInput{}
+func (d *Ec2Driver) Attach_Networkinterface(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &ec2.AttachNetworkInterfaceInput{}
 	var err error
 
 	// Required params
-	err = setFieldWithType(params["group"], input, "TargetGroupArn", awsstr)
+	err = setFieldWithType(params["id"], input, "NetworkInterfaceId", awsstr)
 	if err != nil {
 		return nil, err
 	}
-	err = setFieldWithType(params["id"], input, "Targets[0]Id", awsslicestruct)
+	err = setFieldWithType(params["instance"], input, "InstanceId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["deviceindex"], input, "DeviceIndex", awsint64)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	var output *ec2.AttachNetworkInterfaceOutput
+	output, err = d.AttachNetworkInterface(input)
+	output = output
+	if err != nil {
+		return nil, fmt.Errorf("attach networkinterface: %s", err)
+	}
+	d.logger.ExtraVerbosef("ec2.AttachNetworkInterface call took %s", time.Since(start))
+	id := aws.StringValue(output.AttachmentId)
+
+	d.logger.Verbosef("attach networkinterface '%s' done", id)
+	return id, nil
+}
+
+// This function was auto generated
+func (d *Ec2Driver) Detach_Networkinterface_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &ec2.DetachNetworkInterfaceInput{}
+	input.DryRun = aws.Bool(true)
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["attachment"], input, "AttachmentId", awsstr)
 	if err != nil {
 		return nil, err
 	}
 
 	// Extra params
-	if _, ok := params["port"]; ok {
-		err = setFieldWithType(params["port"], input, "Targets[0]Port", awsslicestruct)
+	if _, ok := params["force"]; ok {
+		err = setFieldWithType(params["force"], input, "Force", awsbool)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = d.DetachNetworkInterface(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound):
+			id := fakeDryRunId("networkinterface")
+			d.logger.Verbose("dry run: detach networkinterface ok")
+			return id, nil
+		}
+	}
+
+	return nil, fmt.Errorf("dry run: detach networkinterface: %s", err)
+}
+
+// This function was auto generated
+func (d *Ec2Driver) Detach_Networkinterface(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &ec2.DetachNetworkInterfaceInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["attachment"], input, "AttachmentId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["force"]; ok {
+		err = setFieldWithType(params["force"], input, "Force", awsbool)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	start := time.Now()
-	var output *elbv2.RegisterTargetsOutput
-	output, err = d.RegisterTargets(input)
+	var output *ec2.DetachNetworkInterfaceOutput
+	output, err = d.DetachNetworkInterface(input)
 	output = output
 	if err != nil {
-		return nil, fmt.Errorf("attach instance: %s", err)
+		return nil, fmt.Errorf("detach networkinterface: %s", err)
 	}
-	d.logger.ExtraVerbosef("elbv2.RegisterTargets call took %s", time.Since(start))
-	d.logger.Verbose("attach instance done")
+	d.logger.ExtraVerbosef("ec2.DetachNetworkInterface call took %s", time.Since(start))
+	d.logger.Verbose("detach networkinterface done")
 	return output, nil
 }
 
 // This function was auto generated
-func (d *Elbv2Driver) Detach_Instance_DryRun(params map[string]interface{}) (interface{}, error) {
-	if _, ok := params["group"]; !ok {
-		return nil, errors.New("detach instance: missing required params 'group'")
+func (d *Ec2Driver) Delete_Keypair_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &ec2.DeleteKeyPairInput{}
+	input.DryRun = aws.Bool(true)
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "KeyName", awsstr)
+	if err != nil {
+		return nil, err
 	}
 
-	if _, ok := params["id"]; !ok {
-		return nil, errors.New("detach instance: missing required params 'id'")
+	_, err = d.DeleteKeyPair(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound):
+			id := fakeDryRunId("keypair")
+			d.logger.Verbose("dry run: delete keypair ok")
+			return id, nil
+		}
 	}
 
-	d.logger.Verbose("params dry run: detach instance ok")
-	return nil, nil
+	return nil, fmt.Errorf("dry run: delete keypair: %s", err)
 }
 
 // This function was auto generated
-func (d *Elbv2Driver) Detach_Instance(params map[string]interface{}) (interface{}, error) {
-	input := &elbv2.DeregisterTargetsInput{}
+func (d *Ec2Driver) Delete_Keypair(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &ec2.DeleteKeyPairInput{}
 	var err error
 
 	// Required params
-	err = setFieldWithType(params["group"], input, "TargetGroupArn", awsstr)
-	if err != nil {
-		return nil, err
-	}
-	err = setFieldWithType(params["id"], input, "Targets[0]Id", awsslicestruct)
+	err = setFieldWithType(params["id"], input, "KeyName", awsstr)
 	if err != nil {
 		return nil, err
 	}
 
 	start := time.Now()
-	var output *elbv2.DeregisterTargetsOutput
-	output, err = d.DeregisterTargets(input)
+	var output *ec2.DeleteKeyPairOutput
+	output, err = d.DeleteKeyPair(input)
 	output = output
 	if err != nil {
-		return nil, fmt.Errorf("detach instance: %s", err)
+		return nil, fmt.Errorf("delete keypair: %s", err)
 	}
-	d.logger.ExtraVerbosef("elbv2.DeregisterTargets call took %s", time.Since(start))
-	d.logger.Verbose("detach instance done")
+	d.logger.ExtraVerbosef("ec2.DeleteKeyPair call took %s", time.Since(start))
+	d.logger.Verbose("delete keypair done")
 	return output, nil
 }
 
 // This function was auto generated
-func (d *RdsDriver) Create_Database_DryRun(params map[string]interface{}) (interface{}, error) {
-	if _, ok := params["type"]; !ok {
-		return nil, errors.New("create database: missing required params 'type'")
+func (d *Elbv2Driver) Create_Loadbalancer_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["name"]; !ok {
+		return nil, errors.New("create loadbalancer: missing required params 'name'")
 	}
 
-	if _, ok := params["id"]; !ok {
-		return nil, errors.New("create database: missing required params 'id'")
+	if _, ok := params["subnets"]; !ok {
+		return nil, errors.New("create loadbalancer: missing required params 'subnets'")
 	}
 
-	if _, ok := params["engine"]; !ok {
-		return nil, errors.New("create database: missing required params 'engine'")
+	d.logger.Verbose("params dry run: create loadbalancer ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *Elbv2Driver) Create_Loadbalancer(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &elbv2.CreateLoadBalancerInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["name"], input, "Name", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["subnets"], input, "Subnets", awsstringslice)
+	if err != nil {
+		return nil, err
 	}
 
-	if _, ok := params["password"]; !ok {
-		return nil, errors.New("create database: missing required params 'password'")
+	// Extra params
+	if _, ok := params["iptype"]; ok {
+		err = setFieldWithType(params["iptype"], input, "IpAddressType", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["scheme"]; ok {
+		err = setFieldWithType(params["scheme"], input, "Scheme", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["groups"]; ok {
+		err = setFieldWithType(params["groups"], input, "SecurityGroups", awsstringslice)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	if _, ok := params["username"]; !ok {
-		return nil, errors.New("create database: missing required params 'username'")
+	start := time.Now()
+	var output *elbv2.CreateLoadBalancerOutput
+	output, err = d.CreateLoadBalancer(input)
+	output = output
+	if err != nil {
+		return nil, fmt.Errorf("create loadbalancer: %s", err)
 	}
+	d.logger.ExtraVerbosef("elbv2.CreateLoadBalancer call took %s", time.Since(start))
+	id := aws.StringValue(output.LoadBalancers[0].LoadBalancerArn)
 
-	if _, ok := params["size"]; !ok {
-		return nil, errors.New("create database: missing required params 'size'")
+	d.logger.Verbosef("create loadbalancer '%s' done", id)
+	return &driver.Output{ID: id, Properties: map[string]interface{}{
+		"CanonicalHostedZoneID": aws.StringValue(output.LoadBalancers[0].CanonicalHostedZoneId),
+		"DNSName":               aws.StringValue(output.LoadBalancers[0].DNSName),
+	}}, nil
+}
+
+// This function was auto generated
+func (d *Elbv2Driver) Delete_Loadbalancer_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["id"]; !ok {
+		return nil, errors.New("delete loadbalancer: missing required params 'id'")
 	}
 
-	d.logger.Verbose("params dry run: create database ok")
+	d.logger.Verbose("params dry run: delete loadbalancer ok")
 	return nil, nil
 }
 
 // This function was auto generated
-func (d *RdsDriver) Create_Database(params map[string]interface{}) (interface{}, error) {
-	input := &rds.CreateDBInstanceInput{}
+func (d *Elbv2Driver) Delete_Loadbalancer(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &elbv2.DeleteLoadBalancerInput{}
 	var err error
 
 	// Required params
-	err = setFieldWithType(params["type"], input, "DBInstanceClass", awsstr)
+	err = setFieldWithType(params["id"], input, "LoadBalancerArn", awsstr)
 	if err != nil {
 		return nil, err
 	}
-	err = setFieldWithType(params["id"], input, "DBInstanceIdentifier", awsstr)
+
+	start := time.Now()
+	var output *elbv2.DeleteLoadBalancerOutput
+	output, err = d.DeleteLoadBalancer(input)
+	output = output
+	if err != nil {
+		return nil, fmt.Errorf("delete loadbalancer: %s", err)
+	}
+	d.logger.ExtraVerbosef("elbv2.DeleteLoadBalancer call took %s", time.Since(start))
+	d.logger.Verbose("delete loadbalancer done")
+	return output, nil
+}
+
+// This function was auto generated
+func (d *Elbv2Driver) Create_Listener_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["actiontype"]; !ok {
+		return nil, errors.New("create listener: missing required params 'actiontype'")
+	}
+
+	if _, ok := params["target"]; !ok {
+		return nil, errors.New("create listener: missing required params 'target'")
+	}
+
+	if _, ok := params["loadbalancer"]; !ok {
+		return nil, errors.New("create listener: missing required params 'loadbalancer'")
+	}
+
+	if _, ok := params["port"]; !ok {
+		return nil, errors.New("create listener: missing required params 'port'")
+	}
+
+	if _, ok := params["protocol"]; !ok {
+		return nil, errors.New("create listener: missing required params 'protocol'")
+	}
+
+	d.logger.Verbose("params dry run: create listener ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *Elbv2Driver) Create_Listener(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &elbv2.CreateListenerInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["actiontype"], input, "DefaultActions[0]Type", awsslicestruct)
 	if err != nil {
 		return nil, err
 	}
-	err = setFieldWithType(params["engine"], input, "Engine", awsstr)
+	err = setFieldWithType(params["target"], input, "DefaultActions[0]TargetGroupArn", awsslicestruct)
 	if err != nil {
 		return nil, err
 	}
-	err = setFieldWithType(params["password"], input, "MasterUserPassword", awsstr)
+	err = setFieldWithType(params["loadbalancer"], input, "LoadBalancerArn", awsstr)
 	if err != nil {
 		return nil, err
 	}
-	err = setFieldWithType(params["username"], input, "MasterUsername", awsstr)
+	err = setFieldWithType(params["port"], input, "Port", awsint64)
 	if err != nil {
 		return nil, err
 	}
-	err = setFieldWithType(params["size"], input, "AllocatedStorage", awsint64)
+	err = setFieldWithType(params["protocol"], input, "Protocol", awsstr)
 	if err != nil {
 		return nil, err
 	}
 
 	// Extra params
-	if _, ok := params["autoupgrade"]; ok {
-		err = setFieldWithType(params["autoupgrade"], input, "AutoMinorVersionUpgrade", awsbool)
+	if _, ok := params["certificate"]; ok {
+		err = setFieldWithType(params["certificate"], input, "Certificates[0]CertificateArn", awsslicestruct)
 		if err != nil {
 			return nil, err
 		}
 	}
-	if _, ok := params["zone"]; ok {
+	if _, ok := params["sslpolicy"]; ok {
+		err = setFieldWithType(params["sslpolicy"], input, "SslPolicy", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	var output *elbv2.CreateListenerOutput
+	output, err = d.CreateListener(input)
+	output = output
+	if err != nil {
+		return nil, fmt.Errorf("create listener: %s", err)
+	}
+	d.logger.ExtraVerbosef("elbv2.CreateListener call took %s", time.Since(start))
+	id := aws.StringValue(output.Listeners[0].ListenerArn)
+
+	d.logger.Verbosef("create listener '%s' done", id)
+	return id, nil
+}
+
+// This function was auto generated
+func (d *Elbv2Driver) Delete_Listener_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["id"]; !ok {
+		return nil, errors.New("delete listener: missing required params 'id'")
+	}
+
+	d.logger.Verbose("params dry run: delete listener ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *Elbv2Driver) Delete_Listener(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &elbv2.DeleteListenerInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "ListenerArn", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	var output *elbv2.DeleteListenerOutput
+	output, err = d.DeleteListener(input)
+	output = output
+	if err != nil {
+		return nil, fmt.Errorf("delete listener: %s", err)
+	}
+	d.logger.ExtraVerbosef("elbv2.DeleteListener call took %s", time.Since(start))
+	d.logger.Verbose("delete listener done")
+	return output, nil
+}
+
+// This function was auto generated
+func (d *Elbv2Driver) Create_Targetgroup_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["name"]; !ok {
+		return nil, errors.New("create targetgroup: missing required params 'name'")
+	}
+
+	if _, ok := params["port"]; !ok {
+		return nil, errors.New("create targetgroup: missing required params 'port'")
+	}
+
+	if _, ok := params["protocol"]; !ok {
+		return nil, errors.New("create targetgroup: missing required params 'protocol'")
+	}
+
+	if _, ok := params["vpc"]; !ok {
+		return nil, errors.New("create targetgroup: missing required params 'vpc'")
+	}
+
+	d.logger.Verbose("params dry run: create targetgroup ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *Elbv2Driver) Create_Targetgroup(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &elbv2.CreateTargetGroupInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["name"], input, "Name", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["port"], input, "Port", awsint64)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["protocol"], input, "Protocol", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["vpc"], input, "VpcId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["healthcheckinterval"]; ok {
+		err = setFieldWithType(params["healthcheckinterval"], input, "HealthCheckIntervalSeconds", awsint64)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["healthcheckpath"]; ok {
+		err = setFieldWithType(params["healthcheckpath"], input, "HealthCheckPath", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["healthcheckport"]; ok {
+		err = setFieldWithType(params["healthcheckport"], input, "HealthCheckPort", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["healthcheckprotocol"]; ok {
+		err = setFieldWithType(params["healthcheckprotocol"], input, "HealthCheckProtocol", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["healthchecktimeout"]; ok {
+		err = setFieldWithType(params["healthchecktimeout"], input, "HealthCheckTimeoutSeconds", awsint64)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["healthythreshold"]; ok {
+		err = setFieldWithType(params["healthythreshold"], input, "HealthyThresholdCount", awsint64)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["unhealthythreshold"]; ok {
+		err = setFieldWithType(params["unhealthythreshold"], input, "UnhealthyThresholdCount", awsint64)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["matcher"]; ok {
+		err = setFieldWithType(params["matcher"], input, "Matcher.HttpCode", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	var output *elbv2.CreateTargetGroupOutput
+	output, err = d.CreateTargetGroup(input)
+	output = output
+	if err != nil {
+		return nil, fmt.Errorf("create targetgroup: %s", err)
+	}
+	d.logger.ExtraVerbosef("elbv2.CreateTargetGroup call took %s", time.Since(start))
+	id := aws.StringValue(output.TargetGroups[0].TargetGroupArn)
+
+	d.logger.Verbosef("create targetgroup '%s' done", id)
+	return id, nil
+}
+
+// This function was auto generated
+func (d *Elbv2Driver) Delete_Targetgroup_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["id"]; !ok {
+		return nil, errors.New("delete targetgroup: missing required params 'id'")
+	}
+
+	d.logger.Verbose("params dry run: delete targetgroup ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *Elbv2Driver) Delete_Targetgroup(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &elbv2.DeleteTargetGroupInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "TargetGroupArn", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	var output *elbv2.DeleteTargetGroupOutput
+	output, err = d.DeleteTargetGroup(input)
+	output = output
+	if err != nil {
+		return nil, fmt.Errorf("delete targetgroup: %s", err)
+	}
+	d.logger.ExtraVerbosef("elbv2.DeleteTargetGroup call took %s", time.Since(start))
+	d.logger.Verbose("delete targetgroup done")
+	return output, nil
+}
+
+// This function was auto generated
+func (d *Elbv2Driver) Attach_Instance_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["group"]; !ok {
+		return nil, errors.New("attach instance: missing required params 'group'")
+	}
+
+	if _, ok := params["id"]; !ok {
+		return nil, errors.New("attach instance: missing required params 'id'")
+	}
+
+	d.logger.Verbose("params dry run: attach instance ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *Elbv2Driver) Attach_Instance(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &elbv2.RegisterTargetsInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["group"], input, "TargetGroupArn", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["id"], input, "Targets[0]Id", awsslicestruct)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["port"]; ok {
+		err = setFieldWithType(params["port"], input, "Targets[0]Port", awsslicestruct)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	var output *elbv2.RegisterTargetsOutput
+	output, err = d.RegisterTargets(input)
+	output = output
+	if err != nil {
+		return nil, fmt.Errorf("attach instance: %s", err)
+	}
+	d.logger.ExtraVerbosef("elbv2.RegisterTargets call took %s", time.Since(start))
+	d.logger.Verbose("attach instance done")
+	return output, nil
+}
+
+// This function was auto generated
+func (d *Elbv2Driver) Detach_Instance_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["group"]; !ok {
+		return nil, errors.New("detach instance: missing required params 'group'")
+	}
+
+	if _, ok := params["id"]; !ok {
+		return nil, errors.New("detach instance: missing required params 'id'")
+	}
+
+	d.logger.Verbose("params dry run: detach instance ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *Elbv2Driver) Detach_Instance(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &elbv2.DeregisterTargetsInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["group"], input, "TargetGroupArn", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["id"], input, "Targets[0]Id", awsslicestruct)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	var output *elbv2.DeregisterTargetsOutput
+	output, err = d.DeregisterTargets(input)
+	output = output
+	if err != nil {
+		return nil, fmt.Errorf("detach instance: %s", err)
+	}
+	d.logger.ExtraVerbosef("elbv2.DeregisterTargets call took %s", time.Since(start))
+	d.logger.Verbose("detach instance done")
+	return output, nil
+}
+
+// This function was auto generated
+func (d *RdsDriver) Create_Database_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["type"]; !ok {
+		return nil, errors.New("create database: missing required params 'type'")
+	}
+
+	if _, ok := params["id"]; !ok {
+		return nil, errors.New("create database: missing required params 'id'")
+	}
+
+	if _, ok := params["engine"]; !ok {
+		return nil, errors.New("create database: missing required params 'engine'")
+	}
+
+	if _, ok := params["password"]; !ok {
+		return nil, errors.New("create database: missing required params 'password'")
+	}
+
+	if _, ok := params["username"]; !ok {
+		return nil, errors.New("create database: missing required params 'username'")
+	}
+
+	if _, ok := params["size"]; !ok {
+		return nil, errors.New("create database: missing required params 'size'")
+	}
+
+	d.logger.Verbose("params dry run: create database ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *RdsDriver) Create_Database(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &rds.CreateDBInstanceInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["type"], input, "DBInstanceClass", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["id"], input, "DBInstanceIdentifier", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["engine"], input, "Engine", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["password"], input, "MasterUserPassword", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["username"], input, "MasterUsername", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["size"], input, "AllocatedStorage", awsint64)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["autoupgrade"]; ok {
+		err = setFieldWithType(params["autoupgrade"], input, "AutoMinorVersionUpgrade", awsbool)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["zone"]; ok {
 		err = setFieldWithType(params["zone"], input, "AvailabilityZone", awsstr)
 		if err != nil {
 			return nil, err
 		}
 	}
-	if _, ok := params["backupretention"]; ok {
-		err = setFieldWithType(params["backupretention"], input, "BackupRetentionPeriod", awsint64)
+	if _, ok := params["backupretention"]; ok {
+		err = setFieldWithType(params["backupretention"], input, "BackupRetentionPeriod", awsint64)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["cluster"]; ok {
+		err = setFieldWithType(params["cluster"], input, "DBClusterIdentifier", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["dbname"]; ok {
+		err = setFieldWithType(params["dbname"], input, "DBName", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["parametergroup"]; ok {
+		err = setFieldWithType(params["parametergroup"], input, "DBParameterGroupName", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["dbsecgroup"]; ok {
+		err = setFieldWithType(params["dbsecgroup"], input, "DBSecurityGroups", awsstringslice)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["subnetgroup"]; ok {
+		err = setFieldWithType(params["subnetgroup"], input, "DBSubnetGroupName", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["domain"]; ok {
+		err = setFieldWithType(params["domain"], input, "Domain", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["iamrole"]; ok {
+		err = setFieldWithType(params["iamrole"], input, "DomainIAMRoleName", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["version"]; ok {
+		err = setFieldWithType(params["version"], input, "EngineVersion", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["iops"]; ok {
+		err = setFieldWithType(params["iops"], input, "Iops", awsint64)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["license"]; ok {
+		err = setFieldWithType(params["license"], input, "LicenseModel", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["multiaz"]; ok {
+		err = setFieldWithType(params["multiaz"], input, "MultiAZ", awsbool)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["optiongroup"]; ok {
+		err = setFieldWithType(params["optiongroup"], input, "OptionGroupName", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["port"]; ok {
+		err = setFieldWithType(params["port"], input, "Port", awsint64)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["backupwindow"]; ok {
+		err = setFieldWithType(params["backupwindow"], input, "PreferredBackupWindow", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["maintenancewindow"]; ok {
+		err = setFieldWithType(params["maintenancewindow"], input, "PreferredMaintenanceWindow", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["public"]; ok {
+		err = setFieldWithType(params["public"], input, "PubliclyAccessible", awsbool)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["encrypted"]; ok {
+		err = setFieldWithType(params["encrypted"], input, "StorageEncrypted", awsbool)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["storagetype"]; ok {
+		err = setFieldWithType(params["storagetype"], input, "StorageType", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["timezone"]; ok {
+		err = setFieldWithType(params["timezone"], input, "Timezone", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["vpcsecgroup"]; ok {
+		err = setFieldWithType(params["vpcsecgroup"], input, "VpcSecurityGroupIds", awsstringslice)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	var output *rds.CreateDBInstanceOutput
+	output, err = d.CreateDBInstance(input)
+	output = output
+	if err != nil {
+		return nil, fmt.Errorf("create database: %s", err)
+	}
+	d.logger.ExtraVerbosef("rds.CreateDBInstance call took %s", time.Since(start))
+	id := aws.StringValue(output.DBInstance.DBInstanceIdentifier)
+
+	d.logger.Verbosef("create database '%s' done", id)
+	return id, nil
+}
+
+// This function was auto generated
+func (d *RdsDriver) Delete_Database_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["id"]; !ok {
+		return nil, errors.New("delete database: missing required params 'id'")
+	}
+
+	d.logger.Verbose("params dry run: delete database ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *RdsDriver) Delete_Database(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &rds.DeleteDBInstanceInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "DBInstanceIdentifier", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["skipsnapshot"]; ok {
+		err = setFieldWithType(params["skipsnapshot"], input, "SkipFinalSnapshot", awsbool)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["snapshotid"]; ok {
+		err = setFieldWithType(params["snapshotid"], input, "FinalDBSnapshotIdentifier", awsbool)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	var output *rds.DeleteDBInstanceOutput
+	output, err = d.DeleteDBInstance(input)
+	output = output
+	if err != nil {
+		return nil, fmt.Errorf("delete database: %s", err)
+	}
+	d.logger.ExtraVerbosef("rds.DeleteDBInstance call took %s", time.Since(start))
+	d.logger.Verbose("delete database done")
+	return output, nil
+}
+
+// This function was auto generated
+func (d *RdsDriver) Create_Dbsubnetgroup_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["description"]; !ok {
+		return nil, errors.New("create dbsubnetgroup: missing required params 'description'")
+	}
+
+	if _, ok := params["name"]; !ok {
+		return nil, errors.New("create dbsubnetgroup: missing required params 'name'")
+	}
+
+	if _, ok := params["subnets"]; !ok {
+		return nil, errors.New("create dbsubnetgroup: missing required params 'subnets'")
+	}
+
+	d.logger.Verbose("params dry run: create dbsubnetgroup ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *RdsDriver) Create_Dbsubnetgroup(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &rds.CreateDBSubnetGroupInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["description"], input, "DBSubnetGroupDescription", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["name"], input, "DBSubnetGroupName", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["subnets"], input, "SubnetIds", awsstringslice)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	var output *rds.CreateDBSubnetGroupOutput
+	output, err = d.CreateDBSubnetGroup(input)
+	output = output
+	if err != nil {
+		return nil, fmt.Errorf("create dbsubnetgroup: %s", err)
+	}
+	d.logger.ExtraVerbosef("rds.CreateDBSubnetGroup call took %s", time.Since(start))
+	id := aws.StringValue(output.DBSubnetGroup.DBSubnetGroupName)
+
+	d.logger.Verbosef("create dbsubnetgroup '%s' done", id)
+	return id, nil
+}
+
+// This function was auto generated
+func (d *RdsDriver) Delete_Dbsubnetgroup_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["id"]; !ok {
+		return nil, errors.New("delete dbsubnetgroup: missing required params 'id'")
+	}
+
+	d.logger.Verbose("params dry run: delete dbsubnetgroup ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *RdsDriver) Delete_Dbsubnetgroup(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &rds.DeleteDBSubnetGroupInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "DBSubnetGroupName", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	var output *rds.DeleteDBSubnetGroupOutput
+	output, err = d.DeleteDBSubnetGroup(input)
+	output = output
+	if err != nil {
+		return nil, fmt.Errorf("delete dbsubnetgroup: %s", err)
+	}
+	d.logger.ExtraVerbosef("rds.DeleteDBSubnetGroup call took %s", time.Since(start))
+	d.logger.Verbose("delete dbsubnetgroup done")
+	return output, nil
+}
+
+// This function was auto generated
+func (d *AutoscalingDriver) Create_Launchconfiguration_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["image"]; !ok {
+		return nil, errors.New("create launchconfiguration: missing required params 'image'")
+	}
+
+	if _, ok := params["type"]; !ok {
+		return nil, errors.New("create launchconfiguration: missing required params 'type'")
+	}
+
+	if _, ok := params["name"]; !ok {
+		return nil, errors.New("create launchconfiguration: missing required params 'name'")
+	}
+
+	d.logger.Verbose("params dry run: create launchconfiguration ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *AutoscalingDriver) Create_Launchconfiguration(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &autoscaling.CreateLaunchConfigurationInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["image"], input, "ImageId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["type"], input, "InstanceType", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["name"], input, "LaunchConfigurationName", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["public"]; ok {
+		err = setFieldWithType(params["public"], input, "AssociatePublicIpAddress", awsbool)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["role"]; ok {
+		err = setFieldWithType(params["role"], input, "IamInstanceProfile", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["key"]; ok {
+		err = setFieldWithType(params["key"], input, "KeyName", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["groups"]; ok {
+		err = setFieldWithType(params["groups"], input, "SecurityGroups", awsstringslice)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["spotprice"]; ok {
+		err = setFieldWithType(params["spotprice"], input, "SpotPrice", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["userdata"]; ok {
+		err = setFieldWithType(params["userdata"], input, "UserData", awsstr)
 		if err != nil {
 			return nil, err
 		}
 	}
-	if _, ok := params["cluster"]; ok {
-		err = setFieldWithType(params["cluster"], input, "DBClusterIdentifier", awsstr)
-		if err != nil {
-			return nil, err
-		}
+
+	start := time.Now()
+	var output *autoscaling.CreateLaunchConfigurationOutput
+	output, err = d.CreateLaunchConfiguration(input)
+	output = output
+	if err != nil {
+		return nil, fmt.Errorf("create launchconfiguration: %s", err)
+	}
+	d.logger.ExtraVerbosef("autoscaling.CreateLaunchConfiguration call took %s", time.Since(start))
+	id := fmt.Sprint(params["name"])
+
+	d.logger.Verbosef("create launchconfiguration '%s' done", id)
+	return id, nil
+}
+
+// This function was auto generated
+func (d *AutoscalingDriver) Delete_Launchconfiguration_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["id"]; !ok {
+		return nil, errors.New("delete launchconfiguration: missing required params 'id'")
+	}
+
+	d.logger.Verbose("params dry run: delete launchconfiguration ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *AutoscalingDriver) Delete_Launchconfiguration(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &autoscaling.DeleteLaunchConfigurationInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "LaunchConfigurationName", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	var output *autoscaling.DeleteLaunchConfigurationOutput
+	output, err = d.DeleteLaunchConfiguration(input)
+	output = output
+	if err != nil {
+		return nil, fmt.Errorf("delete launchconfiguration: %s", err)
+	}
+	d.logger.ExtraVerbosef("autoscaling.DeleteLaunchConfiguration call took %s", time.Since(start))
+	d.logger.Verbose("delete launchconfiguration done")
+	return output, nil
+}
+
+// This function was auto generated
+func (d *AutoscalingDriver) Create_Scalinggroup_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["name"]; !ok {
+		return nil, errors.New("create scalinggroup: missing required params 'name'")
+	}
+
+	if _, ok := params["launchconfiguration"]; !ok {
+		return nil, errors.New("create scalinggroup: missing required params 'launchconfiguration'")
+	}
+
+	if _, ok := params["max-size"]; !ok {
+		return nil, errors.New("create scalinggroup: missing required params 'max-size'")
+	}
+
+	if _, ok := params["min-size"]; !ok {
+		return nil, errors.New("create scalinggroup: missing required params 'min-size'")
+	}
+
+	if _, ok := params["subnets"]; !ok {
+		return nil, errors.New("create scalinggroup: missing required params 'subnets'")
+	}
+
+	d.logger.Verbose("params dry run: create scalinggroup ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *AutoscalingDriver) Create_Scalinggroup(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &autoscaling.CreateAutoScalingGroupInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["name"], input, "AutoScalingGroupName", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["launchconfiguration"], input, "LaunchConfigurationName", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["max-size"], input, "MaxSize", awsint64)
+	if err != nil {
+		return nil, err
 	}
-	if _, ok := params["dbname"]; ok {
-		err = setFieldWithType(params["dbname"], input, "DBName", awsstr)
-		if err != nil {
-			return nil, err
-		}
+	err = setFieldWithType(params["min-size"], input, "MinSize", awsint64)
+	if err != nil {
+		return nil, err
 	}
-	if _, ok := params["parametergroup"]; ok {
-		err = setFieldWithType(params["parametergroup"], input, "DBParameterGroupName", awsstr)
-		if err != nil {
-			return nil, err
-		}
+	err = setFieldWithType(params["subnets"], input, "VPCZoneIdentifier", awscsvstr)
+	if err != nil {
+		return nil, err
 	}
-	if _, ok := params["dbsecgroup"]; ok {
-		err = setFieldWithType(params["dbsecgroup"], input, "DBSecurityGroups", awsstringslice)
+
+	// Extra params
+	if _, ok := params["cooldown"]; ok {
+		err = setFieldWithType(params["cooldown"], input, "DefaultCooldown", awsint64)
 		if err != nil {
 			return nil, err
 		}
 	}
-	if _, ok := params["subnetgroup"]; ok {
-		err = setFieldWithType(params["subnetgroup"], input, "DBSubnetGroupName", awsstr)
+	if _, ok := params["desired-capacity"]; ok {
+		err = setFieldWithType(params["desired-capacity"], input, "DesiredCapacity", awsint64)
 		if err != nil {
 			return nil, err
 		}
 	}
-	if _, ok := params["domain"]; ok {
-		err = setFieldWithType(params["domain"], input, "Domain", awsstr)
+	if _, ok := params["healthcheck-grace-period"]; ok {
+		err = setFieldWithType(params["healthcheck-grace-period"], input, "HealthCheckGracePeriod", awsint64)
 		if err != nil {
 			return nil, err
 		}
 	}
-	if _, ok := params["iamrole"]; ok {
-		err = setFieldWithType(params["iamrole"], input, "DomainIAMRoleName", awsstr)
+	if _, ok := params["healthcheck-type"]; ok {
+		err = setFieldWithType(params["healthcheck-type"], input, "HealthCheckType", awsstr)
 		if err != nil {
 			return nil, err
 		}
 	}
-	if _, ok := params["version"]; ok {
-		err = setFieldWithType(params["version"], input, "EngineVersion", awsstr)
+	if _, ok := params["new-instances-protected"]; ok {
+		err = setFieldWithType(params["new-instances-protected"], input, "NewInstancesProtectedFromScaleIn", awsbool)
 		if err != nil {
 			return nil, err
 		}
 	}
-	if _, ok := params["iops"]; ok {
-		err = setFieldWithType(params["iops"], input, "Iops", awsint64)
+	if _, ok := params["targetgroups"]; ok {
+		err = setFieldWithType(params["targetgroups"], input, "TargetGroupARNs", awsstringslice)
 		if err != nil {
 			return nil, err
 		}
 	}
-	if _, ok := params["license"]; ok {
-		err = setFieldWithType(params["license"], input, "LicenseModel", awsstr)
-		if err != nil {
-			return nil, err
-		}
+
+	start := time.Now()
+	var output *autoscaling.CreateAutoScalingGroupOutput
+	output, err = d.CreateAutoScalingGroup(input)
+	output = output
+	if err != nil {
+		return nil, fmt.Errorf("create scalinggroup: %s", err)
 	}
-	if _, ok := params["multiaz"]; ok {
-		err = setFieldWithType(params["multiaz"], input, "MultiAZ", awsbool)
-		if err != nil {
-			return nil, err
-		}
+	d.logger.ExtraVerbosef("autoscaling.CreateAutoScalingGroup call took %s", time.Since(start))
+	id := fmt.Sprint(params["name"])
+
+	d.logger.Verbosef("create scalinggroup '%s' done", id)
+	return id, nil
+}
+
+// This function was auto generated
+func (d *AutoscalingDriver) Update_Scalinggroup_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["id"]; !ok {
+		return nil, errors.New("update scalinggroup: missing required params 'id'")
 	}
-	if _, ok := params["optiongroup"]; ok {
-		err = setFieldWithType(params["optiongroup"], input, "OptionGroupName", awsstr)
+
+	d.logger.Verbose("params dry run: update scalinggroup ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *AutoscalingDriver) Update_Scalinggroup(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &autoscaling.UpdateAutoScalingGroupInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "AutoScalingGroupName", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["cooldown"]; ok {
+		err = setFieldWithType(params["cooldown"], input, "DefaultCooldown", awsint64)
 		if err != nil {
 			return nil, err
 		}
 	}
-	if _, ok := params["port"]; ok {
-		err = setFieldWithType(params["port"], input, "Port", awsint64)
+	if _, ok := params["desired-capacity"]; ok {
+		err = setFieldWithType(params["desired-capacity"], input, "DesiredCapacity", awsint64)
 		if err != nil {
 			return nil, err
 		}
 	}
-	if _, ok := params["backupwindow"]; ok {
-		err = setFieldWithType(params["backupwindow"], input, "PreferredBackupWindow", awsstr)
+	if _, ok := params["healthcheck-grace-period"]; ok {
+		err = setFieldWithType(params["healthcheck-grace-period"], input, "HealthCheckGracePeriod", awsint64)
 		if err != nil {
 			return nil, err
 		}
 	}
-	if _, ok := params["maintenancewindow"]; ok {
-		err = setFieldWithType(params["maintenancewindow"], input, "PreferredMaintenanceWindow", awsstr)
+	if _, ok := params["healthcheck-type"]; ok {
+		err = setFieldWithType(params["healthcheck-type"], input, "HealthCheckType", awsstr)
 		if err != nil {
 			return nil, err
 		}
 	}
-	if _, ok := params["public"]; ok {
-		err = setFieldWithType(params["public"], input, "PubliclyAccessible", awsbool)
+	if _, ok := params["launchconfiguration"]; ok {
+		err = setFieldWithType(params["launchconfiguration"], input, "LaunchConfigurationName", awsstr)
 		if err != nil {
 			return nil, err
 		}
 	}
-	if _, ok := params["encrypted"]; ok {
-		err = setFieldWithType(params["encrypted"], input, "StorageEncrypted", awsbool)
+	if _, ok := params["max-size"]; ok {
+		err = setFieldWithType(params["max-size"], input, "MaxSize", awsint64)
 		if err != nil {
 			return nil, err
 		}
 	}
-	if _, ok := params["storagetype"]; ok {
-		err = setFieldWithType(params["storagetype"], input, "StorageType", awsstr)
+	if _, ok := params["min-size"]; ok {
+		err = setFieldWithType(params["min-size"], input, "MinSize", awsint64)
 		if err != nil {
 			return nil, err
 		}
 	}
-	if _, ok := params["timezone"]; ok {
-		err = setFieldWithType(params["timezone"], input, "Timezone", awsstr)
+	if _, ok := params["new-instances-protected"]; ok {
+		err = setFieldWithType(params["new-instances-protected"], input, "NewInstancesProtectedFromScaleIn", awsbool)
 		if err != nil {
 			return nil, err
 		}
 	}
-	if _, ok := params["vpcsecgroup"]; ok {
-		err = setFieldWithType(params["vpcsecgroup"], input, "VpcSecurityGroupIds", awsstringslice)
+	if _, ok := params["subnets"]; ok {
+		err = setFieldWithType(params["subnets"], input, "VPCZoneIdentifier", awscsvstr)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	start := time.Now()
-	var output *rds.CreateDBInstanceOutput
-	output, err = d.CreateDBInstance(input)
+	var output *autoscaling.UpdateAutoScalingGroupOutput
+	output, err = d.UpdateAutoScalingGroup(input)
 	output = output
 	if err != nil {
-		return nil, fmt.Errorf("create database: %s", err)
+		return nil, fmt.Errorf("update scalinggroup: %s", err)
 	}
-	d.logger.ExtraVerbosef("rds.CreateDBInstance call took %s", time.Since(start))
-	id := aws.StringValue(output.DBInstance.DBInstanceIdentifier)
-
-	d.logger.Verbosef("create database '%s' done", id)
-	return id, nil
+	d.logger.ExtraVerbosef("autoscaling.UpdateAutoScalingGroup call took %s", time.Since(start))
+	d.logger.Verbose("update scalinggroup done")
+	return output, nil
 }
 
 // This function was auto generated
-func (d *RdsDriver) Delete_Database_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *AutoscalingDriver) Delete_Scalinggroup_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	if _, ok := params["id"]; !ok {
-		return nil, errors.New("delete database: missing required params 'id'")
+		return nil, errors.New("delete scalinggroup: missing required params 'id'")
 	}
 
-	d.logger.Verbose("params dry run: delete database ok")
+	d.logger.Verbose("params dry run: delete scalinggroup ok")
 	return nil, nil
 }
 
 // This function was auto generated
-func (d *RdsDriver) Delete_Database(params map[string]interface{}) (interface{}, error) {
-	input := &rds.DeleteDBInstanceInput{}
+func (d *AutoscalingDriver) Delete_Scalinggroup(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &autoscaling.DeleteAutoScalingGroupInput{}
 	var err error
 
 	// Required params
-	err = setFieldWithType(params["id"], input, "DBInstanceIdentifier", awsstr)
+	err = setFieldWithType(params["id"], input, "AutoScalingGroupName", awsstr)
 	if err != nil {
 		return nil, err
 	}
 
 	// Extra params
-	if _, ok := params["skipsnapshot"]; ok {
-		err = setFieldWithType(params["skipsnapshot"], input, "SkipFinalSnapshot", awsbool)
-		if err != nil {
-			return nil, err
-		}
-	}
-	if _, ok := params["snapshotid"]; ok {
-		err = setFieldWithType(params["snapshotid"], input, "FinalDBSnapshotIdentifier", awsbool)
+	if _, ok := params["force"]; ok {
+		err = setFieldWithType(params["force"], input, "ForceDelete", awsbool)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	start := time.Now()
-	var output *rds.DeleteDBInstanceOutput
-	output, err = d.DeleteDBInstance(input)
+	var output *autoscaling.DeleteAutoScalingGroupOutput
+	output, err = d.DeleteAutoScalingGroup(input)
 	output = output
 	if err != nil {
-		return nil, fmt.Errorf("delete database: %s", err)
+		return nil, fmt.Errorf("delete scalinggroup: %s", err)
 	}
-	d.logger.ExtraVerbosef("rds.DeleteDBInstance call took %s", time.Since(start))
-	d.logger.Verbose("delete database done")
+	d.logger.ExtraVerbosef("autoscaling.DeleteAutoScalingGroup call took %s", time.Since(start))
+	d.logger.Verbose("delete scalinggroup done")
 	return output, nil
 }
 
 // This function was auto generated
-func (d *RdsDriver) Create_Dbsubnetgroup_DryRun(params map[string]interface{}) (interface{}, error) {
-	if _, ok := params["description"]; !ok {
-		return nil, errors.New("create dbsubnetgroup: missing required params 'description'")
+func (d *AutoscalingDriver) Create_Scalingpolicy_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["adjustment-type"]; !ok {
+		return nil, errors.New("create scalingpolicy: missing required params 'adjustment-type'")
+	}
+
+	if _, ok := params["scalinggroup"]; !ok {
+		return nil, errors.New("create scalingpolicy: missing required params 'scalinggroup'")
 	}
 
 	if _, ok := params["name"]; !ok {
-		return nil, errors.New("create dbsubnetgroup: missing required params 'name'")
+		return nil, errors.New("create scalingpolicy: missing required params 'name'")
 	}
 
-	if _, ok := params["subnets"]; !ok {
-		return nil, errors.New("create dbsubnetgroup: missing required params 'subnets'")
+	if _, ok := params["scaling-adjustment"]; !ok {
+		return nil, errors.New("create scalingpolicy: missing required params 'scaling-adjustment'")
 	}
 
-	d.logger.Verbose("params dry run: create dbsubnetgroup ok")
+	d.logger.Verbose("params dry run: create scalingpolicy ok")
 	return nil, nil
 }
 
 // This function was auto generated
-func (d *RdsDriver) Create_Dbsubnetgroup(params map[string]interface{}) (interface{}, error) {
-	input := &rds.CreateDBSubnetGroupInput{}
+func (d *AutoscalingDriver) Create_Scalingpolicy(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &autoscaling.PutScalingPolicyInput{}
 	var err error
 
 	// Required params
-	err = setFieldWithType(params["description"], input, "DBSubnetGroupDescription", awsstr)
+	err = setFieldWithType(params["adjustment-type"], input, "AdjustmentType", awsstr)
 	if err != nil {
 		return nil, err
 	}
-	err = setFieldWithType(params["name"], input, "DBSubnetGroupName", awsstr)
+	err = setFieldWithType(params["scalinggroup"], input, "AutoScalingGroupName", awsstr)
 	if err != nil {
 		return nil, err
 	}
-	err = setFieldWithType(params["subnets"], input, "SubnetIds", awsstringslice)
+	err = setFieldWithType(params["name"], input, "PolicyName", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["scaling-adjustment"], input, "ScalingAdjustment", awsint64)
 	if err != nil {
 		return nil, err
 	}
 
+	// Extra params
+	if _, ok := params["cooldown"]; ok {
+		err = setFieldWithType(params["cooldown"], input, "Cooldown", awsint64)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["adjustment-magnitude"]; ok {
+		err = setFieldWithType(params["adjustment-magnitude"], input, "MinAdjustmentMagnitude", awsint64)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	start := time.Now()
-	var output *rds.CreateDBSubnetGroupOutput
-	output, err = d.CreateDBSubnetGroup(input)
+	var output *autoscaling.PutScalingPolicyOutput
+	output, err = d.PutScalingPolicy(input)
 	output = output
 	if err != nil {
-		return nil, fmt.Errorf("create dbsubnetgroup: %s", err)
+		return nil, fmt.Errorf("create scalingpolicy: %s", err)
 	}
-	d.logger.ExtraVerbosef("rds.CreateDBSubnetGroup call took %s", time.Since(start))
-	id := aws.StringValue(output.DBSubnetGroup.DBSubnetGroupName)
+	d.logger.ExtraVerbosef("autoscaling.PutScalingPolicy call took %s", time.Since(start))
+	id := aws.StringValue(output.PolicyARN)
 
-	d.logger.Verbosef("create dbsubnetgroup '%s' done", id)
+	d.logger.Verbosef("create scalingpolicy '%s' done", id)
 	return id, nil
 }
 
 // This function was auto generated
-func (d *RdsDriver) Delete_Dbsubnetgroup_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *AutoscalingDriver) Delete_Scalingpolicy_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	if _, ok := params["id"]; !ok {
-		return nil, errors.New("delete dbsubnetgroup: missing required params 'id'")
+		return nil, errors.New("delete scalingpolicy: missing required params 'id'")
 	}
 
-	d.logger.Verbose("params dry run: delete dbsubnetgroup ok")
+	d.logger.Verbose("params dry run: delete scalingpolicy ok")
 	return nil, nil
 }
 
 // This function was auto generated
-func (d *RdsDriver) Delete_Dbsubnetgroup(params map[string]interface{}) (interface{}, error) {
-	input := &rds.DeleteDBSubnetGroupInput{}
+func (d *AutoscalingDriver) Delete_Scalingpolicy(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &autoscaling.DeletePolicyInput{}
 	var err error
 
 	// Required params
-	err = setFieldWithType(params["id"], input, "DBSubnetGroupName", awsstr)
+	err = setFieldWithType(params["id"], input, "PolicyName", awsstr)
 	if err != nil {
 		return nil, err
 	}
 
 	start := time.Now()
-	var output *rds.DeleteDBSubnetGroupOutput
-	output, err = d.DeleteDBSubnetGroup(input)
+	var output *autoscaling.DeletePolicyOutput
+	output, err = d.DeletePolicy(input)
 	output = output
 	if err != nil {
-		return nil, fmt.Errorf("delete dbsubnetgroup: %s", err)
+		return nil, fmt.Errorf("delete scalingpolicy: %s", err)
 	}
-	d.logger.ExtraVerbosef("rds.DeleteDBSubnetGroup call took %s", time.Since(start))
-	d.logger.Verbose("delete dbsubnetgroup done")
+	d.logger.ExtraVerbosef("autoscaling.DeletePolicy call took %s", time.Since(start))
+	d.logger.Verbose("delete scalingpolicy done")
 	return output, nil
 }
 
 // This function was auto generated
-func (d *IamDriver) Create_User_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *IamDriver) Create_User_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	if _, ok := params["name"]; !ok {
 		return nil, errors.New("create user: missing required params 'name'")
 	}
@@ -2462,7 +3832,7 @@ func (d *IamDriver) Create_User_DryRun(params map[string]interface{}) (interface
 }
 
 // This function was auto generated
-func (d *IamDriver) Create_User(params map[string]interface{}) (interface{}, error) {
+func (d *IamDriver) Create_User(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &iam.CreateUserInput{}
 	var err error
 
@@ -2487,7 +3857,7 @@ func (d *IamDriver) Create_User(params map[string]interface{}) (interface{}, err
 }
 
 // This function was auto generated
-func (d *IamDriver) Delete_User_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *IamDriver) Delete_User_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	if _, ok := params["name"]; !ok {
 		return nil, errors.New("delete user: missing required params 'name'")
 	}
@@ -2497,7 +3867,7 @@ func (d *IamDriver) Delete_User_DryRun(params map[string]interface{}) (interface
 }
 
 // This function was auto generated
-func (d *IamDriver) Delete_User(params map[string]interface{}) (interface{}, error) {
+func (d *IamDriver) Delete_User(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &iam.DeleteUserInput{}
 	var err error
 
@@ -2520,7 +3890,7 @@ func (d *IamDriver) Delete_User(params map[string]interface{}) (interface{}, err
 }
 
 // This function was auto generated
-func (d *IamDriver) Attach_User_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *IamDriver) Attach_User_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	if _, ok := params["group"]; !ok {
 		return nil, errors.New("attach user: missing required params 'group'")
 	}
@@ -2534,7 +3904,7 @@ func (d *IamDriver) Attach_User_DryRun(params map[string]interface{}) (interface
 }
 
 // This function was auto generated
-func (d *IamDriver) Attach_User(params map[string]interface{}) (interface{}, error) {
+func (d *IamDriver) Attach_User(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &iam.AddUserToGroupInput{}
 	var err error
 
@@ -2561,7 +3931,7 @@ func (d *IamDriver) Attach_User(params map[string]interface{}) (interface{}, err
 }
 
 // This function was auto generated
-func (d *IamDriver) Detach_User_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *IamDriver) Detach_User_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	if _, ok := params["group"]; !ok {
 		return nil, errors.New("detach user: missing required params 'group'")
 	}
@@ -2575,7 +3945,7 @@ func (d *IamDriver) Detach_User_DryRun(params map[string]interface{}) (interface
 }
 
 // This function was auto generated
-func (d *IamDriver) Detach_User(params map[string]interface{}) (interface{}, error) {
+func (d *IamDriver) Detach_User(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &iam.RemoveUserFromGroupInput{}
 	var err error
 
@@ -2602,7 +3972,7 @@ func (d *IamDriver) Detach_User(params map[string]interface{}) (interface{}, err
 }
 
 // This function was auto generated
-func (d *IamDriver) Delete_Accesskey_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *IamDriver) Delete_Accesskey_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	if _, ok := params["id"]; !ok {
 		return nil, errors.New("delete accesskey: missing required params 'id'")
 	}
@@ -2612,7 +3982,7 @@ func (d *IamDriver) Delete_Accesskey_DryRun(params map[string]interface{}) (inte
 }
 
 // This function was auto generated
-func (d *IamDriver) Delete_Accesskey(params map[string]interface{}) (interface{}, error) {
+func (d *IamDriver) Delete_Accesskey(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &iam.DeleteAccessKeyInput{}
 	var err error
 
@@ -2622,6 +3992,14 @@ func (d *IamDriver) Delete_Accesskey(params map[string]interface{}) (interface{}
 		return nil, err
 	}
 
+	// Extra params
+	if _, ok := params["user"]; ok {
+		err = setFieldWithType(params["user"], input, "UserName", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	start := time.Now()
 	var output *iam.DeleteAccessKeyOutput
 	output, err = d.DeleteAccessKey(input)
@@ -2635,7 +4013,7 @@ func (d *IamDriver) Delete_Accesskey(params map[string]interface{}) (interface{}
 }
 
 // This function was auto generated
-func (d *IamDriver) Create_Group_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *IamDriver) Create_Group_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	if _, ok := params["name"]; !ok {
 		return nil, errors.New("create group: missing required params 'name'")
 	}
@@ -2645,7 +4023,7 @@ func (d *IamDriver) Create_Group_DryRun(params map[string]interface{}) (interfac
 }
 
 // This function was auto generated
-func (d *IamDriver) Create_Group(params map[string]interface{}) (interface{}, error) {
+func (d *IamDriver) Create_Group(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &iam.CreateGroupInput{}
 	var err error
 
@@ -2670,7 +4048,7 @@ func (d *IamDriver) Create_Group(params map[string]interface{}) (interface{}, er
 }
 
 // This function was auto generated
-func (d *IamDriver) Delete_Group_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *IamDriver) Delete_Group_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	if _, ok := params["name"]; !ok {
 		return nil, errors.New("delete group: missing required params 'name'")
 	}
@@ -2680,7 +4058,7 @@ func (d *IamDriver) Delete_Group_DryRun(params map[string]interface{}) (interfac
 }
 
 // This function was auto generated
-func (d *IamDriver) Delete_Group(params map[string]interface{}) (interface{}, error) {
+func (d *IamDriver) Delete_Group(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &iam.DeleteGroupInput{}
 	var err error
 
@@ -2703,7 +4081,7 @@ func (d *IamDriver) Delete_Group(params map[string]interface{}) (interface{}, er
 }
 
 // This function was auto generated
-func (d *S3Driver) Create_Bucket_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *S3Driver) Create_Bucket_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	if _, ok := params["name"]; !ok {
 		return nil, errors.New("create bucket: missing required params 'name'")
 	}
@@ -2713,7 +4091,7 @@ func (d *S3Driver) Create_Bucket_DryRun(params map[string]interface{}) (interfac
 }
 
 // This function was auto generated
-func (d *S3Driver) Create_Bucket(params map[string]interface{}) (interface{}, error) {
+func (d *S3Driver) Create_Bucket(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &s3.CreateBucketInput{}
 	var err error
 
@@ -2738,7 +4116,7 @@ func (d *S3Driver) Create_Bucket(params map[string]interface{}) (interface{}, er
 }
 
 // This function was auto generated
-func (d *S3Driver) Delete_Bucket_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *S3Driver) Delete_Bucket_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	if _, ok := params["name"]; !ok {
 		return nil, errors.New("delete bucket: missing required params 'name'")
 	}
@@ -2748,7 +4126,7 @@ func (d *S3Driver) Delete_Bucket_DryRun(params map[string]interface{}) (interfac
 }
 
 // This function was auto generated
-func (d *S3Driver) Delete_Bucket(params map[string]interface{}) (interface{}, error) {
+func (d *S3Driver) Delete_Bucket(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &s3.DeleteBucketInput{}
 	var err error
 
@@ -2771,7 +4149,7 @@ func (d *S3Driver) Delete_Bucket(params map[string]interface{}) (interface{}, er
 }
 
 // This function was auto generated
-func (d *S3Driver) Delete_Storageobject_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *S3Driver) Delete_Storageobject_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	if _, ok := params["bucket"]; !ok {
 		return nil, errors.New("delete storageobject: missing required params 'bucket'")
 	}
@@ -2785,7 +4163,7 @@ func (d *S3Driver) Delete_Storageobject_DryRun(params map[string]interface{}) (i
 }
 
 // This function was auto generated
-func (d *S3Driver) Delete_Storageobject(params map[string]interface{}) (interface{}, error) {
+func (d *S3Driver) Delete_Storageobject(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &s3.DeleteObjectInput{}
 	var err error
 
@@ -2812,7 +4190,7 @@ func (d *S3Driver) Delete_Storageobject(params map[string]interface{}) (interfac
 }
 
 // This function was auto generated
-func (d *SnsDriver) Create_Topic_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *SnsDriver) Create_Topic_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	if _, ok := params["name"]; !ok {
 		return nil, errors.New("create topic: missing required params 'name'")
 	}
@@ -2822,7 +4200,7 @@ func (d *SnsDriver) Create_Topic_DryRun(params map[string]interface{}) (interfac
 }
 
 // This function was auto generated
-func (d *SnsDriver) Create_Topic(params map[string]interface{}) (interface{}, error) {
+func (d *SnsDriver) Create_Topic(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &sns.CreateTopicInput{}
 	var err error
 
@@ -2847,7 +4225,7 @@ func (d *SnsDriver) Create_Topic(params map[string]interface{}) (interface{}, er
 }
 
 // This function was auto generated
-func (d *SnsDriver) Delete_Topic_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *SnsDriver) Delete_Topic_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	if _, ok := params["id"]; !ok {
 		return nil, errors.New("delete topic: missing required params 'id'")
 	}
@@ -2857,7 +4235,7 @@ func (d *SnsDriver) Delete_Topic_DryRun(params map[string]interface{}) (interfac
 }
 
 // This function was auto generated
-func (d *SnsDriver) Delete_Topic(params map[string]interface{}) (interface{}, error) {
+func (d *SnsDriver) Delete_Topic(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &sns.DeleteTopicInput{}
 	var err error
 
@@ -2880,7 +4258,7 @@ func (d *SnsDriver) Delete_Topic(params map[string]interface{}) (interface{}, er
 }
 
 // This function was auto generated
-func (d *SnsDriver) Create_Subscription_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *SnsDriver) Create_Subscription_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	if _, ok := params["topic"]; !ok {
 		return nil, errors.New("create subscription: missing required params 'topic'")
 	}
@@ -2898,7 +4276,7 @@ func (d *SnsDriver) Create_Subscription_DryRun(params map[string]interface{}) (i
 }
 
 // This function was auto generated
-func (d *SnsDriver) Create_Subscription(params map[string]interface{}) (interface{}, error) {
+func (d *SnsDriver) Create_Subscription(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &sns.SubscribeInput{}
 	var err error
 
@@ -2931,7 +4309,7 @@ func (d *SnsDriver) Create_Subscription(params map[string]interface{}) (interfac
 }
 
 // This function was auto generated
-func (d *SnsDriver) Delete_Subscription_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *SnsDriver) Delete_Subscription_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	if _, ok := params["id"]; !ok {
 		return nil, errors.New("delete subscription: missing required params 'id'")
 	}
@@ -2941,7 +4319,7 @@ func (d *SnsDriver) Delete_Subscription_DryRun(params map[string]interface{}) (i
 }
 
 // This function was auto generated
-func (d *SnsDriver) Delete_Subscription(params map[string]interface{}) (interface{}, error) {
+func (d *SnsDriver) Delete_Subscription(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &sns.UnsubscribeInput{}
 	var err error
 
@@ -2964,7 +4342,7 @@ func (d *SnsDriver) Delete_Subscription(params map[string]interface{}) (interfac
 }
 
 // This function was auto generated
-func (d *SqsDriver) Create_Queue_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *SqsDriver) Create_Queue_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	if _, ok := params["name"]; !ok {
 		return nil, errors.New("create queue: missing required params 'name'")
 	}
@@ -2974,7 +4352,7 @@ func (d *SqsDriver) Create_Queue_DryRun(params map[string]interface{}) (interfac
 }
 
 // This function was auto generated
-func (d *SqsDriver) Create_Queue(params map[string]interface{}) (interface{}, error) {
+func (d *SqsDriver) Create_Queue(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &sqs.CreateQueueInput{}
 	var err error
 
@@ -3043,7 +4421,7 @@ func (d *SqsDriver) Create_Queue(params map[string]interface{}) (interface{}, er
 }
 
 // This function was auto generated
-func (d *SqsDriver) Delete_Queue_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *SqsDriver) Delete_Queue_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	if _, ok := params["url"]; !ok {
 		return nil, errors.New("delete queue: missing required params 'url'")
 	}
@@ -3053,7 +4431,7 @@ func (d *SqsDriver) Delete_Queue_DryRun(params map[string]interface{}) (interfac
 }
 
 // This function was auto generated
-func (d *SqsDriver) Delete_Queue(params map[string]interface{}) (interface{}, error) {
+func (d *SqsDriver) Delete_Queue(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &sqs.DeleteQueueInput{}
 	var err error
 
@@ -3076,7 +4454,7 @@ func (d *SqsDriver) Delete_Queue(params map[string]interface{}) (interface{}, er
 }
 
 // This function was auto generated
-func (d *Route53Driver) Create_Zone_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *Route53Driver) Create_Zone_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	if _, ok := params["callerreference"]; !ok {
 		return nil, errors.New("create zone: missing required params 'callerreference'")
 	}
@@ -3090,7 +4468,7 @@ func (d *Route53Driver) Create_Zone_DryRun(params map[string]interface{}) (inter
 }
 
 // This function was auto generated
-func (d *Route53Driver) Create_Zone(params map[string]interface{}) (interface{}, error) {
+func (d *Route53Driver) Create_Zone(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &route53.CreateHostedZoneInput{}
 	var err error
 
@@ -3151,7 +4529,7 @@ func (d *Route53Driver) Create_Zone(params map[string]interface{}) (interface{},
 }
 
 // This function was auto generated
-func (d *Route53Driver) Delete_Zone_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *Route53Driver) Delete_Zone_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	if _, ok := params["id"]; !ok {
 		return nil, errors.New("delete zone: missing required params 'id'")
 	}
@@ -3161,7 +4539,7 @@ func (d *Route53Driver) Delete_Zone_DryRun(params map[string]interface{}) (inter
 }
 
 // This function was auto generated
-func (d *Route53Driver) Delete_Zone(params map[string]interface{}) (interface{}, error) {
+func (d *Route53Driver) Delete_Zone(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &route53.DeleteHostedZoneInput{}
 	var err error
 
@@ -3182,3 +4560,143 @@ func (d *Route53Driver) Delete_Zone(params map[string]interface{}) (interface{},
 	d.logger.Verbose("delete zone done")
 	return output, nil
 }
+
+// This function was auto generated
+func (d *LambdaDriver) Delete_Function_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["id"]; !ok {
+		return nil, errors.New("delete function: missing required params 'id'")
+	}
+
+	d.logger.Verbose("params dry run: delete function ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *LambdaDriver) Delete_Function(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &lambda.DeleteFunctionInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "FunctionName", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["version"]; ok {
+		err = setFieldWithType(params["version"], input, "Qualifier", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	var output *lambda.DeleteFunctionOutput
+	output, err = d.DeleteFunction(input)
+	output = output
+	if err != nil {
+		return nil, fmt.Errorf("delete function: %s", err)
+	}
+	d.logger.ExtraVerbosef("lambda.DeleteFunction call took %s", time.Since(start))
+	d.logger.Verbose("delete function done")
+	return output, nil
+}
+
+// This function was auto generated
+func (d *CloudwatchDriver) Delete_Alarm_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["id"]; !ok {
+		return nil, errors.New("delete alarm: missing required params 'id'")
+	}
+
+	d.logger.Verbose("params dry run: delete alarm ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *CloudwatchDriver) Delete_Alarm(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &cloudwatch.DeleteAlarmsInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "AlarmNames", awsstringslice)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	var output *cloudwatch.DeleteAlarmsOutput
+	output, err = d.DeleteAlarms(input)
+	output = output
+	if err != nil {
+		return nil, fmt.Errorf("delete alarm: %s", err)
+	}
+	d.logger.ExtraVerbosef("cloudwatch.DeleteAlarms call took %s", time.Since(start))
+	d.logger.Verbose("delete alarm done")
+	return output, nil
+}
+
+// This function was auto generated
+func (d *CloudwatchDriver) Start_Alarm_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["id"]; !ok {
+		return nil, errors.New("start alarm: missing required params 'id'")
+	}
+
+	d.logger.Verbose("params dry run: start alarm ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *CloudwatchDriver) Start_Alarm(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &cloudwatch.EnableAlarmActionsInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "AlarmNames", awsstringslice)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	var output *cloudwatch.EnableAlarmActionsOutput
+	output, err = d.EnableAlarmActions(input)
+	output = output
+	if err != nil {
+		return nil, fmt.Errorf("start alarm: %s", err)
+	}
+	d.logger.ExtraVerbosef("cloudwatch.EnableAlarmActions call took %s", time.Since(start))
+	d.logger.Verbose("start alarm done")
+	return output, nil
+}
+
+// This function was auto generated
+func (d *CloudwatchDriver) Stop_Alarm_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["id"]; !ok {
+		return nil, errors.New("stop alarm: missing required params 'id'")
+	}
+
+	d.logger.Verbose("params dry run: stop alarm ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *CloudwatchDriver) Stop_Alarm(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+	input := &cloudwatch.DisableAlarmActionsInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "AlarmNames", awsstringslice)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	var output *cloudwatch.DisableAlarmActionsOutput
+	output, err = d.DisableAlarmActions(input)
+	output = output
+	if err != nil {
+		return nil, fmt.Errorf("stop alarm: %s", err)
+	}
+	d.logger.ExtraVerbosef("cloudwatch.DisableAlarmActions call took %s", time.Since(start))
+	d.logger.Verbose("stop alarm done")
+	return output, nil
+}
@@ -20,9 +20,12 @@ package aws
 import (
 	"strings"
 
+	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
 	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
 	"github.com/aws/aws-sdk-go/service/route53/route53iface"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
@@ -108,12 +111,6 @@ func (d *Ec2Driver) Lookup(lookups ...string) (driverFn driver.DriverFn, err err
 		}
 		return d.Stop_Instance, nil
 
-	case "checkinstance":
-		if d.dryRun {
-			return d.Check_Instance_DryRun, nil
-		}
-		return d.Check_Instance, nil
-
 	case "createsecuritygroup":
 		if d.dryRun {
 			return d.Create_Securitygroup_DryRun, nil
@@ -156,6 +153,36 @@ func (d *Ec2Driver) Lookup(lookups ...string) (driverFn driver.DriverFn, err err
 		}
 		return d.Detach_Volume, nil
 
+	case "createsnapshot":
+		if d.dryRun {
+			return d.Create_Snapshot_DryRun, nil
+		}
+		return d.Create_Snapshot, nil
+
+	case "deletesnapshot":
+		if d.dryRun {
+			return d.Delete_Snapshot_DryRun, nil
+		}
+		return d.Delete_Snapshot, nil
+
+	case "createimage":
+		if d.dryRun {
+			return d.Create_Image_DryRun, nil
+		}
+		return d.Create_Image, nil
+
+	case "copyimage":
+		if d.dryRun {
+			return d.Copy_Image_DryRun, nil
+		}
+		return d.Copy_Image, nil
+
+	case "deleteimage":
+		if d.dryRun {
+			return d.Delete_Image_DryRun, nil
+		}
+		return d.Delete_Image, nil
+
 	case "createinternetgateway":
 		if d.dryRun {
 			return d.Create_Internetgateway_DryRun, nil
@@ -216,6 +243,66 @@ func (d *Ec2Driver) Lookup(lookups ...string) (driverFn driver.DriverFn, err err
 		}
 		return d.Delete_Route, nil
 
+	case "createelasticip":
+		if d.dryRun {
+			return d.Create_Elasticip_DryRun, nil
+		}
+		return d.Create_Elasticip, nil
+
+	case "deleteelasticip":
+		if d.dryRun {
+			return d.Delete_Elasticip_DryRun, nil
+		}
+		return d.Delete_Elasticip, nil
+
+	case "attachelasticip":
+		if d.dryRun {
+			return d.Attach_Elasticip_DryRun, nil
+		}
+		return d.Attach_Elasticip, nil
+
+	case "detachelasticip":
+		if d.dryRun {
+			return d.Detach_Elasticip_DryRun, nil
+		}
+		return d.Detach_Elasticip, nil
+
+	case "createnatgateway":
+		if d.dryRun {
+			return d.Create_Natgateway_DryRun, nil
+		}
+		return d.Create_Natgateway, nil
+
+	case "deletenatgateway":
+		if d.dryRun {
+			return d.Delete_Natgateway_DryRun, nil
+		}
+		return d.Delete_Natgateway, nil
+
+	case "createnetworkinterface":
+		if d.dryRun {
+			return d.Create_Networkinterface_DryRun, nil
+		}
+		return d.Create_Networkinterface, nil
+
+	case "deletenetworkinterface":
+		if d.dryRun {
+			return d.Delete_Networkinterface_DryRun, nil
+		}
+		return d.Delete_Networkinterface, nil
+
+	case "attachnetworkinterface":
+		if d.dryRun {
+			return d.Attach_Networkinterface_DryRun, nil
+		}
+		return d.Attach_Networkinterface, nil
+
+	case "detachnetworkinterface":
+		if d.dryRun {
+			return d.Detach_Networkinterface_DryRun, nil
+		}
+		return d.Detach_Networkinterface, nil
+
 	case "createtag":
 		if d.dryRun {
 			return d.Create_Tag_DryRun, nil
@@ -357,6 +444,68 @@ func (d *RdsDriver) Lookup(lookups ...string) (driverFn driver.DriverFn, err err
 	}
 }
 
+type AutoscalingDriver struct {
+	dryRun bool
+	logger *logger.Logger
+	autoscalingiface.AutoScalingAPI
+}
+
+func (d *AutoscalingDriver) SetDryRun(dry bool)         { d.dryRun = dry }
+func (d *AutoscalingDriver) SetLogger(l *logger.Logger) { d.logger = l }
+func NewAutoscalingDriver(api autoscalingiface.AutoScalingAPI) driver.Driver {
+	return &AutoscalingDriver{false, logger.DiscardLogger, api}
+}
+
+func (d *AutoscalingDriver) Lookup(lookups ...string) (driverFn driver.DriverFn, err error) {
+	switch strings.Join(lookups, "") {
+
+	case "createlaunchconfiguration":
+		if d.dryRun {
+			return d.Create_Launchconfiguration_DryRun, nil
+		}
+		return d.Create_Launchconfiguration, nil
+
+	case "deletelaunchconfiguration":
+		if d.dryRun {
+			return d.Delete_Launchconfiguration_DryRun, nil
+		}
+		return d.Delete_Launchconfiguration, nil
+
+	case "createscalinggroup":
+		if d.dryRun {
+			return d.Create_Scalinggroup_DryRun, nil
+		}
+		return d.Create_Scalinggroup, nil
+
+	case "updatescalinggroup":
+		if d.dryRun {
+			return d.Update_Scalinggroup_DryRun, nil
+		}
+		return d.Update_Scalinggroup, nil
+
+	case "deletescalinggroup":
+		if d.dryRun {
+			return d.Delete_Scalinggroup_DryRun, nil
+		}
+		return d.Delete_Scalinggroup, nil
+
+	case "createscalingpolicy":
+		if d.dryRun {
+			return d.Create_Scalingpolicy_DryRun, nil
+		}
+		return d.Create_Scalingpolicy, nil
+
+	case "deletescalingpolicy":
+		if d.dryRun {
+			return d.Delete_Scalingpolicy_DryRun, nil
+		}
+		return d.Delete_Scalingpolicy, nil
+
+	default:
+		return nil, driver.ErrDriverFnNotFound
+	}
+}
+
 type StsDriver struct {
 	dryRun bool
 	logger *logger.Logger
@@ -620,3 +769,79 @@ func (d *Route53Driver) Lookup(lookups ...string) (driverFn driver.DriverFn, err
 		return nil, driver.ErrDriverFnNotFound
 	}
 }
+
+type LambdaDriver struct {
+	dryRun bool
+	logger *logger.Logger
+	lambdaiface.LambdaAPI
+}
+
+func (d *LambdaDriver) SetDryRun(dry bool)         { d.dryRun = dry }
+func (d *LambdaDriver) SetLogger(l *logger.Logger) { d.logger = l }
+func NewLambdaDriver(api lambdaiface.LambdaAPI) driver.Driver {
+	return &LambdaDriver{false, logger.DiscardLogger, api}
+}
+
+func (d *LambdaDriver) Lookup(lookups ...string) (driverFn driver.DriverFn, err error) {
+	switch strings.Join(lookups, "") {
+
+	case "createfunction":
+		if d.dryRun {
+			return d.Create_Function_DryRun, nil
+		}
+		return d.Create_Function, nil
+
+	case "deletefunction":
+		if d.dryRun {
+			return d.Delete_Function_DryRun, nil
+		}
+		return d.Delete_Function, nil
+
+	default:
+		return nil, driver.ErrDriverFnNotFound
+	}
+}
+
+type CloudwatchDriver struct {
+	dryRun bool
+	logger *logger.Logger
+	cloudwatchiface.CloudWatchAPI
+}
+
+func (d *CloudwatchDriver) SetDryRun(dry bool)         { d.dryRun = dry }
+func (d *CloudwatchDriver) SetLogger(l *logger.Logger) { d.logger = l }
+func NewCloudwatchDriver(api cloudwatchiface.CloudWatchAPI) driver.Driver {
+	return &CloudwatchDriver{false, logger.DiscardLogger, api}
+}
+
+func (d *CloudwatchDriver) Lookup(lookups ...string) (driverFn driver.DriverFn, err error) {
+	switch strings.Join(lookups, "") {
+
+	case "createalarm":
+		if d.dryRun {
+			return d.Create_Alarm_DryRun, nil
+		}
+		return d.Create_Alarm, nil
+
+	case "deletealarm":
+		if d.dryRun {
+			return d.Delete_Alarm_DryRun, nil
+		}
+		return d.Delete_Alarm, nil
+
+	case "startalarm":
+		if d.dryRun {
+			return d.Start_Alarm_DryRun, nil
+		}
+		return d.Start_Alarm, nil
+
+	case "stopalarm":
+		if d.dryRun {
+			return d.Stop_Alarm_DryRun, nil
+		}
+		return d.Stop_Alarm, nil
+
+	default:
+		return nil, driver.ErrDriverFnNotFound
+	}
+}
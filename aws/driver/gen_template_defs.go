@@ -28,6 +28,10 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "ec2",
 		RequiredParams: []string{"cidr"},
 		ExtraParams:    []string{"name"},
+		Revert: &template.RevertRule{
+			Action:      "delete",
+			ResultParam: "id",
+		},
 	},
 	"deletevpc": {
 		Action:         "delete",
@@ -42,6 +46,10 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "ec2",
 		RequiredParams: []string{"cidr", "vpc"},
 		ExtraParams:    []string{"name", "zone"},
+		Revert: &template.RevertRule{
+			Action:      "delete",
+			ResultParam: "id",
+		},
 	},
 	"updatesubnet": {
 		Action:         "update",
@@ -49,6 +57,11 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "ec2",
 		RequiredParams: []string{"id"},
 		ExtraParams:    []string{"public"},
+		Revert: &template.RevertRule{
+			Action:   "update",
+			Params:   map[string]string{"id": "id"},
+			Previous: map[string]string{"public": "Public"},
+		},
 	},
 	"deletesubnet": {
 		Action:         "delete",
@@ -63,6 +76,12 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "ec2",
 		RequiredParams: []string{"count", "image", "name", "subnet", "type"},
 		ExtraParams:    []string{"group", "ip", "key", "lock", "userdata"},
+		Revert: &template.RevertRule{
+			Action:       "delete",
+			ResultParam:  "id",
+			CheckState:   "terminated",
+			CheckTimeout: 180,
+		},
 	},
 	"updateinstance": {
 		Action:         "update",
@@ -70,6 +89,11 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "ec2",
 		RequiredParams: []string{"id"},
 		ExtraParams:    []string{"group", "lock", "type"},
+		Revert: &template.RevertRule{
+			Action:   "update",
+			Params:   map[string]string{"id": "id"},
+			Previous: map[string]string{"group": "SecurityGroups", "type": "Type"},
+		},
 	},
 	"deleteinstance": {
 		Action:         "delete",
@@ -84,6 +108,11 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "ec2",
 		RequiredParams: []string{"id"},
 		ExtraParams:    []string{},
+		Revert: &template.RevertRule{
+			Action:      "stop",
+			ResultParam: "id",
+		},
+		PlannedProperties: map[string]interface{}{"State": "running"},
 	},
 	"stopinstance": {
 		Action:         "stop",
@@ -91,13 +120,11 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "ec2",
 		RequiredParams: []string{"id"},
 		ExtraParams:    []string{},
-	},
-	"checkinstance": {
-		Action:         "check",
-		Entity:         "instance",
-		Api:            "ec2",
-		RequiredParams: []string{"id", "state", "timeout"},
-		ExtraParams:    []string{},
+		Revert: &template.RevertRule{
+			Action:      "start",
+			ResultParam: "id",
+		},
+		PlannedProperties: map[string]interface{}{"State": "stopped"},
 	},
 	"createsecuritygroup": {
 		Action:         "create",
@@ -105,6 +132,10 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "ec2",
 		RequiredParams: []string{"description", "name", "vpc"},
 		ExtraParams:    []string{},
+		Revert: &template.RevertRule{
+			Action:      "delete",
+			ResultParam: "id",
+		},
 	},
 	"updatesecuritygroup": {
 		Action:         "update",
@@ -112,6 +143,11 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "ec2",
 		RequiredParams: []string{"cidr", "id", "protocol"},
 		ExtraParams:    []string{"inbound", "outbound", "portrange"},
+		Revert: &template.RevertRule{
+			Action:   "update",
+			Params:   map[string]string{"cidr": "cidr", "id": "id", "portrange": "portrange", "protocol": "protocol"},
+			Inverted: map[string]map[string]string{"inbound": {"authorize": "revoke", "revoke": "authorize"}, "outbound": {"authorize": "revoke", "revoke": "authorize"}},
+		},
 	},
 	"deletesecuritygroup": {
 		Action:         "delete",
@@ -126,6 +162,10 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "ec2",
 		RequiredParams: []string{"size", "zone"},
 		ExtraParams:    []string{},
+		Revert: &template.RevertRule{
+			Action:      "delete",
+			ResultParam: "id",
+		},
 	},
 	"deletevolume": {
 		Action:         "delete",
@@ -140,6 +180,10 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "ec2",
 		RequiredParams: []string{"device", "id", "instance"},
 		ExtraParams:    []string{},
+		Revert: &template.RevertRule{
+			Action: "detach",
+			Params: map[string]string{"device": "device", "id": "id", "instance": "instance"},
+		},
 	},
 	"detachvolume": {
 		Action:         "detach",
@@ -147,6 +191,57 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "ec2",
 		RequiredParams: []string{"device", "id", "instance"},
 		ExtraParams:    []string{"force"},
+		Revert: &template.RevertRule{
+			Action: "attach",
+			Params: map[string]string{"device": "device", "id": "id", "instance": "instance"},
+		},
+	},
+	"createsnapshot": {
+		Action:         "create",
+		Entity:         "snapshot",
+		Api:            "ec2",
+		RequiredParams: []string{"volume"},
+		ExtraParams:    []string{"description"},
+		Revert: &template.RevertRule{
+			Action:      "delete",
+			ResultParam: "id",
+		},
+	},
+	"deletesnapshot": {
+		Action:         "delete",
+		Entity:         "snapshot",
+		Api:            "ec2",
+		RequiredParams: []string{"id"},
+		ExtraParams:    []string{},
+	},
+	"createimage": {
+		Action:         "create",
+		Entity:         "image",
+		Api:            "ec2",
+		RequiredParams: []string{"instance", "name"},
+		ExtraParams:    []string{"description", "noreboot"},
+		Revert: &template.RevertRule{
+			Action:      "delete",
+			ResultParam: "id",
+		},
+	},
+	"copyimage": {
+		Action:         "copy",
+		Entity:         "image",
+		Api:            "ec2",
+		RequiredParams: []string{"id", "name", "region"},
+		ExtraParams:    []string{"description", "encrypted"},
+		Revert: &template.RevertRule{
+			Action:      "delete",
+			ResultParam: "id",
+		},
+	},
+	"deleteimage": {
+		Action:         "delete",
+		Entity:         "image",
+		Api:            "ec2",
+		RequiredParams: []string{"id"},
+		ExtraParams:    []string{},
 	},
 	"createinternetgateway": {
 		Action:         "create",
@@ -154,6 +249,10 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "ec2",
 		RequiredParams: []string{},
 		ExtraParams:    []string{},
+		Revert: &template.RevertRule{
+			Action:      "delete",
+			ResultParam: "id",
+		},
 	},
 	"deleteinternetgateway": {
 		Action:         "delete",
@@ -168,6 +267,10 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "ec2",
 		RequiredParams: []string{"id", "vpc"},
 		ExtraParams:    []string{},
+		Revert: &template.RevertRule{
+			Action: "detach",
+			Params: map[string]string{"id": "id", "vpc": "vpc"},
+		},
 	},
 	"detachinternetgateway": {
 		Action:         "detach",
@@ -175,6 +278,10 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "ec2",
 		RequiredParams: []string{"id", "vpc"},
 		ExtraParams:    []string{},
+		Revert: &template.RevertRule{
+			Action: "attach",
+			Params: map[string]string{"id": "id", "vpc": "vpc"},
+		},
 	},
 	"createroutetable": {
 		Action:         "create",
@@ -182,6 +289,10 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "ec2",
 		RequiredParams: []string{"vpc"},
 		ExtraParams:    []string{},
+		Revert: &template.RevertRule{
+			Action:      "delete",
+			ResultParam: "id",
+		},
 	},
 	"deleteroutetable": {
 		Action:         "delete",
@@ -196,6 +307,10 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "ec2",
 		RequiredParams: []string{"id", "subnet"},
 		ExtraParams:    []string{},
+		Revert: &template.RevertRule{
+			Action:      "detach",
+			ResultParam: "association",
+		},
 	},
 	"detachroutetable": {
 		Action:         "detach",
@@ -210,6 +325,10 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "ec2",
 		RequiredParams: []string{"cidr", "gateway", "table"},
 		ExtraParams:    []string{},
+		Revert: &template.RevertRule{
+			Action: "delete",
+			Params: map[string]string{"cidr": "cidr", "table": "table"},
+		},
 	},
 	"deleteroute": {
 		Action:         "delete",
@@ -218,12 +337,108 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		RequiredParams: []string{"cidr", "table"},
 		ExtraParams:    []string{},
 	},
+	"createelasticip": {
+		Action:         "create",
+		Entity:         "elasticip",
+		Api:            "ec2",
+		RequiredParams: []string{"domain"},
+		ExtraParams:    []string{},
+		Revert: &template.RevertRule{
+			Action:      "delete",
+			ResultParam: "id",
+		},
+	},
+	"deleteelasticip": {
+		Action:         "delete",
+		Entity:         "elasticip",
+		Api:            "ec2",
+		RequiredParams: []string{"id"},
+		ExtraParams:    []string{},
+	},
+	"attachelasticip": {
+		Action:         "attach",
+		Entity:         "elasticip",
+		Api:            "ec2",
+		RequiredParams: []string{"id"},
+		ExtraParams:    []string{"allowreassociation", "instance", "networkinterface", "privateip"},
+		Revert: &template.RevertRule{
+			Action:      "detach",
+			ResultParam: "association",
+		},
+	},
+	"detachelasticip": {
+		Action:         "detach",
+		Entity:         "elasticip",
+		Api:            "ec2",
+		RequiredParams: []string{"association"},
+		ExtraParams:    []string{},
+	},
+	"createnatgateway": {
+		Action:         "create",
+		Entity:         "natgateway",
+		Api:            "ec2",
+		RequiredParams: []string{"elasticip", "subnet"},
+		ExtraParams:    []string{},
+		Revert: &template.RevertRule{
+			Action:       "delete",
+			ResultParam:  "id",
+			CheckState:   "deleted",
+			CheckTimeout: 300,
+		},
+	},
+	"deletenatgateway": {
+		Action:         "delete",
+		Entity:         "natgateway",
+		Api:            "ec2",
+		RequiredParams: []string{"id"},
+		ExtraParams:    []string{},
+	},
+	"createnetworkinterface": {
+		Action:         "create",
+		Entity:         "networkinterface",
+		Api:            "ec2",
+		RequiredParams: []string{"subnet"},
+		ExtraParams:    []string{"description", "group", "privateip"},
+		Revert: &template.RevertRule{
+			Action:      "delete",
+			ResultParam: "id",
+		},
+	},
+	"deletenetworkinterface": {
+		Action:         "delete",
+		Entity:         "networkinterface",
+		Api:            "ec2",
+		RequiredParams: []string{"id"},
+		ExtraParams:    []string{},
+	},
+	"attachnetworkinterface": {
+		Action:         "attach",
+		Entity:         "networkinterface",
+		Api:            "ec2",
+		RequiredParams: []string{"deviceindex", "id", "instance"},
+		ExtraParams:    []string{},
+		Revert: &template.RevertRule{
+			Action:      "detach",
+			ResultParam: "attachment",
+		},
+	},
+	"detachnetworkinterface": {
+		Action:         "detach",
+		Entity:         "networkinterface",
+		Api:            "ec2",
+		RequiredParams: []string{"attachment"},
+		ExtraParams:    []string{"force"},
+	},
 	"createtag": {
 		Action:         "create",
 		Entity:         "tag",
 		Api:            "ec2",
 		RequiredParams: []string{"key", "resource", "value"},
 		ExtraParams:    []string{},
+		Revert: &template.RevertRule{
+			Action: "delete",
+			Params: map[string]string{"key": "key", "resource": "resource", "value": "value"},
+		},
 	},
 	"deletetag": {
 		Action:         "delete",
@@ -231,6 +446,10 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "ec2",
 		RequiredParams: []string{"key", "resource", "value"},
 		ExtraParams:    []string{},
+		Revert: &template.RevertRule{
+			Action: "create",
+			Params: map[string]string{"key": "key", "resource": "resource", "value": "value"},
+		},
 	},
 	"createkeypair": {
 		Action:         "create",
@@ -238,6 +457,10 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "ec2",
 		RequiredParams: []string{"name"},
 		ExtraParams:    []string{},
+		Revert: &template.RevertRule{
+			Action:      "delete",
+			ResultParam: "id",
+		},
 	},
 	"deletekeypair": {
 		Action:         "delete",
@@ -252,6 +475,12 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "elbv2",
 		RequiredParams: []string{"name", "subnets"},
 		ExtraParams:    []string{"groups", "iptype", "scheme"},
+		Revert: &template.RevertRule{
+			Action:       "delete",
+			ResultParam:  "id",
+			CheckState:   "not-found",
+			CheckTimeout: 300,
+		},
 	},
 	"deleteloadbalancer": {
 		Action:         "delete",
@@ -266,6 +495,10 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "elbv2",
 		RequiredParams: []string{"actiontype", "loadbalancer", "port", "protocol", "target"},
 		ExtraParams:    []string{"certificate", "sslpolicy"},
+		Revert: &template.RevertRule{
+			Action:      "delete",
+			ResultParam: "id",
+		},
 	},
 	"deletelistener": {
 		Action:         "delete",
@@ -280,6 +513,10 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "elbv2",
 		RequiredParams: []string{"name", "port", "protocol", "vpc"},
 		ExtraParams:    []string{"healthcheckinterval", "healthcheckpath", "healthcheckport", "healthcheckprotocol", "healthchecktimeout", "healthythreshold", "matcher", "unhealthythreshold"},
+		Revert: &template.RevertRule{
+			Action:      "delete",
+			ResultParam: "id",
+		},
 	},
 	"deletetargetgroup": {
 		Action:         "delete",
@@ -294,6 +531,10 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "elbv2",
 		RequiredParams: []string{"group", "id"},
 		ExtraParams:    []string{"port"},
+		Revert: &template.RevertRule{
+			Action: "detach",
+			Params: map[string]string{"group": "group", "id": "id"},
+		},
 	},
 	"detachinstance": {
 		Action:         "detach",
@@ -301,6 +542,10 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "elbv2",
 		RequiredParams: []string{"group", "id"},
 		ExtraParams:    []string{},
+		Revert: &template.RevertRule{
+			Action: "attach",
+			Params: map[string]string{"group": "group", "id": "id"},
+		},
 	},
 	"createdatabase": {
 		Action:         "create",
@@ -308,6 +553,13 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "rds",
 		RequiredParams: []string{"engine", "id", "password", "size", "type", "username"},
 		ExtraParams:    []string{"autoupgrade", "backupretention", "backupwindow", "cluster", "dbname", "dbsecgroup", "domain", "encrypted", "iamrole", "iops", "license", "maintenancewindow", "multiaz", "optiongroup", "parametergroup", "port", "public", "storagetype", "subnetgroup", "timezone", "version", "vpcsecgroup", "zone"},
+		Revert: &template.RevertRule{
+			Action:       "delete",
+			ResultParam:  "id",
+			Constants:    map[string]string{"skipsnapshot": "true"},
+			CheckState:   "not-found",
+			CheckTimeout: 900,
+		},
 	},
 	"deletedatabase": {
 		Action:         "delete",
@@ -322,6 +574,10 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "rds",
 		RequiredParams: []string{"description", "name", "subnets"},
 		ExtraParams:    []string{},
+		Revert: &template.RevertRule{
+			Action:      "delete",
+			ResultParam: "id",
+		},
 	},
 	"deletedbsubnetgroup": {
 		Action:         "delete",
@@ -330,12 +586,85 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		RequiredParams: []string{"id"},
 		ExtraParams:    []string{},
 	},
+	"createlaunchconfiguration": {
+		Action:         "create",
+		Entity:         "launchconfiguration",
+		Api:            "autoscaling",
+		RequiredParams: []string{"image", "name", "type"},
+		ExtraParams:    []string{"groups", "key", "public", "role", "spotprice", "userdata"},
+		Revert: &template.RevertRule{
+			Action:      "delete",
+			ResultParam: "id",
+		},
+	},
+	"deletelaunchconfiguration": {
+		Action:         "delete",
+		Entity:         "launchconfiguration",
+		Api:            "autoscaling",
+		RequiredParams: []string{"id"},
+		ExtraParams:    []string{},
+	},
+	"createscalinggroup": {
+		Action:         "create",
+		Entity:         "scalinggroup",
+		Api:            "autoscaling",
+		RequiredParams: []string{"launchconfiguration", "max-size", "min-size", "name", "subnets"},
+		ExtraParams:    []string{"cooldown", "desired-capacity", "healthcheck-grace-period", "healthcheck-type", "new-instances-protected", "targetgroups"},
+		Revert: &template.RevertRule{
+			Action:       "delete",
+			ResultParam:  "id",
+			Constants:    map[string]string{"force": "true"},
+			CheckState:   "not-found",
+			CheckTimeout: 600,
+		},
+	},
+	"updatescalinggroup": {
+		Action:         "update",
+		Entity:         "scalinggroup",
+		Api:            "autoscaling",
+		RequiredParams: []string{"id"},
+		ExtraParams:    []string{"cooldown", "desired-capacity", "healthcheck-grace-period", "healthcheck-type", "launchconfiguration", "max-size", "min-size", "new-instances-protected", "subnets"},
+		Revert: &template.RevertRule{
+			Action:   "update",
+			Params:   map[string]string{"id": "id"},
+			Previous: map[string]string{"cooldown": "Cooldown", "desired-capacity": "DesiredCapacity", "healthcheck-type": "HealthCheckType", "launchconfiguration": "LaunchConfigurationName", "max-size": "MaxSize", "min-size": "MinSize"},
+		},
+	},
+	"deletescalinggroup": {
+		Action:         "delete",
+		Entity:         "scalinggroup",
+		Api:            "autoscaling",
+		RequiredParams: []string{"id"},
+		ExtraParams:    []string{"force"},
+	},
+	"createscalingpolicy": {
+		Action:         "create",
+		Entity:         "scalingpolicy",
+		Api:            "autoscaling",
+		RequiredParams: []string{"adjustment-type", "name", "scaling-adjustment", "scalinggroup"},
+		ExtraParams:    []string{"adjustment-magnitude", "cooldown"},
+		Revert: &template.RevertRule{
+			Action:      "delete",
+			ResultParam: "id",
+		},
+	},
+	"deletescalingpolicy": {
+		Action:         "delete",
+		Entity:         "scalingpolicy",
+		Api:            "autoscaling",
+		RequiredParams: []string{"id"},
+		ExtraParams:    []string{},
+	},
 	"createuser": {
 		Action:         "create",
 		Entity:         "user",
 		Api:            "iam",
 		RequiredParams: []string{"name"},
 		ExtraParams:    []string{},
+		Revert: &template.RevertRule{
+			Action: "delete",
+			Params: map[string]string{"name": "name"},
+		},
 	},
 	"deleteuser": {
 		Action:         "delete",
@@ -350,6 +679,10 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "iam",
 		RequiredParams: []string{"group", "name"},
 		ExtraParams:    []string{},
+		Revert: &template.RevertRule{
+			Action: "detach",
+			Params: map[string]string{"group": "group", "name": "name"},
+		},
 	},
 	"detachuser": {
 		Action:         "detach",
@@ -357,6 +690,10 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "iam",
 		RequiredParams: []string{"group", "name"},
 		ExtraParams:    []string{},
+		Revert: &template.RevertRule{
+			Action: "attach",
+			Params: map[string]string{"group": "group", "name": "name"},
+		},
 	},
 	"createaccesskey": {
 		Action:         "create",
@@ -364,13 +701,18 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "iam",
 		RequiredParams: []string{"user"},
 		ExtraParams:    []string{},
+		Revert: &template.RevertRule{
+			Action:      "delete",
+			ResultParam: "id",
+			Params:      map[string]string{"user": "user"},
+		},
 	},
 	"deleteaccesskey": {
 		Action:         "delete",
 		Entity:         "accesskey",
 		Api:            "iam",
 		RequiredParams: []string{"id"},
-		ExtraParams:    []string{},
+		ExtraParams:    []string{"user"},
 	},
 	"creategroup": {
 		Action:         "create",
@@ -378,6 +720,10 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "iam",
 		RequiredParams: []string{"name"},
 		ExtraParams:    []string{},
+		Revert: &template.RevertRule{
+			Action: "delete",
+			Params: map[string]string{"name": "name"},
+		},
 	},
 	"deletegroup": {
 		Action:         "delete",
@@ -392,6 +738,10 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "iam",
 		RequiredParams: []string{"arn"},
 		ExtraParams:    []string{"group", "user"},
+		Revert: &template.RevertRule{
+			Action: "detach",
+			Params: map[string]string{"arn": "arn", "group": "group", "user": "user"},
+		},
 	},
 	"detachpolicy": {
 		Action:         "detach",
@@ -399,6 +749,10 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "iam",
 		RequiredParams: []string{"arn"},
 		ExtraParams:    []string{"group", "user"},
+		Revert: &template.RevertRule{
+			Action: "attach",
+			Params: map[string]string{"arn": "arn", "group": "group", "user": "user"},
+		},
 	},
 	"createbucket": {
 		Action:         "create",
@@ -406,6 +760,10 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "s3",
 		RequiredParams: []string{"name"},
 		ExtraParams:    []string{},
+		Revert: &template.RevertRule{
+			Action: "delete",
+			Params: map[string]string{"name": "name"},
+		},
 	},
 	"deletebucket": {
 		Action:         "delete",
@@ -420,6 +778,10 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "s3",
 		RequiredParams: []string{"bucket", "file"},
 		ExtraParams:    []string{"name"},
+		Revert: &template.RevertRule{
+			Action: "delete",
+			Params: map[string]string{"bucket": "bucket", "key": "name"},
+		},
 	},
 	"deletestorageobject": {
 		Action:         "delete",
@@ -434,6 +796,10 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "sns",
 		RequiredParams: []string{"name"},
 		ExtraParams:    []string{},
+		Revert: &template.RevertRule{
+			Action:      "delete",
+			ResultParam: "id",
+		},
 	},
 	"deletetopic": {
 		Action:         "delete",
@@ -448,6 +814,10 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "sns",
 		RequiredParams: []string{"endpoint", "protocol", "topic"},
 		ExtraParams:    []string{},
+		Revert: &template.RevertRule{
+			Action:      "delete",
+			ResultParam: "id",
+		},
 	},
 	"deletesubscription": {
 		Action:         "delete",
@@ -462,6 +832,10 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "sqs",
 		RequiredParams: []string{"name"},
 		ExtraParams:    []string{"delay", "maxMsgSize", "msgWait", "policy", "redrivePolicy", "retentionPeriod", "visibilityTimeout"},
+		Revert: &template.RevertRule{
+			Action:      "delete",
+			ResultParam: "url",
+		},
 	},
 	"deletequeue": {
 		Action:         "delete",
@@ -476,6 +850,10 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "route53",
 		RequiredParams: []string{"callerreference", "name"},
 		ExtraParams:    []string{"comment", "delegationsetid", "isprivate", "vpcid", "vpcregion"},
+		Revert: &template.RevertRule{
+			Action:      "delete",
+			ResultParam: "id",
+		},
 	},
 	"deletezone": {
 		Action:         "delete",
@@ -490,6 +868,10 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "route53",
 		RequiredParams: []string{"name", "ttl", "type", "value", "zone"},
 		ExtraParams:    []string{"comment"},
+		Revert: &template.RevertRule{
+			Action: "delete",
+			Params: map[string]string{"name": "name", "ttl": "ttl", "type": "type", "value": "value", "zone": "zone"},
+		},
 	},
 	"deleterecord": {
 		Action:         "delete",
@@ -497,6 +879,315 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "route53",
 		RequiredParams: []string{"name", "ttl", "type", "value", "zone"},
 		ExtraParams:    []string{},
+		Revert: &template.RevertRule{
+			Action: "create",
+			Params: map[string]string{"name": "name", "ttl": "ttl", "type": "type", "value": "value", "zone": "zone"},
+		},
+	},
+	"createfunction": {
+		Action:         "create",
+		Entity:         "function",
+		Api:            "lambda",
+		RequiredParams: []string{"handler", "name", "role", "runtime"},
+		ExtraParams:    []string{"bucket", "description", "groups", "memory", "object", "objectversion", "publish", "subnets", "timeout", "zipfile"},
+		Revert: &template.RevertRule{
+			Action:      "delete",
+			ResultParam: "id",
+		},
+	},
+	"deletefunction": {
+		Action:         "delete",
+		Entity:         "function",
+		Api:            "lambda",
+		RequiredParams: []string{"id"},
+		ExtraParams:    []string{"version"},
+	},
+	"createalarm": {
+		Action:         "create",
+		Entity:         "alarm",
+		Api:            "cloudwatch",
+		RequiredParams: []string{"evaluation-periods", "metric", "name", "namespace", "operator", "period", "statistic", "threshold"},
+		ExtraParams:    []string{"alarm-actions", "description", "dimensions", "enabled", "insufficientdata-actions", "ok-actions", "unit"},
+		Revert: &template.RevertRule{
+			Action:      "delete",
+			ResultParam: "id",
+		},
+	},
+	"deletealarm": {
+		Action:         "delete",
+		Entity:         "alarm",
+		Api:            "cloudwatch",
+		RequiredParams: []string{"id"},
+		ExtraParams:    []string{},
+	},
+	"startalarm": {
+		Action:         "start",
+		Entity:         "alarm",
+		Api:            "cloudwatch",
+		RequiredParams: []string{"id"},
+		ExtraParams:    []string{},
+		Revert: &template.RevertRule{
+			Action: "stop",
+			Params: map[string]string{"id": "id"},
+		},
+		PlannedProperties: map[string]interface{}{"ActionsEnabled": true},
+	},
+	"stopalarm": {
+		Action:         "stop",
+		Entity:         "alarm",
+		Api:            "cloudwatch",
+		RequiredParams: []string{"id"},
+		ExtraParams:    []string{},
+		Revert: &template.RevertRule{
+			Action: "start",
+			Params: map[string]string{"id": "id"},
+		},
+		PlannedProperties: map[string]interface{}{"ActionsEnabled": false},
+	},
+	"checkinstance": {
+		Action:         "check",
+		Entity:         "instance",
+		Api:            "ec2",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{"interval"},
+	},
+	"checksubnet": {
+		Action:         "check",
+		Entity:         "subnet",
+		Api:            "ec2",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{"interval"},
+	},
+	"checkvpc": {
+		Action:         "check",
+		Entity:         "vpc",
+		Api:            "ec2",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{"interval"},
+	},
+	"checkkeypair": {
+		Action:         "check",
+		Entity:         "keypair",
+		Api:            "ec2",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{"interval"},
+	},
+	"checksecuritygroup": {
+		Action:         "check",
+		Entity:         "securitygroup",
+		Api:            "ec2",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{"interval"},
+	},
+	"checkvolume": {
+		Action:         "check",
+		Entity:         "volume",
+		Api:            "ec2",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{"interval"},
+	},
+	"checksnapshot": {
+		Action:         "check",
+		Entity:         "snapshot",
+		Api:            "ec2",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{"interval"},
+	},
+	"checkimage": {
+		Action:         "check",
+		Entity:         "image",
+		Api:            "ec2",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{"interval"},
+	},
+	"checkinternetgateway": {
+		Action:         "check",
+		Entity:         "internetgateway",
+		Api:            "ec2",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{"interval"},
+	},
+	"checkroutetable": {
+		Action:         "check",
+		Entity:         "routetable",
+		Api:            "ec2",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{"interval"},
+	},
+	"checkavailabilityzone": {
+		Action:         "check",
+		Entity:         "availabilityzone",
+		Api:            "ec2",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{"interval"},
+	},
+	"checkelasticip": {
+		Action:         "check",
+		Entity:         "elasticip",
+		Api:            "ec2",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{"interval"},
+	},
+	"checknatgateway": {
+		Action:         "check",
+		Entity:         "natgateway",
+		Api:            "ec2",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{"interval"},
+	},
+	"checknetworkinterface": {
+		Action:         "check",
+		Entity:         "networkinterface",
+		Api:            "ec2",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{"interval"},
+	},
+	"checkloadbalancer": {
+		Action:         "check",
+		Entity:         "loadbalancer",
+		Api:            "elbv2",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{"interval"},
+	},
+	"checktargetgroup": {
+		Action:         "check",
+		Entity:         "targetgroup",
+		Api:            "elbv2",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{"interval"},
+	},
+	"checklistener": {
+		Action:         "check",
+		Entity:         "listener",
+		Api:            "elbv2",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{"interval"},
+	},
+	"checkdatabase": {
+		Action:         "check",
+		Entity:         "database",
+		Api:            "rds",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{"interval"},
+	},
+	"checkdbsubnetgroup": {
+		Action:         "check",
+		Entity:         "dbsubnetgroup",
+		Api:            "rds",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{"interval"},
+	},
+	"checklaunchconfiguration": {
+		Action:         "check",
+		Entity:         "launchconfiguration",
+		Api:            "autoscaling",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{"interval"},
+	},
+	"checkscalinggroup": {
+		Action:         "check",
+		Entity:         "scalinggroup",
+		Api:            "autoscaling",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{"interval"},
+	},
+	"checkscalingpolicy": {
+		Action:         "check",
+		Entity:         "scalingpolicy",
+		Api:            "autoscaling",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{"interval"},
+	},
+	"checkuser": {
+		Action:         "check",
+		Entity:         "user",
+		Api:            "iam",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{"interval"},
+	},
+	"checkgroup": {
+		Action:         "check",
+		Entity:         "group",
+		Api:            "iam",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{"interval"},
+	},
+	"checkrole": {
+		Action:         "check",
+		Entity:         "role",
+		Api:            "iam",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{"interval"},
+	},
+	"checkpolicy": {
+		Action:         "check",
+		Entity:         "policy",
+		Api:            "iam",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{"interval"},
+	},
+	"checkbucket": {
+		Action:         "check",
+		Entity:         "bucket",
+		Api:            "s3",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{"interval"},
+	},
+	"checkstorageobject": {
+		Action:         "check",
+		Entity:         "storageobject",
+		Api:            "s3",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{"interval"},
+	},
+	"checksubscription": {
+		Action:         "check",
+		Entity:         "subscription",
+		Api:            "sns",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{"interval"},
+	},
+	"checktopic": {
+		Action:         "check",
+		Entity:         "topic",
+		Api:            "sns",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{"interval"},
+	},
+	"checkqueue": {
+		Action:         "check",
+		Entity:         "queue",
+		Api:            "sqs",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{"interval"},
+	},
+	"checkzone": {
+		Action:         "check",
+		Entity:         "zone",
+		Api:            "route53",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{"interval"},
+	},
+	"checkrecord": {
+		Action:         "check",
+		Entity:         "record",
+		Api:            "route53",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{"interval"},
+	},
+	"checkfunction": {
+		Action:         "check",
+		Entity:         "function",
+		Api:            "lambda",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{"interval"},
+	},
+	"checkalarm": {
+		Action:         "check",
+		Entity:         "alarm",
+		Api:            "cloudwatch",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{"interval"},
 	},
 }
 
@@ -512,7 +1203,6 @@ func DriverSupportedActions() map[string][]string {
 	supported["delete"] = append(supported["delete"], "instance")
 	supported["start"] = append(supported["start"], "instance")
 	supported["stop"] = append(supported["stop"], "instance")
-	supported["check"] = append(supported["check"], "instance")
 	supported["create"] = append(supported["create"], "securitygroup")
 	supported["update"] = append(supported["update"], "securitygroup")
 	supported["delete"] = append(supported["delete"], "securitygroup")
@@ -520,6 +1210,11 @@ func DriverSupportedActions() map[string][]string {
 	supported["delete"] = append(supported["delete"], "volume")
 	supported["attach"] = append(supported["attach"], "volume")
 	supported["detach"] = append(supported["detach"], "volume")
+	supported["create"] = append(supported["create"], "snapshot")
+	supported["delete"] = append(supported["delete"], "snapshot")
+	supported["create"] = append(supported["create"], "image")
+	supported["copy"] = append(supported["copy"], "image")
+	supported["delete"] = append(supported["delete"], "image")
 	supported["create"] = append(supported["create"], "internetgateway")
 	supported["delete"] = append(supported["delete"], "internetgateway")
 	supported["attach"] = append(supported["attach"], "internetgateway")
@@ -530,6 +1225,16 @@ func DriverSupportedActions() map[string][]string {
 	supported["detach"] = append(supported["detach"], "routetable")
 	supported["create"] = append(supported["create"], "route")
 	supported["delete"] = append(supported["delete"], "route")
+	supported["create"] = append(supported["create"], "elasticip")
+	supported["delete"] = append(supported["delete"], "elasticip")
+	supported["attach"] = append(supported["attach"], "elasticip")
+	supported["detach"] = append(supported["detach"], "elasticip")
+	supported["create"] = append(supported["create"], "natgateway")
+	supported["delete"] = append(supported["delete"], "natgateway")
+	supported["create"] = append(supported["create"], "networkinterface")
+	supported["delete"] = append(supported["delete"], "networkinterface")
+	supported["attach"] = append(supported["attach"], "networkinterface")
+	supported["detach"] = append(supported["detach"], "networkinterface")
 	supported["create"] = append(supported["create"], "tag")
 	supported["delete"] = append(supported["delete"], "tag")
 	supported["create"] = append(supported["create"], "keypair")
@@ -546,6 +1251,13 @@ func DriverSupportedActions() map[string][]string {
 	supported["delete"] = append(supported["delete"], "database")
 	supported["create"] = append(supported["create"], "dbsubnetgroup")
 	supported["delete"] = append(supported["delete"], "dbsubnetgroup")
+	supported["create"] = append(supported["create"], "launchconfiguration")
+	supported["delete"] = append(supported["delete"], "launchconfiguration")
+	supported["create"] = append(supported["create"], "scalinggroup")
+	supported["update"] = append(supported["update"], "scalinggroup")
+	supported["delete"] = append(supported["delete"], "scalinggroup")
+	supported["create"] = append(supported["create"], "scalingpolicy")
+	supported["delete"] = append(supported["delete"], "scalingpolicy")
 	supported["create"] = append(supported["create"], "user")
 	supported["delete"] = append(supported["delete"], "user")
 	supported["attach"] = append(supported["attach"], "user")
@@ -570,5 +1282,46 @@ func DriverSupportedActions() map[string][]string {
 	supported["delete"] = append(supported["delete"], "zone")
 	supported["create"] = append(supported["create"], "record")
 	supported["delete"] = append(supported["delete"], "record")
+	supported["create"] = append(supported["create"], "function")
+	supported["delete"] = append(supported["delete"], "function")
+	supported["create"] = append(supported["create"], "alarm")
+	supported["delete"] = append(supported["delete"], "alarm")
+	supported["start"] = append(supported["start"], "alarm")
+	supported["stop"] = append(supported["stop"], "alarm")
+	supported["check"] = append(supported["check"], "instance")
+	supported["check"] = append(supported["check"], "subnet")
+	supported["check"] = append(supported["check"], "vpc")
+	supported["check"] = append(supported["check"], "keypair")
+	supported["check"] = append(supported["check"], "securitygroup")
+	supported["check"] = append(supported["check"], "volume")
+	supported["check"] = append(supported["check"], "snapshot")
+	supported["check"] = append(supported["check"], "image")
+	supported["check"] = append(supported["check"], "internetgateway")
+	supported["check"] = append(supported["check"], "routetable")
+	supported["check"] = append(supported["check"], "availabilityzone")
+	supported["check"] = append(supported["check"], "elasticip")
+	supported["check"] = append(supported["check"], "natgateway")
+	supported["check"] = append(supported["check"], "networkinterface")
+	supported["check"] = append(supported["check"], "loadbalancer")
+	supported["check"] = append(supported["check"], "targetgroup")
+	supported["check"] = append(supported["check"], "listener")
+	supported["check"] = append(supported["check"], "database")
+	supported["check"] = append(supported["check"], "dbsubnetgroup")
+	supported["check"] = append(supported["check"], "launchconfiguration")
+	supported["check"] = append(supported["check"], "scalinggroup")
+	supported["check"] = append(supported["check"], "scalingpolicy")
+	supported["check"] = append(supported["check"], "user")
+	supported["check"] = append(supported["check"], "group")
+	supported["check"] = append(supported["check"], "role")
+	supported["check"] = append(supported["check"], "policy")
+	supported["check"] = append(supported["check"], "bucket")
+	supported["check"] = append(supported["check"], "storageobject")
+	supported["check"] = append(supported["check"], "subscription")
+	supported["check"] = append(supported["check"], "topic")
+	supported["check"] = append(supported["check"], "queue")
+	supported["check"] = append(supported["check"], "zone")
+	supported["check"] = append(supported["check"], "record")
+	supported["check"] = append(supported["check"], "function")
+	supported["check"] = append(supported["check"], "alarm")
 	return supported
 }
@@ -21,9 +21,11 @@ import (
 	"reflect"
 	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/aws-sdk-go/service/ec2"
 )
 
@@ -38,6 +40,9 @@ const (
 	awsstringslice
 	awsstringpointermap
 	awsslicestruct
+	awscsvstr
+	awsfloat
+	awsdimensionslice
 )
 
 var (
@@ -72,6 +77,11 @@ func setFieldWithType(v, i interface{}, fieldPath string, destType int) (err err
 		if err != nil {
 			return
 		}
+	case awsfloat:
+		v, err = castFloat(v)
+		if err != nil {
+			return
+		}
 	case awsstringslice:
 		switch vv := v.(type) {
 		case string:
@@ -86,6 +96,41 @@ func setFieldWithType(v, i interface{}, fieldPath string, destType int) (err err
 			str := fmt.Sprint(v)
 			v = []*string{&str}
 		}
+	case awscsvstr:
+		switch vv := v.(type) {
+		case []string:
+			v = strings.Join(vv, ",")
+		case []interface{}:
+			var values []string
+			for _, e := range vv {
+				values = append(values, fmt.Sprint(e))
+			}
+			v = strings.Join(values, ",")
+		default:
+			v = fmt.Sprint(v)
+		}
+	case awsdimensionslice:
+		var values []string
+		switch vv := v.(type) {
+		case []string:
+			values = vv
+		case []interface{}:
+			for _, e := range vv {
+				values = append(values, fmt.Sprint(e))
+			}
+		default:
+			values = []string{fmt.Sprint(v)}
+		}
+		var dimensions []*cloudwatch.Dimension
+		for _, value := range values {
+			splits := strings.SplitN(value, ":", 2)
+			if len(splits) != 2 {
+				err = fmt.Errorf("set field awsdimensionslice: invalid dimension '%s', expected 'Name:Value'", value)
+				return
+			}
+			dimensions = append(dimensions, &cloudwatch.Dimension{Name: aws.String(splits[0]), Value: aws.String(splits[1])})
+		}
+		v = dimensions
 	case awsint64slice:
 		var awsint int64
 		awsint, err = castInt64(v)
@@ -173,6 +218,21 @@ func castInt(v interface{}) (int, error) {
 	}
 }
 
+func castFloat(v interface{}) (float64, error) {
+	switch vv := v.(type) {
+	case string:
+		return strconv.ParseFloat(vv, 64)
+	case int:
+		return float64(vv), nil
+	case int64:
+		return float64(vv), nil
+	case float64:
+		return vv, nil
+	default:
+		return 0, fmt.Errorf("cannot cast %T to float", v)
+	}
+}
+
 func castBool(v interface{}) (bool, error) {
 	switch vv := v.(type) {
 	case string:
@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/aws-sdk-go/service/ec2"
 )
 
@@ -58,6 +59,58 @@ func TestSetFieldsOnAwsStruct(t *testing.T) {
 	}
 }
 
+func TestSetMetricAlarmFieldsOnAwsStruct(t *testing.T) {
+	awsparams := &cloudwatch.PutMetricAlarmInput{}
+
+	err := setFieldWithType("75.5", awsparams, "Threshold", awsfloat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := aws.Float64Value(awsparams.Threshold), 75.5; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	err = setFieldWithType(80, awsparams, "Threshold", awsfloat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := aws.Float64Value(awsparams.Threshold), float64(80); got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	err = setFieldWithType("InstanceId:i-1234", awsparams, "Dimensions", awsdimensionslice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(awsparams.Dimensions), 1; got != want {
+		t.Fatalf("len: got %d, want %d", got, want)
+	}
+	if got, want := aws.StringValue(awsparams.Dimensions[0].Name), "InstanceId"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := aws.StringValue(awsparams.Dimensions[0].Value), "i-1234"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	err = setFieldWithType([]string{"LoadBalancer:app/my-lb/50dc6c495c0c9188", "TargetGroup:targetgroup/my-tg/943f017f100becff"}, awsparams, "Dimensions", awsdimensionslice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(awsparams.Dimensions), 2; got != want {
+		t.Fatalf("len: got %d, want %d", got, want)
+	}
+	if got, want := aws.StringValue(awsparams.Dimensions[0].Value), "app/my-lb/50dc6c495c0c9188"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := aws.StringValue(awsparams.Dimensions[1].Name), "TargetGroup"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	err = setFieldWithType("InstanceId", awsparams, "Dimensions", awsdimensionslice)
+	if err == nil {
+		t.Fatal("expected error got nil")
+	}
+}
+
 func TestSetFieldWithMultiType(t *testing.T) {
 	any := struct {
 		Field             string
@@ -68,6 +121,7 @@ func TestSetFieldWithMultiType(t *testing.T) {
 		Int64ArrayField   []*int64
 		BooleanValueField *ec2.AttributeBooleanValue
 		StringValueField  *ec2.AttributeValue
+		CSVStringField    *string
 		StructAttribute   struct {
 			Str  *string
 			Bool *bool
@@ -209,6 +263,21 @@ func TestSetFieldWithMultiType(t *testing.T) {
 		t.Fatalf("len: got %s, want %s", got, want)
 	}
 
+	err = setFieldWithType([]string{"sub-1", "sub-2"}, &any, "CSVStringField", awscsvstr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := aws.StringValue(any.CSVStringField), "sub-1,sub-2"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	err = setFieldWithType("sub-3", &any, "CSVStringField", awscsvstr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := aws.StringValue(any.CSVStringField), "sub-3"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
 	err = setFieldWithType(true, &any, "BoolField", awsbool)
 	if err != nil {
 		t.Fatal(err)
@@ -0,0 +1,238 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/template"
+)
+
+func TestRevertTemplatesDefinitions(t *testing.T) {
+	// commands are run with each param set to its name (or to the first
+	// value of inverted params) and "result" as result
+	expected := map[string]string{
+		"attachelasticip":           "detach elasticip association=result",
+		"attachinstance":            "detach instance group=group id=id",
+		"attachinternetgateway":     "detach internetgateway id=id vpc=vpc",
+		"attachnetworkinterface":    "detach networkinterface attachment=result",
+		"attachpolicy":              "detach policy arn=arn group=group user=user",
+		"attachroutetable":          "detach routetable association=result",
+		"attachuser":                "detach user group=group name=name",
+		"attachvolume":              "detach volume device=device id=id instance=instance",
+		"copyimage":                 "delete image id=result",
+		"createaccesskey":           "delete accesskey id=result user=user",
+		"createalarm":               "delete alarm id=result",
+		"createbucket":              "delete bucket name=name",
+		"createdatabase":            "delete database id=result skipsnapshot=true\ncheck database id=result state=not-found timeout=900",
+		"createdbsubnetgroup":       "delete dbsubnetgroup id=result",
+		"createelasticip":           "delete elasticip id=result",
+		"createfunction":            "delete function id=result",
+		"creategroup":               "delete group name=name",
+		"createimage":               "delete image id=result",
+		"createinstance":            "delete instance id=result\ncheck instance id=result state=terminated timeout=180",
+		"createinternetgateway":     "delete internetgateway id=result",
+		"createkeypair":             "delete keypair id=result",
+		"createlaunchconfiguration": "delete launchconfiguration id=result",
+		"createlistener":            "delete listener id=result",
+		"createloadbalancer":        "delete loadbalancer id=result\ncheck loadbalancer id=result state=not-found timeout=300",
+		"createnatgateway":          "delete natgateway id=result\ncheck natgateway id=result state=deleted timeout=300",
+		"createnetworkinterface":    "delete networkinterface id=result",
+		"createqueue":               "delete queue url=result",
+		"createrecord":              "delete record name=name ttl=ttl type=type value=value zone=zone",
+		"createroute":               "delete route cidr=cidr table=table",
+		"createroutetable":          "delete routetable id=result",
+		"createscalinggroup":        "delete scalinggroup force=true id=result\ncheck scalinggroup id=result state=not-found timeout=600",
+		"createscalingpolicy":       "delete scalingpolicy id=result",
+		"createsecuritygroup":       "delete securitygroup id=result",
+		"createsnapshot":            "delete snapshot id=result",
+		"createstorageobject":       "delete storageobject bucket=bucket key=name",
+		"createsubnet":              "delete subnet id=result",
+		"createsubscription":        "delete subscription id=result",
+		"createtag":                 "delete tag key=key resource=resource value=value",
+		"createtargetgroup":         "delete targetgroup id=result",
+		"createtopic":               "delete topic id=result",
+		"createuser":                "delete user name=name",
+		"createvolume":              "delete volume id=result",
+		"createvpc":                 "delete vpc id=result",
+		"createzone":                "delete zone id=result",
+		"deleteaccesskey":           "",
+		"deletealarm":               "",
+		"deletebucket":              "",
+		"deletedatabase":            "",
+		"deletedbsubnetgroup":       "",
+		"deleteelasticip":           "",
+		"deletefunction":            "",
+		"deletegroup":               "",
+		"deleteimage":               "",
+		"deleteinstance":            "",
+		"deleteinternetgateway":     "",
+		"deletekeypair":             "",
+		"deletelaunchconfiguration": "",
+		"deletelistener":            "",
+		"deleteloadbalancer":        "",
+		"deletenatgateway":          "",
+		"deletenetworkinterface":    "",
+		"deletequeue":               "",
+		"deleterecord":              "create record name=name ttl=ttl type=type value=value zone=zone",
+		"deleteroute":               "",
+		"deleteroutetable":          "",
+		"deletescalinggroup":        "",
+		"deletescalingpolicy":       "",
+		"deletesecuritygroup":       "",
+		"deletesnapshot":            "",
+		"deletestorageobject":       "",
+		"deletesubnet":              "",
+		"deletesubscription":        "",
+		"deletetag":                 "create tag key=key resource=resource value=value",
+		"deletetargetgroup":         "",
+		"deletetopic":               "",
+		"deleteuser":                "",
+		"deletevolume":              "",
+		"deletevpc":                 "",
+		"deletezone":                "",
+		"detachelasticip":           "",
+		"detachinstance":            "attach instance group=group id=id",
+		"detachinternetgateway":     "attach internetgateway id=id vpc=vpc",
+		"detachnetworkinterface":    "",
+		"detachpolicy":              "attach policy arn=arn group=group user=user",
+		"detachroutetable":          "",
+		"detachuser":                "attach user group=group name=name",
+		"detachvolume":              "attach volume device=device id=id instance=instance",
+		"startalarm":                "stop alarm id=id",
+		"startinstance":             "stop instance id=result",
+		"stopalarm":                 "start alarm id=id",
+		"stopinstance":              "start instance id=result",
+		"updateinstance":            "update instance group=previous-group id=id type=previous-type",
+		"updatescalinggroup":        "update scalinggroup cooldown=previous-cooldown desired-capacity=previous-desired-capacity healthcheck-type=previous-healthcheck-type id=id launchconfiguration=previous-launchconfiguration max-size=previous-max-size min-size=previous-min-size",
+		"updatesecuritygroup":       "update securitygroup cidr=cidr id=id inbound=revoke outbound=revoke portrange=portrange protocol=protocol",
+		"updatesubnet":              "update subnet id=id public=previous-public",
+	}
+
+	lookup := func(key string) (template.Definition, bool) {
+		def, ok := AWSTemplatesDefinitions[key]
+		return def, ok
+	}
+
+	var names []string
+	for name := range AWSTemplatesDefinitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		def := AWSTemplatesDefinitions[name]
+		if def.Action == "check" {
+			continue
+		}
+		exp, ok := expected[name]
+		if !ok {
+			t.Errorf("%s: no expected revert, add it to the test (empty if not revertible)", name)
+		}
+
+		tpl, err := template.Parse(sampleCommand(def))
+		if err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+		for _, cmd := range tpl.CommandNodesIterator() {
+			cmd.CmdResult = "result"
+			if def.Revert != nil && def.Revert.Previous != nil {
+				cmd.CmdPrevious = make(map[string]interface{})
+				for param := range def.Revert.Previous {
+					cmd.CmdPrevious[param] = "previous-" + param
+				}
+			}
+		}
+
+		if !template.IsRevertible(tpl, lookup) {
+			if exp != "" {
+				t.Errorf("%s: expected to be revertible with '%s'", name, exp)
+			}
+			continue
+		}
+		reverted, err := tpl.Revert(lookup)
+		if err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+		if got, want := reverted.String(), exp; got != want {
+			t.Errorf("%s: got '%s', want '%s'", name, got, want)
+		}
+
+		for _, cmd := range reverted.CommandNodesIterator() {
+			inverseDef, ok := lookup(cmd.Action + cmd.Entity)
+			if !ok {
+				t.Errorf("%s: no definition for reverting command '%s %s'", name, cmd.Action, cmd.Entity)
+				continue
+			}
+			known := append(inverseDef.Required(), inverseDef.Extra()...)
+			for param := range cmd.Params {
+				if !contains(known, param) {
+					t.Errorf("%s: unexpected param '%s' in reverting command '%s'", name, param, cmd)
+				}
+			}
+		}
+	}
+
+	for name := range expected {
+		if _, ok := AWSTemplatesDefinitions[name]; !ok {
+			t.Errorf("%s: expected revert of unknown definition", name)
+		}
+	}
+}
+
+// sampleCommand returns the command of the definition with its required
+// params, its restorable params for updates, and its extra params otherwise
+func sampleCommand(def template.Definition) string {
+	params := def.Required()
+	if def.Revert != nil && def.Revert.Previous != nil {
+		for param := range def.Revert.Previous {
+			params = append(params, param)
+		}
+	} else {
+		params = append(params, def.Extra()...)
+	}
+
+	var args []string
+	for _, param := range params {
+		value := param
+		if def.Revert != nil {
+			var inverted []string
+			for v := range def.Revert.Inverted[param] {
+				inverted = append(inverted, v)
+			}
+			if len(inverted) > 0 {
+				sort.Strings(inverted)
+				value = inverted[0]
+			}
+		}
+		args = append(args, fmt.Sprintf("%s=%s", param, value))
+	}
+
+	return fmt.Sprintf("%s %s %s", def.Action, def.Entity, strings.Join(args, " "))
+}
+
+func contains(arr []string, s string) bool {
+	for _, e := range arr {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
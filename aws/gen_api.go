@@ -27,12 +27,18 @@ import (
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
 	"github.com/aws/aws-sdk-go/service/route53"
@@ -61,6 +67,8 @@ func init() {
 	ServiceNames = append(ServiceNames, "notification")
 	ServiceNames = append(ServiceNames, "queue")
 	ServiceNames = append(ServiceNames, "dns")
+	ServiceNames = append(ServiceNames, "lambda")
+	ServiceNames = append(ServiceNames, "monitoring")
 }
 
 var ServiceNames = []string{}
@@ -72,14 +80,22 @@ var ResourceTypes = []string{
 	"keypair",
 	"securitygroup",
 	"volume",
+	"snapshot",
+	"image",
 	"internetgateway",
 	"routetable",
 	"availabilityzone",
+	"elasticip",
+	"natgateway",
+	"networkinterface",
 	"loadbalancer",
 	"targetgroup",
 	"listener",
 	"database",
 	"dbsubnetgroup",
+	"launchconfiguration",
+	"scalinggroup",
+	"scalingpolicy",
 	"user",
 	"group",
 	"role",
@@ -91,46 +107,61 @@ var ResourceTypes = []string{
 	"queue",
 	"zone",
 	"record",
+	"function",
+	"alarm",
 }
 
 var ServicePerAPI = map[string]string{
-	"ec2":     "infra",
-	"elbv2":   "infra",
-	"rds":     "infra",
-	"iam":     "access",
-	"sts":     "access",
-	"s3":      "storage",
-	"sns":     "notification",
-	"sqs":     "queue",
-	"route53": "dns",
+	"ec2":         "infra",
+	"elbv2":       "infra",
+	"rds":         "infra",
+	"autoscaling": "infra",
+	"iam":         "access",
+	"sts":         "access",
+	"s3":          "storage",
+	"sns":         "notification",
+	"sqs":         "queue",
+	"route53":     "dns",
+	"lambda":      "lambda",
+	"cloudwatch":  "monitoring",
 }
 
 var ServicePerResourceType = map[string]string{
-	"instance":         "infra",
-	"subnet":           "infra",
-	"vpc":              "infra",
-	"keypair":          "infra",
-	"securitygroup":    "infra",
-	"volume":           "infra",
-	"internetgateway":  "infra",
-	"routetable":       "infra",
-	"availabilityzone": "infra",
-	"loadbalancer":     "infra",
-	"targetgroup":      "infra",
-	"listener":         "infra",
-	"database":         "infra",
-	"dbsubnetgroup":    "infra",
-	"user":             "access",
-	"group":            "access",
-	"role":             "access",
-	"policy":           "access",
-	"bucket":           "storage",
-	"storageobject":    "storage",
-	"subscription":     "notification",
-	"topic":            "notification",
-	"queue":            "queue",
-	"zone":             "dns",
-	"record":           "dns",
+	"instance":            "infra",
+	"subnet":              "infra",
+	"vpc":                 "infra",
+	"keypair":             "infra",
+	"securitygroup":       "infra",
+	"volume":              "infra",
+	"snapshot":            "infra",
+	"image":               "infra",
+	"internetgateway":     "infra",
+	"routetable":          "infra",
+	"availabilityzone":    "infra",
+	"elasticip":           "infra",
+	"natgateway":          "infra",
+	"networkinterface":    "infra",
+	"loadbalancer":        "infra",
+	"targetgroup":         "infra",
+	"listener":            "infra",
+	"database":            "infra",
+	"dbsubnetgroup":       "infra",
+	"launchconfiguration": "infra",
+	"scalinggroup":        "infra",
+	"scalingpolicy":       "infra",
+	"user":                "access",
+	"group":               "access",
+	"role":                "access",
+	"policy":              "access",
+	"bucket":              "storage",
+	"storageobject":       "storage",
+	"subscription":        "notification",
+	"topic":               "notification",
+	"queue":               "queue",
+	"zone":                "dns",
+	"record":              "dns",
+	"function":            "lambda",
+	"alarm":               "monitoring",
 }
 
 type Infra struct {
@@ -141,17 +172,19 @@ type Infra struct {
 	ec2iface.EC2API
 	elbv2iface.ELBV2API
 	rdsiface.RDSAPI
+	autoscalingiface.AutoScalingAPI
 }
 
 func NewInfra(sess *session.Session, awsconf config, log *logger.Logger) cloud.Service {
 	region := awssdk.StringValue(sess.Config.Region)
 	return &Infra{
-		EC2API:   ec2.New(sess),
-		ELBV2API: elbv2.New(sess),
-		RDSAPI:   rds.New(sess),
-		config:   awsconf,
-		region:   region,
-		log:      log,
+		EC2API:         ec2.New(sess),
+		ELBV2API:       elbv2.New(sess),
+		RDSAPI:         rds.New(sess),
+		AutoScalingAPI: autoscaling.New(sess),
+		config:         awsconf,
+		region:         region,
+		log:            log,
 	}
 }
 
@@ -164,6 +197,8 @@ func (s *Infra) Drivers() []driver.Driver {
 		awsdriver.NewEc2Driver(s.EC2API),
 		awsdriver.NewElbv2Driver(s.ELBV2API),
 		awsdriver.NewRdsDriver(s.RDSAPI),
+		awsdriver.NewAutoscalingDriver(s.AutoScalingAPI),
+		awsdriver.NewCheckDriver(s.FetchByID, s.ResourceTypes()...),
 	}
 }
 
@@ -174,14 +209,22 @@ func (s *Infra) ResourceTypes() (all []string) {
 	all = append(all, "keypair")
 	all = append(all, "securitygroup")
 	all = append(all, "volume")
+	all = append(all, "snapshot")
+	all = append(all, "image")
 	all = append(all, "internetgateway")
 	all = append(all, "routetable")
 	all = append(all, "availabilityzone")
+	all = append(all, "elasticip")
+	all = append(all, "natgateway")
+	all = append(all, "networkinterface")
 	all = append(all, "loadbalancer")
 	all = append(all, "targetgroup")
 	all = append(all, "listener")
 	all = append(all, "database")
 	all = append(all, "dbsubnetgroup")
+	all = append(all, "launchconfiguration")
+	all = append(all, "scalinggroup")
+	all = append(all, "scalingpolicy")
 	return
 }
 
@@ -201,14 +244,22 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 	var keypairList []*ec2.KeyPairInfo
 	var securitygroupList []*ec2.SecurityGroup
 	var volumeList []*ec2.Volume
+	var snapshotList []*ec2.Snapshot
+	var imageList []*ec2.Image
 	var internetgatewayList []*ec2.InternetGateway
 	var routetableList []*ec2.RouteTable
 	var availabilityzoneList []*ec2.AvailabilityZone
+	var elasticipList []*ec2.Address
+	var natgatewayList []*ec2.NatGateway
+	var networkinterfaceList []*ec2.NetworkInterface
 	var loadbalancerList []*elbv2.LoadBalancer
 	var targetgroupList []*elbv2.TargetGroup
 	var listenerList []*elbv2.Listener
 	var databaseList []*rds.DBInstance
 	var dbsubnetgroupList []*rds.DBSubnetGroup
+	var launchconfigurationList []*autoscaling.LaunchConfiguration
+	var scalinggroupList []*autoscaling.Group
+	var scalingpolicyList []*autoscaling.ScalingPolicy
 
 	errc := make(chan error)
 	var wg sync.WaitGroup
@@ -309,6 +360,38 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 	} else {
 		s.log.Verbose("sync: *disabled* for resource infra[volume]")
 	}
+	if s.config.getBool("aws.infra.snapshot.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var resGraph *graph.Graph
+			var err error
+			resGraph, snapshotList, err = s.fetch_all_snapshot_graph()
+			if err != nil {
+				errc <- err
+				return
+			}
+			g.AddGraph(resGraph)
+		}()
+	} else {
+		s.log.Verbose("sync: *disabled* for resource infra[snapshot]")
+	}
+	if s.config.getBool("aws.infra.image.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var resGraph *graph.Graph
+			var err error
+			resGraph, imageList, err = s.fetch_all_image_graph()
+			if err != nil {
+				errc <- err
+				return
+			}
+			g.AddGraph(resGraph)
+		}()
+	} else {
+		s.log.Verbose("sync: *disabled* for resource infra[image]")
+	}
 	if s.config.getBool("aws.infra.internetgateway.sync", true) {
 		wg.Add(1)
 		go func() {
@@ -357,6 +440,54 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 	} else {
 		s.log.Verbose("sync: *disabled* for resource infra[availabilityzone]")
 	}
+	if s.config.getBool("aws.infra.elasticip.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var resGraph *graph.Graph
+			var err error
+			resGraph, elasticipList, err = s.fetch_all_elasticip_graph()
+			if err != nil {
+				errc <- err
+				return
+			}
+			g.AddGraph(resGraph)
+		}()
+	} else {
+		s.log.Verbose("sync: *disabled* for resource infra[elasticip]")
+	}
+	if s.config.getBool("aws.infra.natgateway.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var resGraph *graph.Graph
+			var err error
+			resGraph, natgatewayList, err = s.fetch_all_natgateway_graph()
+			if err != nil {
+				errc <- err
+				return
+			}
+			g.AddGraph(resGraph)
+		}()
+	} else {
+		s.log.Verbose("sync: *disabled* for resource infra[natgateway]")
+	}
+	if s.config.getBool("aws.infra.networkinterface.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var resGraph *graph.Graph
+			var err error
+			resGraph, networkinterfaceList, err = s.fetch_all_networkinterface_graph()
+			if err != nil {
+				errc <- err
+				return
+			}
+			g.AddGraph(resGraph)
+		}()
+	} else {
+		s.log.Verbose("sync: *disabled* for resource infra[networkinterface]")
+	}
 	if s.config.getBool("aws.infra.loadbalancer.sync", true) {
 		wg.Add(1)
 		go func() {
@@ -437,6 +568,54 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 	} else {
 		s.log.Verbose("sync: *disabled* for resource infra[dbsubnetgroup]")
 	}
+	if s.config.getBool("aws.infra.launchconfiguration.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var resGraph *graph.Graph
+			var err error
+			resGraph, launchconfigurationList, err = s.fetch_all_launchconfiguration_graph()
+			if err != nil {
+				errc <- err
+				return
+			}
+			g.AddGraph(resGraph)
+		}()
+	} else {
+		s.log.Verbose("sync: *disabled* for resource infra[launchconfiguration]")
+	}
+	if s.config.getBool("aws.infra.scalinggroup.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var resGraph *graph.Graph
+			var err error
+			resGraph, scalinggroupList, err = s.fetch_all_scalinggroup_graph()
+			if err != nil {
+				errc <- err
+				return
+			}
+			g.AddGraph(resGraph)
+		}()
+	} else {
+		s.log.Verbose("sync: *disabled* for resource infra[scalinggroup]")
+	}
+	if s.config.getBool("aws.infra.scalingpolicy.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var resGraph *graph.Graph
+			var err error
+			resGraph, scalingpolicyList, err = s.fetch_all_scalingpolicy_graph()
+			if err != nil {
+				errc <- err
+				return
+			}
+			g.AddGraph(resGraph)
+		}()
+	} else {
+		s.log.Verbose("sync: *disabled* for resource infra[scalingpolicy]")
+	}
 
 	go func() {
 		wg.Wait()
@@ -550,6 +729,36 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 			}
 		}()
 	}
+	if s.config.getBool("aws.infra.snapshot.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, r := range snapshotList {
+				for _, fn := range addParentsFns["snapshot"] {
+					err := fn(g, r)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}
+			}
+		}()
+	}
+	if s.config.getBool("aws.infra.image.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, r := range imageList {
+				for _, fn := range addParentsFns["image"] {
+					err := fn(g, r)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}
+			}
+		}()
+	}
 	if s.config.getBool("aws.infra.internetgateway.sync", true) {
 		wg.Add(1)
 		go func() {
@@ -595,6 +804,51 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 			}
 		}()
 	}
+	if s.config.getBool("aws.infra.elasticip.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, r := range elasticipList {
+				for _, fn := range addParentsFns["elasticip"] {
+					err := fn(g, r)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}
+			}
+		}()
+	}
+	if s.config.getBool("aws.infra.natgateway.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, r := range natgatewayList {
+				for _, fn := range addParentsFns["natgateway"] {
+					err := fn(g, r)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}
+			}
+		}()
+	}
+	if s.config.getBool("aws.infra.networkinterface.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, r := range networkinterfaceList {
+				for _, fn := range addParentsFns["networkinterface"] {
+					err := fn(g, r)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}
+			}
+		}()
+	}
 	if s.config.getBool("aws.infra.loadbalancer.sync", true) {
 		wg.Add(1)
 		go func() {
@@ -670,6 +924,51 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 			}
 		}()
 	}
+	if s.config.getBool("aws.infra.launchconfiguration.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, r := range launchconfigurationList {
+				for _, fn := range addParentsFns["launchconfiguration"] {
+					err := fn(g, r)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}
+			}
+		}()
+	}
+	if s.config.getBool("aws.infra.scalinggroup.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, r := range scalinggroupList {
+				for _, fn := range addParentsFns["scalinggroup"] {
+					err := fn(g, r)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}
+			}
+		}()
+	}
+	if s.config.getBool("aws.infra.scalingpolicy.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, r := range scalingpolicyList {
+				for _, fn := range addParentsFns["scalingpolicy"] {
+					err := fn(g, r)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}
+			}
+		}()
+	}
 
 	go func() {
 		wg.Wait()
@@ -705,6 +1004,12 @@ func (s *Infra) FetchByType(t string) (*graph.Graph, error) {
 	case "volume":
 		graph, _, err := s.fetch_all_volume_graph()
 		return graph, err
+	case "snapshot":
+		graph, _, err := s.fetch_all_snapshot_graph()
+		return graph, err
+	case "image":
+		graph, _, err := s.fetch_all_image_graph()
+		return graph, err
 	case "internetgateway":
 		graph, _, err := s.fetch_all_internetgateway_graph()
 		return graph, err
@@ -714,6 +1019,15 @@ func (s *Infra) FetchByType(t string) (*graph.Graph, error) {
 	case "availabilityzone":
 		graph, _, err := s.fetch_all_availabilityzone_graph()
 		return graph, err
+	case "elasticip":
+		graph, _, err := s.fetch_all_elasticip_graph()
+		return graph, err
+	case "natgateway":
+		graph, _, err := s.fetch_all_natgateway_graph()
+		return graph, err
+	case "networkinterface":
+		graph, _, err := s.fetch_all_networkinterface_graph()
+		return graph, err
 	case "loadbalancer":
 		graph, _, err := s.fetch_all_loadbalancer_graph()
 		return graph, err
@@ -729,16 +1043,95 @@ func (s *Infra) FetchByType(t string) (*graph.Graph, error) {
 	case "dbsubnetgroup":
 		graph, _, err := s.fetch_all_dbsubnetgroup_graph()
 		return graph, err
+	case "launchconfiguration":
+		graph, _, err := s.fetch_all_launchconfiguration_graph()
+		return graph, err
+	case "scalinggroup":
+		graph, _, err := s.fetch_all_scalinggroup_graph()
+		return graph, err
+	case "scalingpolicy":
+		graph, _, err := s.fetch_all_scalingpolicy_graph()
+		return graph, err
 	default:
 		return nil, fmt.Errorf("aws infra: unsupported fetch for type %s", t)
 	}
 }
 
+// FetchByID fetches the resource of the given type and id, or all the
+// resources of the type when they cannot be described by id
+func (s *Infra) FetchByID(t, id string) (*graph.Graph, error) {
+	switch t {
+	case "instance":
+		graph, _, err := s.fetch_instance_graph(&ec2.DescribeInstancesInput{InstanceIds: []*string{awssdk.String(id)}})
+		return graph, err
+	case "subnet":
+		graph, _, err := s.fetch_subnet_graph(&ec2.DescribeSubnetsInput{SubnetIds: []*string{awssdk.String(id)}})
+		return graph, err
+	case "vpc":
+		graph, _, err := s.fetch_vpc_graph(&ec2.DescribeVpcsInput{VpcIds: []*string{awssdk.String(id)}})
+		return graph, err
+	case "keypair":
+		graph, _, err := s.fetch_keypair_graph(&ec2.DescribeKeyPairsInput{KeyNames: []*string{awssdk.String(id)}})
+		return graph, err
+	case "securitygroup":
+		graph, _, err := s.fetch_securitygroup_graph(&ec2.DescribeSecurityGroupsInput{GroupIds: []*string{awssdk.String(id)}})
+		return graph, err
+	case "volume":
+		graph, _, err := s.fetch_volume_graph(&ec2.DescribeVolumesInput{VolumeIds: []*string{awssdk.String(id)}})
+		return graph, err
+	case "snapshot":
+		graph, _, err := s.fetch_snapshot_graph(&ec2.DescribeSnapshotsInput{OwnerIds: []*string{awssdk.String("self")}, SnapshotIds: []*string{awssdk.String(id)}})
+		return graph, err
+	case "image":
+		graph, _, err := s.fetch_image_graph(&ec2.DescribeImagesInput{Owners: []*string{awssdk.String("self")}, ImageIds: []*string{awssdk.String(id)}})
+		return graph, err
+	case "internetgateway":
+		graph, _, err := s.fetch_internetgateway_graph(&ec2.DescribeInternetGatewaysInput{InternetGatewayIds: []*string{awssdk.String(id)}})
+		return graph, err
+	case "routetable":
+		graph, _, err := s.fetch_routetable_graph(&ec2.DescribeRouteTablesInput{RouteTableIds: []*string{awssdk.String(id)}})
+		return graph, err
+	case "natgateway":
+		graph, _, err := s.fetch_natgateway_graph(&ec2.DescribeNatGatewaysInput{NatGatewayIds: []*string{awssdk.String(id)}})
+		return graph, err
+	case "networkinterface":
+		graph, _, err := s.fetch_networkinterface_graph(&ec2.DescribeNetworkInterfacesInput{NetworkInterfaceIds: []*string{awssdk.String(id)}})
+		return graph, err
+	case "loadbalancer":
+		graph, _, err := s.fetch_loadbalancer_graph(&elbv2.DescribeLoadBalancersInput{LoadBalancerArns: []*string{awssdk.String(id)}})
+		return graph, err
+	case "targetgroup":
+		graph, _, err := s.fetch_targetgroup_graph(&elbv2.DescribeTargetGroupsInput{TargetGroupArns: []*string{awssdk.String(id)}})
+		return graph, err
+	case "database":
+		graph, _, err := s.fetch_database_graph(&rds.DescribeDBInstancesInput{DBInstanceIdentifier: awssdk.String(id)})
+		return graph, err
+	case "dbsubnetgroup":
+		graph, _, err := s.fetch_dbsubnetgroup_graph(&rds.DescribeDBSubnetGroupsInput{DBSubnetGroupName: awssdk.String(id)})
+		return graph, err
+	case "launchconfiguration":
+		graph, _, err := s.fetch_launchconfiguration_graph(&autoscaling.DescribeLaunchConfigurationsInput{LaunchConfigurationNames: []*string{awssdk.String(id)}})
+		return graph, err
+	case "scalinggroup":
+		graph, _, err := s.fetch_scalinggroup_graph(&autoscaling.DescribeAutoScalingGroupsInput{AutoScalingGroupNames: []*string{awssdk.String(id)}})
+		return graph, err
+	case "scalingpolicy":
+		graph, _, err := s.fetch_scalingpolicy_graph(&autoscaling.DescribePoliciesInput{PolicyNames: []*string{awssdk.String(id)}})
+		return graph, err
+	default:
+		return s.FetchByType(t)
+	}
+}
+
 func (s *Infra) fetch_all_instance_graph() (*graph.Graph, []*ec2.Instance, error) {
+	return s.fetch_instance_graph(&ec2.DescribeInstancesInput{})
+}
+
+func (s *Infra) fetch_instance_graph(input *ec2.DescribeInstancesInput) (*graph.Graph, []*ec2.Instance, error) {
 	g := graph.NewGraph()
 	var cloudResources []*ec2.Instance
 	var badResErr error
-	err := s.DescribeInstancesPages(&ec2.DescribeInstancesInput{},
+	err := s.DescribeInstancesPages(input,
 		func(out *ec2.DescribeInstancesOutput, lastPage bool) (shouldContinue bool) {
 			for _, all := range out.Reservations {
 				for _, output := range all.Instances {
@@ -763,9 +1156,13 @@ func (s *Infra) fetch_all_instance_graph() (*graph.Graph, []*ec2.Instance, error
 }
 
 func (s *Infra) fetch_all_subnet_graph() (*graph.Graph, []*ec2.Subnet, error) {
+	return s.fetch_subnet_graph(&ec2.DescribeSubnetsInput{})
+}
+
+func (s *Infra) fetch_subnet_graph(input *ec2.DescribeSubnetsInput) (*graph.Graph, []*ec2.Subnet, error) {
 	g := graph.NewGraph()
 	var cloudResources []*ec2.Subnet
-	out, err := s.DescribeSubnets(&ec2.DescribeSubnetsInput{})
+	out, err := s.DescribeSubnets(input)
 	if err != nil {
 		return nil, cloudResources, err
 	}
@@ -786,9 +1183,13 @@ func (s *Infra) fetch_all_subnet_graph() (*graph.Graph, []*ec2.Subnet, error) {
 }
 
 func (s *Infra) fetch_all_vpc_graph() (*graph.Graph, []*ec2.Vpc, error) {
+	return s.fetch_vpc_graph(&ec2.DescribeVpcsInput{})
+}
+
+func (s *Infra) fetch_vpc_graph(input *ec2.DescribeVpcsInput) (*graph.Graph, []*ec2.Vpc, error) {
 	g := graph.NewGraph()
 	var cloudResources []*ec2.Vpc
-	out, err := s.DescribeVpcs(&ec2.DescribeVpcsInput{})
+	out, err := s.DescribeVpcs(input)
 	if err != nil {
 		return nil, cloudResources, err
 	}
@@ -809,9 +1210,13 @@ func (s *Infra) fetch_all_vpc_graph() (*graph.Graph, []*ec2.Vpc, error) {
 }
 
 func (s *Infra) fetch_all_keypair_graph() (*graph.Graph, []*ec2.KeyPairInfo, error) {
+	return s.fetch_keypair_graph(&ec2.DescribeKeyPairsInput{})
+}
+
+func (s *Infra) fetch_keypair_graph(input *ec2.DescribeKeyPairsInput) (*graph.Graph, []*ec2.KeyPairInfo, error) {
 	g := graph.NewGraph()
 	var cloudResources []*ec2.KeyPairInfo
-	out, err := s.DescribeKeyPairs(&ec2.DescribeKeyPairsInput{})
+	out, err := s.DescribeKeyPairs(input)
 	if err != nil {
 		return nil, cloudResources, err
 	}
@@ -832,9 +1237,13 @@ func (s *Infra) fetch_all_keypair_graph() (*graph.Graph, []*ec2.KeyPairInfo, err
 }
 
 func (s *Infra) fetch_all_securitygroup_graph() (*graph.Graph, []*ec2.SecurityGroup, error) {
+	return s.fetch_securitygroup_graph(&ec2.DescribeSecurityGroupsInput{})
+}
+
+func (s *Infra) fetch_securitygroup_graph(input *ec2.DescribeSecurityGroupsInput) (*graph.Graph, []*ec2.SecurityGroup, error) {
 	g := graph.NewGraph()
 	var cloudResources []*ec2.SecurityGroup
-	out, err := s.DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{})
+	out, err := s.DescribeSecurityGroups(input)
 	if err != nil {
 		return nil, cloudResources, err
 	}
@@ -855,10 +1264,14 @@ func (s *Infra) fetch_all_securitygroup_graph() (*graph.Graph, []*ec2.SecurityGr
 }
 
 func (s *Infra) fetch_all_volume_graph() (*graph.Graph, []*ec2.Volume, error) {
+	return s.fetch_volume_graph(&ec2.DescribeVolumesInput{})
+}
+
+func (s *Infra) fetch_volume_graph(input *ec2.DescribeVolumesInput) (*graph.Graph, []*ec2.Volume, error) {
 	g := graph.NewGraph()
 	var cloudResources []*ec2.Volume
 	var badResErr error
-	err := s.DescribeVolumesPages(&ec2.DescribeVolumesInput{},
+	err := s.DescribeVolumesPages(input,
 		func(out *ec2.DescribeVolumesOutput, lastPage bool) (shouldContinue bool) {
 			for _, output := range out.Volumes {
 				cloudResources = append(cloudResources, output)
@@ -880,10 +1293,71 @@ func (s *Infra) fetch_all_volume_graph() (*graph.Graph, []*ec2.Volume, error) {
 	return g, cloudResources, badResErr
 }
 
+func (s *Infra) fetch_all_snapshot_graph() (*graph.Graph, []*ec2.Snapshot, error) {
+	return s.fetch_snapshot_graph(&ec2.DescribeSnapshotsInput{OwnerIds: []*string{awssdk.String("self")}})
+}
+
+func (s *Infra) fetch_snapshot_graph(input *ec2.DescribeSnapshotsInput) (*graph.Graph, []*ec2.Snapshot, error) {
+	g := graph.NewGraph()
+	var cloudResources []*ec2.Snapshot
+	var badResErr error
+	err := s.DescribeSnapshotsPages(input,
+		func(out *ec2.DescribeSnapshotsOutput, lastPage bool) (shouldContinue bool) {
+			for _, output := range out.Snapshots {
+				cloudResources = append(cloudResources, output)
+				var res *graph.Resource
+				res, badResErr = newResource(output)
+				if badResErr != nil {
+					return false
+				}
+				if badResErr = g.AddResource(res); badResErr != nil {
+					return false
+				}
+			}
+			return out.NextToken != nil
+		})
+	if err != nil {
+		return g, cloudResources, err
+	}
+
+	return g, cloudResources, badResErr
+}
+
+func (s *Infra) fetch_all_image_graph() (*graph.Graph, []*ec2.Image, error) {
+	return s.fetch_image_graph(&ec2.DescribeImagesInput{Owners: []*string{awssdk.String("self")}})
+}
+
+func (s *Infra) fetch_image_graph(input *ec2.DescribeImagesInput) (*graph.Graph, []*ec2.Image, error) {
+	g := graph.NewGraph()
+	var cloudResources []*ec2.Image
+	out, err := s.DescribeImages(input)
+	if err != nil {
+		return nil, cloudResources, err
+	}
+
+	for _, output := range out.Images {
+		cloudResources = append(cloudResources, output)
+		res, err := newResource(output)
+		if err != nil {
+			return g, cloudResources, err
+		}
+		if err = g.AddResource(res); err != nil {
+			return g, cloudResources, err
+		}
+	}
+
+	return g, cloudResources, nil
+
+}
+
 func (s *Infra) fetch_all_internetgateway_graph() (*graph.Graph, []*ec2.InternetGateway, error) {
+	return s.fetch_internetgateway_graph(&ec2.DescribeInternetGatewaysInput{})
+}
+
+func (s *Infra) fetch_internetgateway_graph(input *ec2.DescribeInternetGatewaysInput) (*graph.Graph, []*ec2.InternetGateway, error) {
 	g := graph.NewGraph()
 	var cloudResources []*ec2.InternetGateway
-	out, err := s.DescribeInternetGateways(&ec2.DescribeInternetGatewaysInput{})
+	out, err := s.DescribeInternetGateways(input)
 	if err != nil {
 		return nil, cloudResources, err
 	}
@@ -904,9 +1378,13 @@ func (s *Infra) fetch_all_internetgateway_graph() (*graph.Graph, []*ec2.Internet
 }
 
 func (s *Infra) fetch_all_routetable_graph() (*graph.Graph, []*ec2.RouteTable, error) {
+	return s.fetch_routetable_graph(&ec2.DescribeRouteTablesInput{})
+}
+
+func (s *Infra) fetch_routetable_graph(input *ec2.DescribeRouteTablesInput) (*graph.Graph, []*ec2.RouteTable, error) {
 	g := graph.NewGraph()
 	var cloudResources []*ec2.RouteTable
-	out, err := s.DescribeRouteTables(&ec2.DescribeRouteTablesInput{})
+	out, err := s.DescribeRouteTables(input)
 	if err != nil {
 		return nil, cloudResources, err
 	}
@@ -927,9 +1405,13 @@ func (s *Infra) fetch_all_routetable_graph() (*graph.Graph, []*ec2.RouteTable, e
 }
 
 func (s *Infra) fetch_all_availabilityzone_graph() (*graph.Graph, []*ec2.AvailabilityZone, error) {
+	return s.fetch_availabilityzone_graph(&ec2.DescribeAvailabilityZonesInput{})
+}
+
+func (s *Infra) fetch_availabilityzone_graph(input *ec2.DescribeAvailabilityZonesInput) (*graph.Graph, []*ec2.AvailabilityZone, error) {
 	g := graph.NewGraph()
 	var cloudResources []*ec2.AvailabilityZone
-	out, err := s.DescribeAvailabilityZones(&ec2.DescribeAvailabilityZonesInput{})
+	out, err := s.DescribeAvailabilityZones(input)
 	if err != nil {
 		return nil, cloudResources, err
 	}
@@ -949,11 +1431,99 @@ func (s *Infra) fetch_all_availabilityzone_graph() (*graph.Graph, []*ec2.Availab
 
 }
 
+func (s *Infra) fetch_all_elasticip_graph() (*graph.Graph, []*ec2.Address, error) {
+	return s.fetch_elasticip_graph(&ec2.DescribeAddressesInput{})
+}
+
+func (s *Infra) fetch_elasticip_graph(input *ec2.DescribeAddressesInput) (*graph.Graph, []*ec2.Address, error) {
+	g := graph.NewGraph()
+	var cloudResources []*ec2.Address
+	out, err := s.DescribeAddresses(input)
+	if err != nil {
+		return nil, cloudResources, err
+	}
+
+	for _, output := range out.Addresses {
+		cloudResources = append(cloudResources, output)
+		res, err := newResource(output)
+		if err != nil {
+			return g, cloudResources, err
+		}
+		if err = g.AddResource(res); err != nil {
+			return g, cloudResources, err
+		}
+	}
+
+	return g, cloudResources, nil
+
+}
+
+func (s *Infra) fetch_all_natgateway_graph() (*graph.Graph, []*ec2.NatGateway, error) {
+	return s.fetch_natgateway_graph(&ec2.DescribeNatGatewaysInput{})
+}
+
+func (s *Infra) fetch_natgateway_graph(input *ec2.DescribeNatGatewaysInput) (*graph.Graph, []*ec2.NatGateway, error) {
+	g := graph.NewGraph()
+	var cloudResources []*ec2.NatGateway
+	var badResErr error
+	err := s.DescribeNatGatewaysPages(input,
+		func(out *ec2.DescribeNatGatewaysOutput, lastPage bool) (shouldContinue bool) {
+			for _, output := range out.NatGateways {
+				cloudResources = append(cloudResources, output)
+				var res *graph.Resource
+				res, badResErr = newResource(output)
+				if badResErr != nil {
+					return false
+				}
+				if badResErr = g.AddResource(res); badResErr != nil {
+					return false
+				}
+			}
+			return out.NextToken != nil
+		})
+	if err != nil {
+		return g, cloudResources, err
+	}
+
+	return g, cloudResources, badResErr
+}
+
+func (s *Infra) fetch_all_networkinterface_graph() (*graph.Graph, []*ec2.NetworkInterface, error) {
+	return s.fetch_networkinterface_graph(&ec2.DescribeNetworkInterfacesInput{})
+}
+
+func (s *Infra) fetch_networkinterface_graph(input *ec2.DescribeNetworkInterfacesInput) (*graph.Graph, []*ec2.NetworkInterface, error) {
+	g := graph.NewGraph()
+	var cloudResources []*ec2.NetworkInterface
+	out, err := s.DescribeNetworkInterfaces(input)
+	if err != nil {
+		return nil, cloudResources, err
+	}
+
+	for _, output := range out.NetworkInterfaces {
+		cloudResources = append(cloudResources, output)
+		res, err := newResource(output)
+		if err != nil {
+			return g, cloudResources, err
+		}
+		if err = g.AddResource(res); err != nil {
+			return g, cloudResources, err
+		}
+	}
+
+	return g, cloudResources, nil
+
+}
+
 func (s *Infra) fetch_all_loadbalancer_graph() (*graph.Graph, []*elbv2.LoadBalancer, error) {
+	return s.fetch_loadbalancer_graph(&elbv2.DescribeLoadBalancersInput{})
+}
+
+func (s *Infra) fetch_loadbalancer_graph(input *elbv2.DescribeLoadBalancersInput) (*graph.Graph, []*elbv2.LoadBalancer, error) {
 	g := graph.NewGraph()
 	var cloudResources []*elbv2.LoadBalancer
 	var badResErr error
-	err := s.DescribeLoadBalancersPages(&elbv2.DescribeLoadBalancersInput{},
+	err := s.DescribeLoadBalancersPages(input,
 		func(out *elbv2.DescribeLoadBalancersOutput, lastPage bool) (shouldContinue bool) {
 			for _, output := range out.LoadBalancers {
 				cloudResources = append(cloudResources, output)
@@ -976,9 +1546,13 @@ func (s *Infra) fetch_all_loadbalancer_graph() (*graph.Graph, []*elbv2.LoadBalan
 }
 
 func (s *Infra) fetch_all_targetgroup_graph() (*graph.Graph, []*elbv2.TargetGroup, error) {
+	return s.fetch_targetgroup_graph(&elbv2.DescribeTargetGroupsInput{})
+}
+
+func (s *Infra) fetch_targetgroup_graph(input *elbv2.DescribeTargetGroupsInput) (*graph.Graph, []*elbv2.TargetGroup, error) {
 	g := graph.NewGraph()
 	var cloudResources []*elbv2.TargetGroup
-	out, err := s.DescribeTargetGroups(&elbv2.DescribeTargetGroupsInput{})
+	out, err := s.DescribeTargetGroups(input)
 	if err != nil {
 		return nil, cloudResources, err
 	}
@@ -999,10 +1573,14 @@ func (s *Infra) fetch_all_targetgroup_graph() (*graph.Graph, []*elbv2.TargetGrou
 }
 
 func (s *Infra) fetch_all_database_graph() (*graph.Graph, []*rds.DBInstance, error) {
+	return s.fetch_database_graph(&rds.DescribeDBInstancesInput{})
+}
+
+func (s *Infra) fetch_database_graph(input *rds.DescribeDBInstancesInput) (*graph.Graph, []*rds.DBInstance, error) {
 	g := graph.NewGraph()
 	var cloudResources []*rds.DBInstance
 	var badResErr error
-	err := s.DescribeDBInstancesPages(&rds.DescribeDBInstancesInput{},
+	err := s.DescribeDBInstancesPages(input,
 		func(out *rds.DescribeDBInstancesOutput, lastPage bool) (shouldContinue bool) {
 			for _, output := range out.DBInstances {
 				cloudResources = append(cloudResources, output)
@@ -1025,10 +1603,14 @@ func (s *Infra) fetch_all_database_graph() (*graph.Graph, []*rds.DBInstance, err
 }
 
 func (s *Infra) fetch_all_dbsubnetgroup_graph() (*graph.Graph, []*rds.DBSubnetGroup, error) {
+	return s.fetch_dbsubnetgroup_graph(&rds.DescribeDBSubnetGroupsInput{})
+}
+
+func (s *Infra) fetch_dbsubnetgroup_graph(input *rds.DescribeDBSubnetGroupsInput) (*graph.Graph, []*rds.DBSubnetGroup, error) {
 	g := graph.NewGraph()
 	var cloudResources []*rds.DBSubnetGroup
 	var badResErr error
-	err := s.DescribeDBSubnetGroupsPages(&rds.DescribeDBSubnetGroupsInput{},
+	err := s.DescribeDBSubnetGroupsPages(input,
 		func(out *rds.DescribeDBSubnetGroupsOutput, lastPage bool) (shouldContinue bool) {
 			for _, output := range out.DBSubnetGroups {
 				cloudResources = append(cloudResources, output)
@@ -1050,6 +1632,96 @@ func (s *Infra) fetch_all_dbsubnetgroup_graph() (*graph.Graph, []*rds.DBSubnetGr
 	return g, cloudResources, badResErr
 }
 
+func (s *Infra) fetch_all_launchconfiguration_graph() (*graph.Graph, []*autoscaling.LaunchConfiguration, error) {
+	return s.fetch_launchconfiguration_graph(&autoscaling.DescribeLaunchConfigurationsInput{})
+}
+
+func (s *Infra) fetch_launchconfiguration_graph(input *autoscaling.DescribeLaunchConfigurationsInput) (*graph.Graph, []*autoscaling.LaunchConfiguration, error) {
+	g := graph.NewGraph()
+	var cloudResources []*autoscaling.LaunchConfiguration
+	var badResErr error
+	err := s.DescribeLaunchConfigurationsPages(input,
+		func(out *autoscaling.DescribeLaunchConfigurationsOutput, lastPage bool) (shouldContinue bool) {
+			for _, output := range out.LaunchConfigurations {
+				cloudResources = append(cloudResources, output)
+				var res *graph.Resource
+				res, badResErr = newResource(output)
+				if badResErr != nil {
+					return false
+				}
+				if badResErr = g.AddResource(res); badResErr != nil {
+					return false
+				}
+			}
+			return out.NextToken != nil
+		})
+	if err != nil {
+		return g, cloudResources, err
+	}
+
+	return g, cloudResources, badResErr
+}
+
+func (s *Infra) fetch_all_scalinggroup_graph() (*graph.Graph, []*autoscaling.Group, error) {
+	return s.fetch_scalinggroup_graph(&autoscaling.DescribeAutoScalingGroupsInput{})
+}
+
+func (s *Infra) fetch_scalinggroup_graph(input *autoscaling.DescribeAutoScalingGroupsInput) (*graph.Graph, []*autoscaling.Group, error) {
+	g := graph.NewGraph()
+	var cloudResources []*autoscaling.Group
+	var badResErr error
+	err := s.DescribeAutoScalingGroupsPages(input,
+		func(out *autoscaling.DescribeAutoScalingGroupsOutput, lastPage bool) (shouldContinue bool) {
+			for _, output := range out.AutoScalingGroups {
+				cloudResources = append(cloudResources, output)
+				var res *graph.Resource
+				res, badResErr = newResource(output)
+				if badResErr != nil {
+					return false
+				}
+				if badResErr = g.AddResource(res); badResErr != nil {
+					return false
+				}
+			}
+			return out.NextToken != nil
+		})
+	if err != nil {
+		return g, cloudResources, err
+	}
+
+	return g, cloudResources, badResErr
+}
+
+func (s *Infra) fetch_all_scalingpolicy_graph() (*graph.Graph, []*autoscaling.ScalingPolicy, error) {
+	return s.fetch_scalingpolicy_graph(&autoscaling.DescribePoliciesInput{})
+}
+
+func (s *Infra) fetch_scalingpolicy_graph(input *autoscaling.DescribePoliciesInput) (*graph.Graph, []*autoscaling.ScalingPolicy, error) {
+	g := graph.NewGraph()
+	var cloudResources []*autoscaling.ScalingPolicy
+	var badResErr error
+	err := s.DescribePoliciesPages(input,
+		func(out *autoscaling.DescribePoliciesOutput, lastPage bool) (shouldContinue bool) {
+			for _, output := range out.ScalingPolicies {
+				cloudResources = append(cloudResources, output)
+				var res *graph.Resource
+				res, badResErr = newResource(output)
+				if badResErr != nil {
+					return false
+				}
+				if badResErr = g.AddResource(res); badResErr != nil {
+					return false
+				}
+			}
+			return out.NextToken != nil
+		})
+	if err != nil {
+		return g, cloudResources, err
+	}
+
+	return g, cloudResources, badResErr
+}
+
 func (s *Infra) IsSyncDisabled() bool {
 	return !s.config.getBool("aws.infra.sync", true)
 }
@@ -1082,6 +1754,7 @@ func (s *Access) Drivers() []driver.Driver {
 	return []driver.Driver{
 		awsdriver.NewIamDriver(s.IAMAPI),
 		awsdriver.NewStsDriver(s.STSAPI),
+		awsdriver.NewCheckDriver(s.FetchByID, s.ResourceTypes()...),
 	}
 }
 
@@ -1292,11 +1965,24 @@ func (s *Access) FetchByType(t string) (*graph.Graph, error) {
 	}
 }
 
+// FetchByID fetches the resource of the given type and id, or all the
+// resources of the type when they cannot be described by id
+func (s *Access) FetchByID(t, id string) (*graph.Graph, error) {
+	switch t {
+	default:
+		return s.FetchByType(t)
+	}
+}
+
 func (s *Access) fetch_all_group_graph() (*graph.Graph, []*iam.GroupDetail, error) {
+	return s.fetch_group_graph(&iam.GetAccountAuthorizationDetailsInput{Filter: []*string{awssdk.String(iam.EntityTypeGroup)}})
+}
+
+func (s *Access) fetch_group_graph(input *iam.GetAccountAuthorizationDetailsInput) (*graph.Graph, []*iam.GroupDetail, error) {
 	g := graph.NewGraph()
 	var cloudResources []*iam.GroupDetail
 	var badResErr error
-	err := s.GetAccountAuthorizationDetailsPages(&iam.GetAccountAuthorizationDetailsInput{Filter: []*string{awssdk.String(iam.EntityTypeGroup)}},
+	err := s.GetAccountAuthorizationDetailsPages(input,
 		func(out *iam.GetAccountAuthorizationDetailsOutput, lastPage bool) (shouldContinue bool) {
 			for _, output := range out.GroupDetailList {
 				cloudResources = append(cloudResources, output)
@@ -1319,10 +2005,14 @@ func (s *Access) fetch_all_group_graph() (*graph.Graph, []*iam.GroupDetail, erro
 }
 
 func (s *Access) fetch_all_role_graph() (*graph.Graph, []*iam.RoleDetail, error) {
+	return s.fetch_role_graph(&iam.GetAccountAuthorizationDetailsInput{Filter: []*string{awssdk.String(iam.EntityTypeRole)}})
+}
+
+func (s *Access) fetch_role_graph(input *iam.GetAccountAuthorizationDetailsInput) (*graph.Graph, []*iam.RoleDetail, error) {
 	g := graph.NewGraph()
 	var cloudResources []*iam.RoleDetail
 	var badResErr error
-	err := s.GetAccountAuthorizationDetailsPages(&iam.GetAccountAuthorizationDetailsInput{Filter: []*string{awssdk.String(iam.EntityTypeRole)}},
+	err := s.GetAccountAuthorizationDetailsPages(input,
 		func(out *iam.GetAccountAuthorizationDetailsOutput, lastPage bool) (shouldContinue bool) {
 			for _, output := range out.RoleDetailList {
 				cloudResources = append(cloudResources, output)
@@ -1345,10 +2035,14 @@ func (s *Access) fetch_all_role_graph() (*graph.Graph, []*iam.RoleDetail, error)
 }
 
 func (s *Access) fetch_all_policy_graph() (*graph.Graph, []*iam.Policy, error) {
+	return s.fetch_policy_graph(&iam.ListPoliciesInput{OnlyAttached: awssdk.Bool(true)})
+}
+
+func (s *Access) fetch_policy_graph(input *iam.ListPoliciesInput) (*graph.Graph, []*iam.Policy, error) {
 	g := graph.NewGraph()
 	var cloudResources []*iam.Policy
 	var badResErr error
-	err := s.ListPoliciesPages(&iam.ListPoliciesInput{OnlyAttached: awssdk.Bool(true)},
+	err := s.ListPoliciesPages(input,
 		func(out *iam.ListPoliciesOutput, lastPage bool) (shouldContinue bool) {
 			for _, output := range out.Policies {
 				cloudResources = append(cloudResources, output)
@@ -1399,6 +2093,7 @@ func (s *Storage) Name() string {
 func (s *Storage) Drivers() []driver.Driver {
 	return []driver.Driver{
 		awsdriver.NewS3Driver(s.S3API),
+		awsdriver.NewCheckDriver(s.FetchByID, s.ResourceTypes()...),
 	}
 }
 
@@ -1537,6 +2232,15 @@ func (s *Storage) FetchByType(t string) (*graph.Graph, error) {
 	}
 }
 
+// FetchByID fetches the resource of the given type and id, or all the
+// resources of the type when they cannot be described by id
+func (s *Storage) FetchByID(t, id string) (*graph.Graph, error) {
+	switch t {
+	default:
+		return s.FetchByType(t)
+	}
+}
+
 func (s *Storage) IsSyncDisabled() bool {
 	return !s.config.getBool("aws.storage.sync", true)
 }
@@ -1566,6 +2270,7 @@ func (s *Notification) Name() string {
 func (s *Notification) Drivers() []driver.Driver {
 	return []driver.Driver{
 		awsdriver.NewSnsDriver(s.SNSAPI),
+		awsdriver.NewCheckDriver(s.FetchByID, s.ResourceTypes()...),
 	}
 }
 
@@ -1704,11 +2409,24 @@ func (s *Notification) FetchByType(t string) (*graph.Graph, error) {
 	}
 }
 
+// FetchByID fetches the resource of the given type and id, or all the
+// resources of the type when they cannot be described by id
+func (s *Notification) FetchByID(t, id string) (*graph.Graph, error) {
+	switch t {
+	default:
+		return s.FetchByType(t)
+	}
+}
+
 func (s *Notification) fetch_all_subscription_graph() (*graph.Graph, []*sns.Subscription, error) {
+	return s.fetch_subscription_graph(&sns.ListSubscriptionsInput{})
+}
+
+func (s *Notification) fetch_subscription_graph(input *sns.ListSubscriptionsInput) (*graph.Graph, []*sns.Subscription, error) {
 	g := graph.NewGraph()
 	var cloudResources []*sns.Subscription
 	var badResErr error
-	err := s.ListSubscriptionsPages(&sns.ListSubscriptionsInput{},
+	err := s.ListSubscriptionsPages(input,
 		func(out *sns.ListSubscriptionsOutput, lastPage bool) (shouldContinue bool) {
 			for _, output := range out.Subscriptions {
 				cloudResources = append(cloudResources, output)
@@ -1731,10 +2449,14 @@ func (s *Notification) fetch_all_subscription_graph() (*graph.Graph, []*sns.Subs
 }
 
 func (s *Notification) fetch_all_topic_graph() (*graph.Graph, []*sns.Topic, error) {
+	return s.fetch_topic_graph(&sns.ListTopicsInput{})
+}
+
+func (s *Notification) fetch_topic_graph(input *sns.ListTopicsInput) (*graph.Graph, []*sns.Topic, error) {
 	g := graph.NewGraph()
 	var cloudResources []*sns.Topic
 	var badResErr error
-	err := s.ListTopicsPages(&sns.ListTopicsInput{},
+	err := s.ListTopicsPages(input,
 		func(out *sns.ListTopicsOutput, lastPage bool) (shouldContinue bool) {
 			for _, output := range out.Topics {
 				cloudResources = append(cloudResources, output)
@@ -1785,6 +2507,7 @@ func (s *Queue) Name() string {
 func (s *Queue) Drivers() []driver.Driver {
 	return []driver.Driver{
 		awsdriver.NewSqsDriver(s.SQSAPI),
+		awsdriver.NewCheckDriver(s.FetchByID, s.ResourceTypes()...),
 	}
 }
 
@@ -1887,6 +2610,15 @@ func (s *Queue) FetchByType(t string) (*graph.Graph, error) {
 	}
 }
 
+// FetchByID fetches the resource of the given type and id, or all the
+// resources of the type when they cannot be described by id
+func (s *Queue) FetchByID(t, id string) (*graph.Graph, error) {
+	switch t {
+	default:
+		return s.FetchByType(t)
+	}
+}
+
 func (s *Queue) IsSyncDisabled() bool {
 	return !s.config.getBool("aws.queue.sync", true)
 }
@@ -1916,6 +2648,7 @@ func (s *Dns) Name() string {
 func (s *Dns) Drivers() []driver.Driver {
 	return []driver.Driver{
 		awsdriver.NewRoute53Driver(s.Route53API),
+		awsdriver.NewCheckDriver(s.FetchByID, s.ResourceTypes()...),
 	}
 }
 
@@ -2054,11 +2787,24 @@ func (s *Dns) FetchByType(t string) (*graph.Graph, error) {
 	}
 }
 
+// FetchByID fetches the resource of the given type and id, or all the
+// resources of the type when they cannot be described by id
+func (s *Dns) FetchByID(t, id string) (*graph.Graph, error) {
+	switch t {
+	default:
+		return s.FetchByType(t)
+	}
+}
+
 func (s *Dns) fetch_all_zone_graph() (*graph.Graph, []*route53.HostedZone, error) {
+	return s.fetch_zone_graph(&route53.ListHostedZonesInput{})
+}
+
+func (s *Dns) fetch_zone_graph(input *route53.ListHostedZonesInput) (*graph.Graph, []*route53.HostedZone, error) {
 	g := graph.NewGraph()
 	var cloudResources []*route53.HostedZone
 	var badResErr error
-	err := s.ListHostedZonesPages(&route53.ListHostedZonesInput{},
+	err := s.ListHostedZonesPages(input,
 		func(out *route53.ListHostedZonesOutput, lastPage bool) (shouldContinue bool) {
 			for _, output := range out.HostedZones {
 				cloudResources = append(cloudResources, output)
@@ -2083,3 +2829,345 @@ func (s *Dns) fetch_all_zone_graph() (*graph.Graph, []*route53.HostedZone, error
 func (s *Dns) IsSyncDisabled() bool {
 	return !s.config.getBool("aws.dns.sync", true)
 }
+
+type Lambda struct {
+	once   oncer
+	region string
+	config config
+	log    *logger.Logger
+	lambdaiface.LambdaAPI
+}
+
+func NewLambda(sess *session.Session, awsconf config, log *logger.Logger) cloud.Service {
+	region := awssdk.StringValue(sess.Config.Region)
+	return &Lambda{
+		LambdaAPI: lambda.New(sess),
+		config:    awsconf,
+		region:    region,
+		log:       log,
+	}
+}
+
+func (s *Lambda) Name() string {
+	return "lambda"
+}
+
+func (s *Lambda) Drivers() []driver.Driver {
+	return []driver.Driver{
+		awsdriver.NewLambdaDriver(s.LambdaAPI),
+		awsdriver.NewCheckDriver(s.FetchByID, s.ResourceTypes()...),
+	}
+}
+
+func (s *Lambda) ResourceTypes() (all []string) {
+	all = append(all, "function")
+	return
+}
+
+func (s *Lambda) FetchResources() (*graph.Graph, error) {
+	g := graph.NewGraph()
+	if s.IsSyncDisabled() {
+		return g, nil
+	}
+
+	regionN := graph.InitResource(cloud.Region, s.region)
+	if err := g.AddResource(regionN); err != nil {
+		return g, err
+	}
+	var functionList []*lambda.FunctionConfiguration
+
+	errc := make(chan error)
+	var wg sync.WaitGroup
+
+	if s.config.getBool("aws.lambda.function.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var resGraph *graph.Graph
+			var err error
+			resGraph, functionList, err = s.fetch_all_function_graph()
+			if err != nil {
+				errc <- err
+				return
+			}
+			g.AddGraph(resGraph)
+		}()
+	} else {
+		s.log.Verbose("sync: *disabled* for resource lambda[function]")
+	}
+
+	go func() {
+		wg.Wait()
+		close(errc)
+	}()
+
+	for err := range errc {
+		switch ee := err.(type) {
+		case awserr.RequestFailure:
+			switch ee.Message() {
+			case accessDenied:
+				return g, cloud.ErrFetchAccessDenied
+			default:
+				return g, ee
+			}
+		case nil:
+			continue
+		default:
+			return g, ee
+		}
+	}
+
+	errc = make(chan error)
+	if s.config.getBool("aws.lambda.function.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, r := range functionList {
+				for _, fn := range addParentsFns["function"] {
+					err := fn(g, r)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(errc)
+	}()
+
+	for err := range errc {
+		if err != nil {
+			return g, err
+		}
+	}
+
+	return g, nil
+}
+
+func (s *Lambda) FetchByType(t string) (*graph.Graph, error) {
+	switch t {
+	case "function":
+		graph, _, err := s.fetch_all_function_graph()
+		return graph, err
+	default:
+		return nil, fmt.Errorf("aws lambda: unsupported fetch for type %s", t)
+	}
+}
+
+// FetchByID fetches the resource of the given type and id, or all the
+// resources of the type when they cannot be described by id
+func (s *Lambda) FetchByID(t, id string) (*graph.Graph, error) {
+	switch t {
+	default:
+		return s.FetchByType(t)
+	}
+}
+
+func (s *Lambda) fetch_all_function_graph() (*graph.Graph, []*lambda.FunctionConfiguration, error) {
+	return s.fetch_function_graph(&lambda.ListFunctionsInput{})
+}
+
+func (s *Lambda) fetch_function_graph(input *lambda.ListFunctionsInput) (*graph.Graph, []*lambda.FunctionConfiguration, error) {
+	g := graph.NewGraph()
+	var cloudResources []*lambda.FunctionConfiguration
+	var badResErr error
+	err := s.ListFunctionsPages(input,
+		func(out *lambda.ListFunctionsOutput, lastPage bool) (shouldContinue bool) {
+			for _, output := range out.Functions {
+				cloudResources = append(cloudResources, output)
+				var res *graph.Resource
+				res, badResErr = newResource(output)
+				if badResErr != nil {
+					return false
+				}
+				if badResErr = g.AddResource(res); badResErr != nil {
+					return false
+				}
+			}
+			return out.NextMarker != nil
+		})
+	if err != nil {
+		return g, cloudResources, err
+	}
+
+	return g, cloudResources, badResErr
+}
+
+func (s *Lambda) IsSyncDisabled() bool {
+	return !s.config.getBool("aws.lambda.sync", true)
+}
+
+type Monitoring struct {
+	once   oncer
+	region string
+	config config
+	log    *logger.Logger
+	cloudwatchiface.CloudWatchAPI
+}
+
+func NewMonitoring(sess *session.Session, awsconf config, log *logger.Logger) cloud.Service {
+	region := awssdk.StringValue(sess.Config.Region)
+	return &Monitoring{
+		CloudWatchAPI: cloudwatch.New(sess),
+		config:        awsconf,
+		region:        region,
+		log:           log,
+	}
+}
+
+func (s *Monitoring) Name() string {
+	return "monitoring"
+}
+
+func (s *Monitoring) Drivers() []driver.Driver {
+	return []driver.Driver{
+		awsdriver.NewCloudwatchDriver(s.CloudWatchAPI),
+		awsdriver.NewCheckDriver(s.FetchByID, s.ResourceTypes()...),
+	}
+}
+
+func (s *Monitoring) ResourceTypes() (all []string) {
+	all = append(all, "alarm")
+	return
+}
+
+func (s *Monitoring) FetchResources() (*graph.Graph, error) {
+	g := graph.NewGraph()
+	if s.IsSyncDisabled() {
+		return g, nil
+	}
+
+	regionN := graph.InitResource(cloud.Region, s.region)
+	if err := g.AddResource(regionN); err != nil {
+		return g, err
+	}
+	var alarmList []*cloudwatch.MetricAlarm
+
+	errc := make(chan error)
+	var wg sync.WaitGroup
+
+	if s.config.getBool("aws.monitoring.alarm.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var resGraph *graph.Graph
+			var err error
+			resGraph, alarmList, err = s.fetch_all_alarm_graph()
+			if err != nil {
+				errc <- err
+				return
+			}
+			g.AddGraph(resGraph)
+		}()
+	} else {
+		s.log.Verbose("sync: *disabled* for resource monitoring[alarm]")
+	}
+
+	go func() {
+		wg.Wait()
+		close(errc)
+	}()
+
+	for err := range errc {
+		switch ee := err.(type) {
+		case awserr.RequestFailure:
+			switch ee.Message() {
+			case accessDenied:
+				return g, cloud.ErrFetchAccessDenied
+			default:
+				return g, ee
+			}
+		case nil:
+			continue
+		default:
+			return g, ee
+		}
+	}
+
+	errc = make(chan error)
+	if s.config.getBool("aws.monitoring.alarm.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, r := range alarmList {
+				for _, fn := range addParentsFns["alarm"] {
+					err := fn(g, r)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(errc)
+	}()
+
+	for err := range errc {
+		if err != nil {
+			return g, err
+		}
+	}
+
+	return g, nil
+}
+
+func (s *Monitoring) FetchByType(t string) (*graph.Graph, error) {
+	switch t {
+	case "alarm":
+		graph, _, err := s.fetch_all_alarm_graph()
+		return graph, err
+	default:
+		return nil, fmt.Errorf("aws monitoring: unsupported fetch for type %s", t)
+	}
+}
+
+// FetchByID fetches the resource of the given type and id, or all the
+// resources of the type when they cannot be described by id
+func (s *Monitoring) FetchByID(t, id string) (*graph.Graph, error) {
+	switch t {
+	default:
+		return s.FetchByType(t)
+	}
+}
+
+func (s *Monitoring) fetch_all_alarm_graph() (*graph.Graph, []*cloudwatch.MetricAlarm, error) {
+	return s.fetch_alarm_graph(&cloudwatch.DescribeAlarmsInput{})
+}
+
+func (s *Monitoring) fetch_alarm_graph(input *cloudwatch.DescribeAlarmsInput) (*graph.Graph, []*cloudwatch.MetricAlarm, error) {
+	g := graph.NewGraph()
+	var cloudResources []*cloudwatch.MetricAlarm
+	var badResErr error
+	err := s.DescribeAlarmsPages(input,
+		func(out *cloudwatch.DescribeAlarmsOutput, lastPage bool) (shouldContinue bool) {
+			for _, output := range out.MetricAlarms {
+				cloudResources = append(cloudResources, output)
+				var res *graph.Resource
+				res, badResErr = newResource(output)
+				if badResErr != nil {
+					return false
+				}
+				if badResErr = g.AddResource(res); badResErr != nil {
+					return false
+				}
+			}
+			return out.NextToken != nil
+		})
+	if err != nil {
+		return g, cloudResources, err
+	}
+
+	return g, cloudResources, badResErr
+}
+
+func (s *Monitoring) IsSyncDisabled() bool {
+	return !s.config.getBool("aws.monitoring.sync", true)
+}
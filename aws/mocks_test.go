@@ -5,12 +5,18 @@ import (
 	"strconv"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
 	"github.com/aws/aws-sdk-go/service/route53"
@@ -24,13 +30,19 @@ import (
 
 type mockEc2 struct {
 	ec2iface.EC2API
-	vpcs             []*ec2.Vpc
-	subnets          []*ec2.Subnet
-	instances        []*ec2.Instance
-	securityGroups   []*ec2.SecurityGroup
-	keyPairs         []*ec2.KeyPairInfo
-	internetGateways []*ec2.InternetGateway
-	routeTables      []*ec2.RouteTable
+	vpcs              []*ec2.Vpc
+	subnets           []*ec2.Subnet
+	instances         []*ec2.Instance
+	securityGroups    []*ec2.SecurityGroup
+	keyPairs          []*ec2.KeyPairInfo
+	internetGateways  []*ec2.InternetGateway
+	routeTables       []*ec2.RouteTable
+	elasticIPs        []*ec2.Address
+	natGateways       []*ec2.NatGateway
+	networkInterfaces []*ec2.NetworkInterface
+	volumes           []*ec2.Volume
+	snapshots         []*ec2.Snapshot
+	images            []*ec2.Image
 }
 
 func (m *mockEc2) DescribeVpcs(input *ec2.DescribeVpcsInput) (*ec2.DescribeVpcsOutput, error) {
@@ -66,14 +78,37 @@ func (m *mockEc2) DescribeRouteTables(input *ec2.DescribeRouteTablesInput) (*ec2
 	return &ec2.DescribeRouteTablesOutput{RouteTables: m.routeTables}, nil
 }
 
-// Not tested
-func (m *mockEc2) DescribeVolumes(input *ec2.DescribeVolumesInput) (*ec2.DescribeVolumesOutput, error) {
-	return &ec2.DescribeVolumesOutput{}, nil
+func (m *mockEc2) DescribeAddresses(input *ec2.DescribeAddressesInput) (*ec2.DescribeAddressesOutput, error) {
+	return &ec2.DescribeAddressesOutput{Addresses: m.elasticIPs}, nil
+}
+
+func (m *mockEc2) DescribeNatGatewaysPages(input *ec2.DescribeNatGatewaysInput, fn func(p *ec2.DescribeNatGatewaysOutput, lastPage bool) (shouldContinue bool)) error {
+	fn(&ec2.DescribeNatGatewaysOutput{NatGateways: m.natGateways}, true)
+	return nil
 }
+
+func (m *mockEc2) DescribeNetworkInterfaces(input *ec2.DescribeNetworkInterfacesInput) (*ec2.DescribeNetworkInterfacesOutput, error) {
+	return &ec2.DescribeNetworkInterfacesOutput{NetworkInterfaces: m.networkInterfaces}, nil
+}
+
 func (m *mockEc2) DescribeVolumesPages(input *ec2.DescribeVolumesInput, fn func(p *ec2.DescribeVolumesOutput, lastPage bool) (shouldContinue bool)) error {
-	fn(&ec2.DescribeVolumesOutput{}, true)
+	fn(&ec2.DescribeVolumesOutput{Volumes: m.volumes}, true)
+	return nil
+}
+
+func (m *mockEc2) DescribeSnapshotsPages(input *ec2.DescribeSnapshotsInput, fn func(p *ec2.DescribeSnapshotsOutput, lastPage bool) (shouldContinue bool)) error {
+	fn(&ec2.DescribeSnapshotsOutput{Snapshots: m.snapshots}, true)
 	return nil
 }
+
+func (m *mockEc2) DescribeImages(input *ec2.DescribeImagesInput) (*ec2.DescribeImagesOutput, error) {
+	return &ec2.DescribeImagesOutput{Images: m.images}, nil
+}
+
+// Not tested
+func (m *mockEc2) DescribeVolumes(input *ec2.DescribeVolumesInput) (*ec2.DescribeVolumesOutput, error) {
+	return &ec2.DescribeVolumesOutput{}, nil
+}
 func (m *mockEc2) DescribeAvailabilityZones(input *ec2.DescribeAvailabilityZonesInput) (*ec2.DescribeAvailabilityZonesOutput, error) {
 	return &ec2.DescribeAvailabilityZonesOutput{}, nil
 }
@@ -119,6 +154,28 @@ func (m *mockRDS) DescribeDBSubnetGroupsPages(input *rds.DescribeDBSubnetGroupsI
 	return nil
 }
 
+type mockAutoScaling struct {
+	autoscalingiface.AutoScalingAPI
+	launchConfigs []*autoscaling.LaunchConfiguration
+	groups        []*autoscaling.Group
+	policies      []*autoscaling.ScalingPolicy
+}
+
+func (m *mockAutoScaling) DescribeLaunchConfigurationsPages(input *autoscaling.DescribeLaunchConfigurationsInput, fn func(p *autoscaling.DescribeLaunchConfigurationsOutput, lastPage bool) (shouldContinue bool)) error {
+	fn(&autoscaling.DescribeLaunchConfigurationsOutput{LaunchConfigurations: m.launchConfigs}, true)
+	return nil
+}
+
+func (m *mockAutoScaling) DescribeAutoScalingGroupsPages(input *autoscaling.DescribeAutoScalingGroupsInput, fn func(p *autoscaling.DescribeAutoScalingGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	fn(&autoscaling.DescribeAutoScalingGroupsOutput{AutoScalingGroups: m.groups}, true)
+	return nil
+}
+
+func (m *mockAutoScaling) DescribePoliciesPages(input *autoscaling.DescribePoliciesInput, fn func(p *autoscaling.DescribePoliciesOutput, lastPage bool) (shouldContinue bool)) error {
+	fn(&autoscaling.DescribePoliciesOutput{ScalingPolicies: m.policies}, true)
+	return nil
+}
+
 type mockRoute53 struct {
 	route53iface.Route53API
 	zonePages   [][]*route53.HostedZone
@@ -144,6 +201,34 @@ func (m *mockRoute53) ListResourceRecordSetsPages(input *route53.ListResourceRec
 	return nil
 }
 
+type mockLambda struct {
+	lambdaiface.LambdaAPI
+	functionPages [][]*lambda.FunctionConfiguration
+}
+
+func (m *mockLambda) ListFunctionsPages(input *lambda.ListFunctionsInput, fn func(p *lambda.ListFunctionsOutput, lastPage bool) (shouldContinue bool)) error {
+	for i, page := range m.functionPages {
+		fn(&lambda.ListFunctionsOutput{Functions: page, NextMarker: awssdk.String(strconv.Itoa(i + 1))},
+			i < len(m.functionPages),
+		)
+	}
+	return nil
+}
+
+type mockCloudWatch struct {
+	cloudwatchiface.CloudWatchAPI
+	alarmPages [][]*cloudwatch.MetricAlarm
+}
+
+func (m *mockCloudWatch) DescribeAlarmsPages(input *cloudwatch.DescribeAlarmsInput, fn func(p *cloudwatch.DescribeAlarmsOutput, lastPage bool) (shouldContinue bool)) error {
+	for i, page := range m.alarmPages {
+		fn(&cloudwatch.DescribeAlarmsOutput{MetricAlarms: page, NextToken: awssdk.String(strconv.Itoa(i + 1))},
+			i < len(m.alarmPages),
+		)
+	}
+	return nil
+}
+
 type mockIam struct {
 	iamiface.IAMAPI
 	groups          []*iam.GroupDetail
@@ -151,6 +236,7 @@ type mockIam struct {
 	roles           []*iam.RoleDetail
 	users           []*iam.User
 	usersDetails    []*iam.UserDetail
+	listRolesCalls  int
 }
 
 func (m *mockIam) ListUsers(input *iam.ListUsersInput) (*iam.ListUsersOutput, error) {
@@ -190,6 +276,16 @@ func (m *mockIam) GetAccountAuthorizationDetailsPages(input *iam.GetAccountAutho
 	return nil
 }
 
+func (m *mockIam) ListRolesPages(input *iam.ListRolesInput, fn func(p *iam.ListRolesOutput, lastPage bool) (shouldContinue bool)) error {
+	m.listRolesCalls++
+	var roles []*iam.Role
+	for _, role := range m.roles {
+		roles = append(roles, &iam.Role{RoleId: role.RoleId, RoleName: role.RoleName, Arn: role.Arn})
+	}
+	fn(&iam.ListRolesOutput{Roles: roles}, true)
+	return nil
+}
+
 type mockS3 struct {
 	s3iface.S3API
 	bucketsACL       map[string][]*s3.Grant
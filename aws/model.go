@@ -24,6 +24,7 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 	//EC2
 	cloud.Instance: {
 		properties.Name:              {name: "Tags", transform: extractTagFn("Name")},
+		properties.Tags:              {name: "Tags", transform: extractTagsFn},
 		properties.Type:              {name: "InstanceType", transform: extractValueFn},
 		properties.Subnet:            {name: "SubnetId", transform: extractValueFn},
 		properties.Vpc:               {name: "VpcId", transform: extractValueFn},
@@ -49,12 +50,14 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 	},
 	cloud.Vpc: {
 		properties.Name:    {name: "Tags", transform: extractTagFn("Name")},
+		properties.Tags:    {name: "Tags", transform: extractTagsFn},
 		properties.Default: {name: "IsDefault", transform: extractValueFn},
 		properties.State:   {name: "State", transform: extractValueFn},
 		properties.CIDR:    {name: "CidrBlock", transform: extractValueFn},
 	},
 	cloud.Subnet: {
 		properties.Name:             {name: "Tags", transform: extractTagFn("Name")},
+		properties.Tags:             {name: "Tags", transform: extractTagsFn},
 		properties.Vpc:              {name: "VpcId", transform: extractValueFn},
 		properties.Public:           {name: "MapPublicIpOnLaunch", transform: extractValueFn},
 		properties.State:            {name: "State", transform: extractValueFn},
@@ -75,6 +78,7 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 	},
 	cloud.Volume: {
 		properties.Name:             {name: "Tags", transform: extractTagFn("Name")},
+		properties.Tags:             {name: "Tags", transform: extractTagsFn},
 		properties.Type:             {name: "VolumeType", transform: extractValueFn},
 		properties.State:            {name: "State", transform: extractValueFn},
 		properties.Size:             {name: "Size", transform: extractValueFn},
@@ -82,12 +86,39 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.Created:          {name: "CreateTime", transform: extractTimeFn},
 		properties.AvailabilityZone: {name: "AvailabilityZone", transform: extractValueFn},
 	},
+	cloud.Snapshot: {
+		properties.Name:        {name: "Tags", transform: extractTagFn("Name")},
+		properties.Tags:        {name: "Tags", transform: extractTagsFn},
+		properties.Description: {name: "Description", transform: extractValueFn},
+		properties.State:       {name: "State", transform: extractValueFn},
+		properties.Volume:      {name: "VolumeId", transform: extractValueFn},
+		properties.Size:        {name: "VolumeSize", transform: extractValueFn},
+		properties.Encrypted:   {name: "Encrypted", transform: extractValueFn},
+		properties.Created:     {name: "StartTime", transform: extractTimeFn},
+		properties.Owner:       {name: "OwnerId", transform: extractValueFn},
+	},
+	cloud.Image: {
+		properties.Name:           {name: "Name", transform: extractValueFn},
+		properties.Tags:           {name: "Tags", transform: extractTagsFn},
+		properties.Description:    {name: "Description", transform: extractValueFn},
+		properties.State:          {name: "State", transform: extractValueFn},
+		properties.Architecture:   {name: "Architecture", transform: extractValueFn},
+		properties.Hypervisor:     {name: "Hypervisor", transform: extractValueFn},
+		properties.Type:           {name: "ImageType", transform: extractValueFn},
+		properties.Public:         {name: "Public", transform: extractValueFn},
+		properties.Created:        {name: "CreationDate", transform: extractTimeStringFn},
+		properties.RootDevice:     {name: "RootDeviceName", transform: extractValueFn},
+		properties.RootDeviceType: {name: "RootDeviceType", transform: extractValueFn},
+		properties.Owner:          {name: "OwnerId", transform: extractValueFn},
+	},
 	cloud.InternetGateway: {
 		properties.Name: {name: "Tags", transform: extractTagFn("Name")},
+		properties.Tags: {name: "Tags", transform: extractTagsFn},
 		properties.Vpcs: {name: "Attachments", transform: extractStringSliceValues("VpcId")},
 	},
 	cloud.RouteTable: {
 		properties.Name:   {name: "Tags", transform: extractTagFn("Name")},
+		properties.Tags:   {name: "Tags", transform: extractTagsFn},
 		properties.Vpc:    {name: "VpcId", transform: extractValueFn},
 		properties.Routes: {name: "Routes", transform: extractRoutesSliceFn},
 		properties.Main:   {name: "Associations", transform: extractHasATrueBoolInStructSliceFn("Main")},
@@ -98,6 +129,39 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.Region:   {name: "RegionName", transform: extractValueFn},
 		properties.Messages: {name: "Messages", transform: extractStringSliceValues("Message")},
 	},
+	cloud.ElasticIP: {
+		properties.PublicIP:         {name: "PublicIp", transform: extractValueFn},
+		properties.PrivateIP:        {name: "PrivateIpAddress", transform: extractValueFn},
+		properties.Domain:           {name: "Domain", transform: extractValueFn},
+		properties.Instance:         {name: "InstanceId", transform: extractValueFn},
+		properties.NetworkInterface: {name: "NetworkInterfaceId", transform: extractValueFn},
+		properties.Association:      {name: "AssociationId", transform: extractValueFn},
+	},
+	cloud.NatGateway: {
+		properties.State:            {name: "State", transform: extractValueFn},
+		properties.Subnet:           {name: "SubnetId", transform: extractValueFn},
+		properties.Vpc:              {name: "VpcId", transform: extractValueFn},
+		properties.Created:          {name: "CreateTime", transform: extractTimeFn},
+		properties.PublicIP:         {name: "NatGatewayAddresses", transform: extractFirstFieldFn("PublicIp")},
+		properties.PrivateIP:        {name: "NatGatewayAddresses", transform: extractFirstFieldFn("PrivateIp")},
+		properties.NetworkInterface: {name: "NatGatewayAddresses", transform: extractFirstFieldFn("NetworkInterfaceId")},
+	},
+	cloud.NetworkInterface: {
+		properties.Name:             {name: "TagSet", transform: extractTagFn("Name")},
+		properties.Tags:             {name: "TagSet", transform: extractTagsFn},
+		properties.Description:      {name: "Description", transform: extractValueFn},
+		properties.Type:             {name: "InterfaceType", transform: extractValueFn},
+		properties.State:            {name: "Status", transform: extractValueFn},
+		properties.Subnet:           {name: "SubnetId", transform: extractValueFn},
+		properties.Vpc:              {name: "VpcId", transform: extractValueFn},
+		properties.AvailabilityZone: {name: "AvailabilityZone", transform: extractValueFn},
+		properties.PrivateIP:        {name: "PrivateIpAddress", transform: extractValueFn},
+		properties.PublicIP:         {name: "Association", transform: extractFieldFn("PublicIp")},
+		properties.MACAddress:       {name: "MacAddress", transform: extractValueFn},
+		properties.SecurityGroups:   {name: "Groups", transform: extractStringSliceValues("GroupId")},
+		properties.Instance:         {name: "Attachment", transform: extractFieldFn("InstanceId")},
+		properties.Attachment:       {name: "Attachment", transform: extractFieldFn("AttachmentId")},
+	},
 	// LoadBalancer
 	cloud.LoadBalancer: {
 		properties.Name:              {name: "LoadBalancerName", transform: extractValueFn},
@@ -183,6 +247,41 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.Subnets:     {name: "Subnets", transform: extractStringSliceValues("SubnetIdentifier")},
 		properties.Vpc:         {name: "VpcId", transform: extractValueFn},
 	},
+	//Autoscaling
+	cloud.LaunchConfiguration: {
+		properties.Name:           {name: "LaunchConfigurationName", transform: extractValueFn},
+		properties.Arn:            {name: "LaunchConfigurationARN", transform: extractValueFn},
+		properties.Created:        {name: "CreatedTime", transform: extractTimeFn},
+		properties.Image:          {name: "ImageId", transform: extractValueFn},
+		properties.Type:           {name: "InstanceType", transform: extractValueFn},
+		properties.SSHKey:         {name: "KeyName", transform: extractValueFn},
+		properties.SecurityGroups: {name: "SecurityGroups", transform: extractStringPointerSliceValuesFn},
+		properties.Profile:        {name: "IamInstanceProfile", transform: extractValueFn},
+		properties.Public:         {name: "AssociatePublicIpAddress", transform: extractValueFn},
+	},
+	cloud.ScalingGroup: {
+		properties.Name:                    {name: "AutoScalingGroupName", transform: extractValueFn},
+		properties.Arn:                     {name: "AutoScalingGroupARN", transform: extractValueFn},
+		properties.Created:                 {name: "CreatedTime", transform: extractTimeFn},
+		properties.LaunchConfigurationName: {name: "LaunchConfigurationName", transform: extractValueFn},
+		properties.DesiredCapacity:         {name: "DesiredCapacity", transform: extractValueFn},
+		properties.MinSize:                 {name: "MinSize", transform: extractValueFn},
+		properties.MaxSize:                 {name: "MaxSize", transform: extractValueFn},
+		properties.Cooldown:                {name: "DefaultCooldown", transform: extractValueFn},
+		properties.HealthCheckType:         {name: "HealthCheckType", transform: extractValueFn},
+		properties.AvailabilityZones:       {name: "AvailabilityZones", transform: extractStringPointerSliceValuesFn},
+		properties.Subnets:                 {name: "VPCZoneIdentifier", transform: extractCSVValuesFn},
+		properties.State:                   {name: "Status", transform: extractValueFn},
+	},
+	cloud.ScalingPolicy: {
+		properties.Name:              {name: "PolicyName", transform: extractValueFn},
+		properties.Arn:               {name: "PolicyARN", transform: extractValueFn},
+		properties.Type:              {name: "PolicyType", transform: extractValueFn},
+		properties.AdjustmentType:    {name: "AdjustmentType", transform: extractValueFn},
+		properties.ScalingAdjustment: {name: "ScalingAdjustment", transform: extractValueFn},
+		properties.Cooldown:          {name: "Cooldown", transform: extractValueFn},
+		properties.ScalingGroupName:  {name: "AutoScalingGroupName", transform: extractValueFn},
+	},
 	//IAM
 	cloud.User: {
 		properties.Name:             {name: "UserName", transform: extractValueFn},
@@ -262,4 +361,35 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 	},
 	//Queue
 	cloud.Queue: {}, //Manually set
+	//Lambda
+	cloud.Function: {
+		properties.Name:           {name: "FunctionName", transform: extractValueFn},
+		properties.Arn:            {name: "FunctionArn", transform: extractValueFn},
+		properties.Description:    {name: "Description", transform: extractValueFn},
+		properties.Runtime:        {name: "Runtime", transform: extractValueFn},
+		properties.Handler:        {name: "Handler", transform: extractValueFn},
+		properties.Memory:         {name: "MemorySize", transform: extractValueFn},
+		properties.Timeout:        {name: "Timeout", transform: extractValueFn},
+		properties.Role:           {name: "Role", transform: extractValueFn},
+		properties.Modified:       {name: "LastModified", transform: extractTimeStringFn},
+		properties.CodeSize:       {name: "CodeSize", transform: extractValueFn},
+		properties.Vpc:            {name: "VpcConfig", transform: extractFieldFn("VpcId")},
+		properties.Subnets:        {name: "VpcConfig", transform: extractStringPointerSliceFieldFn("SubnetIds")},
+		properties.SecurityGroups: {name: "VpcConfig", transform: extractStringPointerSliceFieldFn("SecurityGroupIds")},
+	},
+	//Monitoring
+	cloud.Alarm: {
+		properties.Name:           {name: "AlarmName", transform: extractValueFn},
+		properties.Arn:            {name: "AlarmArn", transform: extractValueFn},
+		properties.Description:    {name: "AlarmDescription", transform: extractValueFn},
+		properties.State:          {name: "StateValue", transform: extractValueFn},
+		properties.Updated:        {name: "StateUpdatedTimestamp", transform: extractTimeFn},
+		properties.Metric:         {name: "MetricName", transform: extractValueFn},
+		properties.Namespace:      {name: "Namespace", transform: extractValueFn},
+		properties.Statistic:      {name: "Statistic", transform: extractValueFn},
+		properties.Threshold:      {name: "Threshold", transform: extractFloatStringFn},
+		properties.Dimensions:     {name: "Dimensions", transform: extractDimensionsFn},
+		properties.ActionsEnabled: {name: "ActionsEnabled", transform: extractValueFn},
+		properties.Actions:        {name: "AlarmActions", transform: extractStringPointerSliceValuesFn},
+	},
 }
@@ -21,12 +21,18 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"strings"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/logger"
 )
 
 const (
@@ -63,10 +69,29 @@ var addParentsFns = map[string][]addParentFn{
 	cloud.RouteTable: {
 		funcBuilder{parent: cloud.Subnet, fieldName: "SubnetId", listName: "Associations", relation: DEPENDING_ON}.build(),
 		funcBuilder{parent: cloud.Vpc, fieldName: "VpcId"}.build(),
+		funcBuilder{parent: cloud.NatGateway, fieldName: "NatGatewayId", listName: "Routes", relation: DEPENDING_ON}.build(),
+		funcBuilder{parent: cloud.NetworkInterface, fieldName: "NetworkInterfaceId", listName: "Routes", relation: DEPENDING_ON}.build(),
 	},
 	cloud.Volume: {
 		funcBuilder{parent: cloud.AvailabilityZone, fieldName: "AvailabilityZone"}.build(),
 		funcBuilder{parent: cloud.Instance, fieldName: "InstanceId", listName: "Attachments", relation: DEPENDING_ON}.build(),
+		funcBuilder{parent: cloud.Snapshot, fieldName: "SnapshotId", relation: DEPENDING_ON}.build(),
+	},
+	cloud.Snapshot: {
+		funcBuilder{parent: cloud.Volume, fieldName: "VolumeId"}.build(),
+	},
+	cloud.Image: {addImageSnapshotsRelations},
+	cloud.ElasticIP: {
+		addRegionParent,
+		funcBuilder{parent: cloud.NetworkInterface, fieldName: "NetworkInterfaceId", relation: DEPENDING_ON}.build(),
+	},
+	cloud.NatGateway: {
+		funcBuilder{parent: cloud.Subnet, fieldName: "SubnetId"}.build(),
+	},
+	cloud.NetworkInterface: {
+		funcBuilder{parent: cloud.Subnet, fieldName: "SubnetId"}.build(),
+		funcBuilder{parent: cloud.SecurityGroup, fieldName: "GroupId", listName: "Groups", relation: APPLIES_ON}.build(),
+		addNetworkInterfaceInstanceRelation,
 	},
 	// Loadbalancer
 	cloud.LoadBalancer: {
@@ -88,6 +113,17 @@ var addParentsFns = map[string][]addParentFn{
 		funcBuilder{parent: cloud.AvailabilityZone, fieldName: "AvailabilityZone"}.build(),
 		funcBuilder{parent: cloud.SecurityGroup, listName: "VpcSecurityGroups", fieldName: "VpcSecurityGroupId", relation: APPLIES_ON}.build(),
 	},
+	// Autoscaling
+	cloud.LaunchConfiguration: {addRegionParent},
+	cloud.ScalingGroup: {
+		addScalingGroupSubnetsRelations,
+		funcBuilder{parent: cloud.TargetGroup, stringListName: "TargetGroupARNs", relation: APPLIES_ON}.build(),
+		funcBuilder{parent: cloud.LaunchConfiguration, fieldName: "LaunchConfigurationName", relation: APPLIES_ON}.build(),
+		funcBuilder{parent: cloud.Instance, fieldName: "InstanceId", listName: "Instances", relation: DEPENDING_ON}.build(),
+	},
+	cloud.ScalingPolicy: {
+		funcBuilder{parent: cloud.ScalingGroup, fieldName: "AutoScalingGroupName"}.build(),
+	},
 	cloud.Vpc:              {addRegionParent},
 	cloud.AvailabilityZone: {addRegionParent},
 	cloud.Keypair:          {addRegionParent},
@@ -95,6 +131,10 @@ var addParentsFns = map[string][]addParentFn{
 	cloud.Role:             {addManagedPoliciesRelations},
 	cloud.Group:            {addManagedPoliciesRelations},
 	cloud.Bucket:           {addRegionParent},
+	// Lambda
+	cloud.Function: {addFunctionVpcRelations, fetchFunctionRoleAndAddRelation},
+	// Monitoring
+	cloud.Alarm: {addRegionParent, addAlarmDimensionsRelations},
 }
 
 func (fb funcBuilder) build() addParentFn {
@@ -332,6 +372,139 @@ func userAddGroupsRelations(g *graph.Graph, i interface{}) error {
 	return nil
 }
 
+func addScalingGroupSubnetsRelations(g *graph.Graph, i interface{}) error {
+	group, ok := i.(*autoscaling.Group)
+	if !ok {
+		return fmt.Errorf("add subnets relation: not a scaling group, but a %T", i)
+	}
+	res, err := initResource(group)
+	if err != nil {
+		return err
+	}
+
+	for _, subnet := range strings.Split(awssdk.StringValue(group.VPCZoneIdentifier), ",") {
+		if subnet = strings.TrimSpace(subnet); subnet == "" {
+			continue
+		}
+		g.AddParentRelation(graph.InitResource(cloud.Subnet, subnet), res)
+	}
+	return nil
+}
+
+func addNetworkInterfaceInstanceRelation(g *graph.Graph, i interface{}) error {
+	ni, ok := i.(*ec2.NetworkInterface)
+	if !ok {
+		return fmt.Errorf("add instance relation: not a network interface, but a %T", i)
+	}
+	if ni.Attachment == nil || awssdk.StringValue(ni.Attachment.InstanceId) == "" {
+		return nil
+	}
+	res, err := initResource(ni)
+	if err != nil {
+		return err
+	}
+
+	g.AddAppliesOnRelation(res, graph.InitResource(cloud.Instance, awssdk.StringValue(ni.Attachment.InstanceId)))
+	return nil
+}
+
+// The root device snapshot is the parent of the image, giving the volume > snapshot > image lineage
+func addImageSnapshotsRelations(g *graph.Graph, i interface{}) error {
+	image, ok := i.(*ec2.Image)
+	if !ok {
+		return fmt.Errorf("add snapshots relations: not an image, but a %T", i)
+	}
+	res, err := initResource(image)
+	if err != nil {
+		return err
+	}
+
+	var rootSnapshot string
+	var snapshots []string
+	for _, mapping := range image.BlockDeviceMappings {
+		if mapping.Ebs == nil || awssdk.StringValue(mapping.Ebs.SnapshotId) == "" {
+			continue
+		}
+		snapshot := awssdk.StringValue(mapping.Ebs.SnapshotId)
+		if rootSnapshot == "" && awssdk.StringValue(mapping.DeviceName) == awssdk.StringValue(image.RootDeviceName) {
+			rootSnapshot = snapshot
+		} else {
+			snapshots = append(snapshots, snapshot)
+		}
+	}
+	if rootSnapshot == "" && len(snapshots) > 0 {
+		rootSnapshot, snapshots = snapshots[0], snapshots[1:]
+	}
+
+	if rootSnapshot == "" {
+		return addRegionParent(g, i)
+	}
+	g.AddParentRelation(graph.InitResource(cloud.Snapshot, rootSnapshot), res)
+	for _, snapshot := range snapshots {
+		g.AddAppliesOnRelation(res, graph.InitResource(cloud.Snapshot, snapshot))
+	}
+	return nil
+}
+
+// Functions running in a VPC are children of their subnets, the others of the region
+func addFunctionVpcRelations(g *graph.Graph, i interface{}) error {
+	function, ok := i.(*lambda.FunctionConfiguration)
+	if !ok {
+		return fmt.Errorf("add vpc relations: not a function, but a %T", i)
+	}
+	if function.VpcConfig == nil || len(function.VpcConfig.SubnetIds) == 0 {
+		return addRegionParent(g, i)
+	}
+	res, err := initResource(function)
+	if err != nil {
+		return err
+	}
+
+	for _, subnet := range function.VpcConfig.SubnetIds {
+		g.AddParentRelation(graph.InitResource(cloud.Subnet, awssdk.StringValue(subnet)), res)
+	}
+	for _, group := range function.VpcConfig.SecurityGroupIds {
+		g.AddAppliesOnRelation(graph.InitResource(cloud.SecurityGroup, awssdk.StringValue(group)), res)
+	}
+	return nil
+}
+
+// The execution role of a function is given by its ARN, whereas roles are identified by their id.
+// Roles are listed once per sync. A role that cannot be found (ex: missing IAM permission)
+// is skipped for the sync not to fail.
+func fetchFunctionRoleAndAddRelation(g *graph.Graph, i interface{}) error {
+	function, ok := i.(*lambda.FunctionConfiguration)
+	if !ok {
+		return fmt.Errorf("add role relation: not a function, but a %T", i)
+	}
+	arn := awssdk.StringValue(function.Role)
+	if arn == "" {
+		return nil
+	}
+	res, err := initResource(function)
+	if err != nil {
+		return err
+	}
+
+	access, ok := AccessService.(*Access)
+	if !ok {
+		logger.Verbosef("sync: skipping role %s of function %s: access service unavailable", arn, res.Id())
+		return nil
+	}
+	ids, err := access.roleIdsByArn()
+	if err != nil {
+		logger.Verbosef("sync: skipping role %s of function %s: cannot list roles: %s", arn, res.Id(), err)
+		return nil
+	}
+	id, ok := ids[arn]
+	if !ok {
+		logger.Verbosef("sync: skipping role %s of function %s: role not found", arn, res.Id())
+		return nil
+	}
+	g.AddAppliesOnRelation(graph.InitResource(cloud.Role, id), res)
+	return nil
+}
+
 func fetchTargetsAndAddRelations(g *graph.Graph, i interface{}) error {
 	group, ok := i.(*elbv2.TargetGroup)
 	if !ok {
@@ -353,3 +526,34 @@ func fetchTargetsAndAddRelations(g *graph.Graph, i interface{}) error {
 	}
 	return nil
 }
+
+// An alarm applies on the instances, load balancers or databases named in the dimensions of its metric.
+// Load balancers are identified by their ARN, rebuilt from the alarm ARN (ex: "app/my-lb/50dc6c495c0c9188" dimension)
+func addAlarmDimensionsRelations(g *graph.Graph, i interface{}) error {
+	alarm, ok := i.(*cloudwatch.MetricAlarm)
+	if !ok {
+		return fmt.Errorf("add dimensions relations: not an alarm, but a %T", i)
+	}
+	res, err := initResource(alarm)
+	if err != nil {
+		return err
+	}
+
+	for _, dimension := range alarm.Dimensions {
+		value := awssdk.StringValue(dimension.Value)
+		switch awssdk.StringValue(dimension.Name) {
+		case "InstanceId":
+			g.AddAppliesOnRelation(res, graph.InitResource(cloud.Instance, value))
+		case "DBInstanceIdentifier":
+			g.AddAppliesOnRelation(res, graph.InitResource(cloud.Database, value))
+		case "LoadBalancer":
+			splits := strings.Split(awssdk.StringValue(alarm.AlarmArn), ":")
+			if len(splits) < 5 {
+				continue
+			}
+			arn := fmt.Sprintf("arn:%s:elasticloadbalancing:%s:%s:loadbalancer/%s", splits[1], splits[3], splits[4], value)
+			g.AddAppliesOnRelation(res, graph.InitResource(cloud.LoadBalancer, arn))
+		}
+	}
+	return nil
+}
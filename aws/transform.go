@@ -24,13 +24,19 @@ import (
 	"hash/adler32"
 	"net"
 	"reflect"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/s3"
@@ -57,12 +63,26 @@ func initResource(source interface{}) (*graph.Resource, error) {
 		res = graph.InitResource(cloud.Keypair, awssdk.StringValue(ss.KeyName))
 	case *ec2.Volume:
 		res = graph.InitResource(cloud.Volume, awssdk.StringValue(ss.VolumeId))
+	case *ec2.Snapshot:
+		res = graph.InitResource(cloud.Snapshot, awssdk.StringValue(ss.SnapshotId))
+	case *ec2.Image:
+		res = graph.InitResource(cloud.Image, awssdk.StringValue(ss.ImageId))
 	case *ec2.InternetGateway:
 		res = graph.InitResource(cloud.InternetGateway, awssdk.StringValue(ss.InternetGatewayId))
 	case *ec2.RouteTable:
 		res = graph.InitResource(cloud.RouteTable, awssdk.StringValue(ss.RouteTableId))
 	case *ec2.AvailabilityZone:
 		res = graph.InitResource(cloud.AvailabilityZone, awssdk.StringValue(ss.ZoneName))
+	case *ec2.Address:
+		id := awssdk.StringValue(ss.AllocationId)
+		if id == "" { // EC2-Classic addresses have no allocation id
+			id = awssdk.StringValue(ss.PublicIp)
+		}
+		res = graph.InitResource(cloud.ElasticIP, id)
+	case *ec2.NatGateway:
+		res = graph.InitResource(cloud.NatGateway, awssdk.StringValue(ss.NatGatewayId))
+	case *ec2.NetworkInterface:
+		res = graph.InitResource(cloud.NetworkInterface, awssdk.StringValue(ss.NetworkInterfaceId))
 	// Loadbalancer
 	case *elbv2.LoadBalancer:
 		res = graph.InitResource(cloud.LoadBalancer, awssdk.StringValue(ss.LoadBalancerArn))
@@ -75,6 +95,13 @@ func initResource(source interface{}) (*graph.Resource, error) {
 		res = graph.InitResource(cloud.Database, awssdk.StringValue(ss.DBInstanceIdentifier))
 	case *rds.DBSubnetGroup:
 		res = graph.InitResource(cloud.DbSubnetGroup, awssdk.StringValue(ss.DBSubnetGroupName))
+	// Autoscaling
+	case *autoscaling.LaunchConfiguration:
+		res = graph.InitResource(cloud.LaunchConfiguration, awssdk.StringValue(ss.LaunchConfigurationName))
+	case *autoscaling.Group:
+		res = graph.InitResource(cloud.ScalingGroup, awssdk.StringValue(ss.AutoScalingGroupName))
+	case *autoscaling.ScalingPolicy:
+		res = graph.InitResource(cloud.ScalingPolicy, awssdk.StringValue(ss.PolicyARN))
 	// IAM
 	case *iam.User:
 		res = graph.InitResource(cloud.User, awssdk.StringValue(ss.UserId))
@@ -104,6 +131,12 @@ func initResource(source interface{}) (*graph.Resource, error) {
 	case *route53.ResourceRecordSet:
 		id := hashFields(awssdk.StringValue(ss.Name), awssdk.StringValue(ss.Type))
 		res = graph.InitResource(cloud.Record, id)
+		// Lambda
+	case *lambda.FunctionConfiguration:
+		res = graph.InitResource(cloud.Function, awssdk.StringValue(ss.FunctionArn))
+		// Monitoring
+	case *cloudwatch.MetricAlarm:
+		res = graph.InitResource(cloud.Alarm, awssdk.StringValue(ss.AlarmName))
 	default:
 		return nil, fmt.Errorf("Unknown type of resource %T", source)
 	}
@@ -209,6 +242,24 @@ var extractTimeFn = func(i interface{}) (interface{}, error) {
 	return t.UTC(), nil
 }
 
+// layouts of the dates given as strings by the AWS APIs (ex: lambda uses "+0000" timezones)
+var timeStringLayouts = []string{time.RFC3339, "2006-01-02T15:04:05.000-0700"}
+
+var extractTimeStringFn = func(i interface{}) (interface{}, error) {
+	s, ok := i.(*string)
+	if !ok {
+		return nil, fmt.Errorf("extract time string: expected string pointer, got: %T", i)
+	}
+	var t time.Time
+	var err error
+	for _, layout := range timeStringLayouts {
+		if t, err = time.Parse(layout, awssdk.StringValue(s)); err == nil {
+			return t.UTC(), nil
+		}
+	}
+	return nil, fmt.Errorf("extract time string: %s", err)
+}
+
 var extractIpPermissionSliceFn = func(i interface{}) (interface{}, error) {
 	if _, ok := i.([]*ec2.IpPermission); !ok {
 		return nil, fmt.Errorf("extract ip permission: not a permission slice but a %T", i)
@@ -294,6 +345,27 @@ var extractTagFn = func(key string) transformFn {
 	}
 }
 
+// extractTagsFn returns the tags as 'key=value', except the Name tag
+// already extracted as the Name property
+var extractTagsFn = func(i interface{}) (interface{}, error) {
+	tags, ok := i.([]*ec2.Tag)
+	if !ok {
+		return nil, fmt.Errorf("extract tags: not a tag slice, but a %T", i)
+	}
+	var res []string
+	for _, t := range tags {
+		if key := awssdk.StringValue(t.Key); key != "Name" {
+			res = append(res, fmt.Sprintf("%s=%s", key, awssdk.StringValue(t.Value)))
+		}
+	}
+	if len(res) == 0 {
+		return nil, ErrTagNotFound
+	}
+	sort.Strings(res)
+
+	return res, nil
+}
+
 var extractStringSliceValues = func(key string) transformFn {
 	return func(i interface{}) (interface{}, error) {
 		var res []string
@@ -317,6 +389,79 @@ var extractStringSliceValues = func(key string) transformFn {
 	}
 }
 
+var extractFirstFieldFn = func(key string) transformFn {
+	return func(i interface{}) (interface{}, error) {
+		value := reflect.ValueOf(i)
+		if value.Kind() != reflect.Slice {
+			return nil, fmt.Errorf("extract first field: not a slice but a %T", i)
+		}
+		if value.Len() == 0 {
+			return nil, nil
+		}
+		return extractFieldFn(key)(value.Index(0).Interface())
+	}
+}
+
+var extractStringPointerSliceValuesFn = func(i interface{}) (interface{}, error) {
+	values, ok := i.([]*string)
+	if !ok {
+		return nil, fmt.Errorf("extract string slice: not a string pointer slice but a %T", i)
+	}
+	return awssdk.StringValueSlice(values), nil
+}
+
+// extractStringPointerSliceFieldFn returns the values of a string pointer slice field of a struct (ex: VpcConfig.SubnetIds)
+var extractStringPointerSliceFieldFn = func(field string) transformFn {
+	return func(i interface{}) (interface{}, error) {
+		value := reflect.ValueOf(i)
+		if value.Kind() != reflect.Ptr || value.Elem().Kind() != reflect.Struct {
+			return nil, fmt.Errorf("extract string slice field: not a struct pointer but a %T", i)
+		}
+		structField := value.Elem().FieldByName(field)
+		if !structField.IsValid() {
+			return nil, fmt.Errorf("extract string slice field: field not found: %s", field)
+		}
+		return extractStringPointerSliceValuesFn(structField.Interface())
+	}
+}
+
+// extractCSVValuesFn returns the values of a comma separated string (ex: "subnet-1,subnet-2")
+var extractCSVValuesFn = func(i interface{}) (interface{}, error) {
+	str, ok := i.(*string)
+	if !ok {
+		return nil, fmt.Errorf("extract csv: not a string pointer but a %T", i)
+	}
+	var res []string
+	for _, v := range strings.Split(awssdk.StringValue(str), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			res = append(res, v)
+		}
+	}
+	return res, nil
+}
+
+// extractFloatStringFn formats a float pointer as a string since floats are not supported as graph literals
+var extractFloatStringFn = func(i interface{}) (interface{}, error) {
+	f, ok := i.(*float64)
+	if !ok {
+		return nil, fmt.Errorf("extract float string: not a float pointer but a %T", i)
+	}
+	return strconv.FormatFloat(awssdk.Float64Value(f), 'f', -1, 64), nil
+}
+
+// extractDimensionsFn returns the metric dimensions as 'Name:Value' strings (ex: "InstanceId:i-1234")
+var extractDimensionsFn = func(i interface{}) (interface{}, error) {
+	dimensions, ok := i.([]*cloudwatch.Dimension)
+	if !ok {
+		return nil, fmt.Errorf("extract dimensions: not a dimension slice but a %T", i)
+	}
+	var res []string
+	for _, d := range dimensions {
+		res = append(res, fmt.Sprintf("%s:%s", awssdk.StringValue(d.Name), awssdk.StringValue(d.Value)))
+	}
+	return res, nil
+}
+
 var extractRoutesSliceFn = func(i interface{}) (interface{}, error) {
 	if _, ok := i.([]*ec2.Route); !ok {
 		return nil, fmt.Errorf("extract route: not a route slice but a %T", i)
@@ -21,6 +21,7 @@ import (
 	"net"
 	"reflect"
 	"testing"
+	"time"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/wallix/awless/graph"
@@ -48,6 +49,23 @@ func TestTransformFunctions(t *testing.T) {
 		}
 	})
 
+	t.Run("extractTags", func(t *testing.T) {
+		t.Parallel()
+		tags := []*ec2.Tag{
+			{Key: awssdk.String("Name"), Value: awssdk.String("instance-name")},
+			{Key: awssdk.String("env"), Value: awssdk.String("prod")},
+			{Key: awssdk.String("Created with"), Value: awssdk.String("awless")},
+		}
+
+		val, _ := extractTagsFn(tags)
+		if got, want := val, []string{"Created with=awless", "env=prod"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		if _, err := extractTagsFn(tags[:1]); err != ErrTagNotFound {
+			t.Fatalf("got %v, want %v", err, ErrTagNotFound)
+		}
+	})
+
 	t.Run("extractValue", func(t *testing.T) {
 		t.Parallel()
 		val, _ := extractValueFn(awssdk.String("any"))
@@ -189,6 +207,55 @@ func TestTransformFunctions(t *testing.T) {
 		}
 	})
 
+	t.Run("extractFirstField", func(t *testing.T) {
+		t.Parallel()
+		addresses := []*ec2.NatGatewayAddress{
+			{PublicIp: awssdk.String("1.2.3.4")},
+			{PublicIp: awssdk.String("2.3.4.5")},
+		}
+		val, err := extractFirstFieldFn("PublicIp")(addresses)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := val, "1.2.3.4"; got != want {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		if val, err = extractFirstFieldFn("PublicIp")([]*ec2.NatGatewayAddress{}); err != nil || val != nil {
+			t.Fatalf("got %v, %v, want nil value", val, err)
+		}
+	})
+
+	t.Run("extractTimeString", func(t *testing.T) {
+		t.Parallel()
+		val, err := extractTimeStringFn(awssdk.String("2017-03-01T12:15:30.000+02:00"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := val, time.Date(2017, 3, 1, 10, 15, 30, 0, time.UTC); got != want {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		if val, err = extractTimeStringFn(awssdk.String("2017-03-01T10:15:30.000+0000")); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := val, time.Date(2017, 3, 1, 10, 15, 30, 0, time.UTC); got != want {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		if _, err = extractTimeStringFn(awssdk.String("yesterday")); err == nil {
+			t.Fatal("expected error")
+		}
+	})
+
+	t.Run("extractCSVValues", func(t *testing.T) {
+		t.Parallel()
+		val, err := extractCSVValuesFn(awssdk.String("sub-1, sub-2,,sub-3"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := val, []string{"sub-1", "sub-2", "sub-3"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+
 	t.Run("extractRoutesSlice", func(t *testing.T) {
 		t.Parallel()
 		routes := []*ec2.Route{
@@ -3,11 +3,15 @@ package properties
 // Properties
 const (
 	Actions                   = "Actions"
+	ActionsEnabled            = "ActionsEnabled"
+	AdjustmentType            = "AdjustmentType"
 	Affinity                  = "Affinity"
 	ApproximateMessageCount   = "ApproximateMessageCount"
 	Architecture              = "Architecture"
 	Arn                       = "Arn"
+	Association               = "Association"
 	Attachable                = "Attachable"
+	Attachment                = "Attachment"
 	AutoUpgrade               = "AutoUpgrade"
 	AvailabilityZone          = "AvailabilityZone"
 	AvailabilityZones         = "AvailabilityZones"
@@ -27,8 +31,10 @@ const (
 	CipherSuite               = "CipherSuite"
 	Class                     = "Class"
 	Cluster                   = "Cluster"
+	CodeSize                  = "CodeSize"
 	Comment                   = "Comment"
 	Continent                 = "Continent"
+	Cooldown                  = "Cooldown"
 	CopyTagsToSnapshot        = "CopyTagsToSnapshot"
 	Country                   = "Country"
 	Created                   = "Created"
@@ -37,6 +43,9 @@ const (
 	Default                   = "Default"
 	Delay                     = "Delay"
 	Description               = "Description"
+	DesiredCapacity           = "DesiredCapacity"
+	Dimensions                = "Dimensions"
+	Domain                    = "Domain"
 	Encrypted                 = "Encrypted"
 	Endpoint                  = "Endpoint"
 	Engine                    = "Engine"
@@ -45,7 +54,9 @@ const (
 	Fingerprint               = "Fingerprint"
 	GlobalID                  = "GlobalID"
 	Grants                    = "Grants"
+	Handler                   = "Handler"
 	HealthCheck               = "HealthCheck"
+	HealthCheckType           = "HealthCheckType"
 	HealthyThresholdCount     = "HealthyThresholdCount"
 	Host                      = "Host"
 	Hypervisor                = "Hypervisor"
@@ -53,21 +64,30 @@ const (
 	Image                     = "Image"
 	InboundRules              = "InboundRules"
 	InlinePolicies            = "InlinePolicies"
+	Instance                  = "Instance"
 	IOPS                      = "IOPS"
 	IPType                    = "IPType"
 	Key                       = "Key"
 	LatestRestorableTime      = "LatestRestorableTime"
+	LaunchConfigurationName   = "LaunchConfigurationName"
 	Launched                  = "Launched"
 	License                   = "License"
 	Lifecycle                 = "Lifecycle"
 	LoadBalancer              = "LoadBalancer"
+	MACAddress                = "MACAddress"
 	Main                      = "Main"
+	MaxSize                   = "MaxSize"
+	Memory                    = "Memory"
 	Messages                  = "Messages"
+	Metric                    = "Metric"
+	MinSize                   = "MinSize"
 	Modified                  = "Modified"
 	MonitoringInterval        = "MonitoringInterval"
 	MonitoringRole            = "MonitoringRole"
 	MultiAZ                   = "MultiAZ"
 	Name                      = "Name"
+	Namespace                 = "Namespace"
+	NetworkInterface          = "NetworkInterface"
 	NetworkInterfaces         = "NetworkInterfaces"
 	OptionGroups              = "OptionGroups"
 	OutboundRules             = "OutboundRules"
@@ -89,9 +109,13 @@ const (
 	Records                   = "Records"
 	RecordCount               = "RecordCount"
 	Region                    = "Region"
+	Role                      = "Role"
 	RootDevice                = "RootDevice"
 	RootDeviceType            = "RootDeviceType"
 	Routes                    = "Routes"
+	Runtime                   = "Runtime"
+	ScalingAdjustment         = "ScalingAdjustment"
+	ScalingGroupName          = "ScalingGroupName"
 	Scheme                    = "Scheme"
 	SecondaryAvailabilityZone = "SecondaryAvailabilityZone"
 	SecurityGroups            = "SecurityGroups"
@@ -100,10 +124,14 @@ const (
 	SpotInstanceRequestId     = "SpotInstanceRequestId"
 	SSHKey                    = "SSHKey"
 	State                     = "State"
+	Statistic                 = "Statistic"
 	Storage                   = "Storage"
 	StorageType               = "StorageType"
 	Subnet                    = "Subnet"
 	Subnets                   = "Subnets"
+	Tags                      = "Tags"
+	Threshold                 = "Threshold"
+	Timeout                   = "Timeout"
 	Timezone                  = "Timezone"
 	Topic                     = "Topic"
 	TrafficPolicyInstance     = "TrafficPolicyInstance"
@@ -112,6 +140,7 @@ const (
 	UnhealthyThresholdCount   = "UnhealthyThresholdCount"
 	Updated                   = "Updated"
 	Username                  = "Username"
+	Volume                    = "Volume"
 	Vpc                       = "Vpc"
 	Vpcs                      = "Vpcs"
 	Weight                    = "Weight"
@@ -67,11 +67,15 @@ var (
 // Properties
 var (
 	Actions                   = fmt.Sprintf("%s:actions", CloudNS)
+	ActionsEnabled            = fmt.Sprintf("%s:actionsEnabled", CloudNS)
+	AdjustmentType            = fmt.Sprintf("%s:adjustmentType", CloudNS)
 	Affinity                  = fmt.Sprintf("%s:affinity", CloudNS)
 	ApproximateMessageCount   = fmt.Sprintf("%s:approximateMessageCount", CloudNS)
 	Architecture              = fmt.Sprintf("%s:architecture", CloudNS)
 	Arn                       = fmt.Sprintf("%s:arn", CloudNS)
+	Association               = fmt.Sprintf("%s:association", CloudNS)
 	Attachable                = fmt.Sprintf("%s:attachable", CloudNS)
+	Attachment                = fmt.Sprintf("%s:attachment", CloudNS)
 	AutoUpgrade               = fmt.Sprintf("%s:autoUpgrade", CloudNS)
 	AvailabilityZone          = fmt.Sprintf("%s:availabilityZone", CloudNS)
 	AvailabilityZones         = fmt.Sprintf("%s:availabilityZones", CloudNS)
@@ -92,8 +96,10 @@ var (
 	CipherSuite               = fmt.Sprintf("%s:cipherSuite", CloudNS)
 	Class                     = fmt.Sprintf("%s:class", CloudNS)
 	Cluster                   = fmt.Sprintf("%s:cluster", CloudNS)
+	CodeSize                  = fmt.Sprintf("%s:codeSize", CloudNS)
 	Comment                   = RdfsComment
 	Continent                 = fmt.Sprintf("%s:continent", CloudNS)
+	Cooldown                  = fmt.Sprintf("%s:cooldown", CloudNS)
 	CopyTagsToSnapshot        = fmt.Sprintf("%s:copyTagsToSnapshot", CloudNS)
 	Country                   = fmt.Sprintf("%s:country", CloudNS)
 	Created                   = fmt.Sprintf("%s:created", CloudNS)
@@ -102,6 +108,9 @@ var (
 	Default                   = fmt.Sprintf("%s:default", CloudNS)
 	Delay                     = fmt.Sprintf("%s:delaySeconds", CloudNS)
 	Description               = fmt.Sprintf("%s:description", CloudNS)
+	DesiredCapacity           = fmt.Sprintf("%s:desiredCapacity", CloudNS)
+	Dimensions                = fmt.Sprintf("%s:dimensions", CloudNS)
+	Domain                    = fmt.Sprintf("%s:domain", CloudNS)
 	Encrypted                 = fmt.Sprintf("%s:encrypted", CloudNS)
 	Endpoint                  = fmt.Sprintf("%s:endpoint", CloudNS)
 	Engine                    = fmt.Sprintf("%s:engine", CloudNS)
@@ -111,7 +120,9 @@ var (
 	GlobalID                  = fmt.Sprintf("%s:globalID", CloudNS)
 	Grants                    = fmt.Sprintf("%s:grants", CloudNS)
 	GranteeType               = fmt.Sprintf("%s:granteeType", CloudNS)
+	Handler                   = fmt.Sprintf("%s:handler", CloudNS)
 	HealthCheck               = fmt.Sprintf("%s:healthCheck", CloudNS)
+	HealthCheckType           = fmt.Sprintf("%s:healthCheckType", CloudNS)
 	HealthyThresholdCount     = fmt.Sprintf("%s:healthyThresholdCount", CloudNS)
 	Host                      = fmt.Sprintf("%s:host", CloudNS)
 	Hypervisor                = fmt.Sprintf("%s:hypervisor", CloudNS)
@@ -119,21 +130,30 @@ var (
 	Image                     = fmt.Sprintf("%s:image", CloudNS)
 	InboundRules              = fmt.Sprintf("%s:inboundRules", netNS)
 	InlinePolicies            = fmt.Sprintf("%s:inlinePolicies", CloudNS)
+	Instance                  = fmt.Sprintf("%s:instance", CloudNS)
 	IOPS                      = fmt.Sprintf("%s:iops", CloudNS)
 	IPType                    = fmt.Sprintf("%s:ipType", netNS)
 	Key                       = fmt.Sprintf("%s:key", CloudNS)
 	LatestRestorableTime      = fmt.Sprintf("%s:latestRestorableTime", CloudNS)
+	LaunchConfigurationName   = fmt.Sprintf("%s:launchConfigurationName", CloudNS)
 	Launched                  = fmt.Sprintf("%s:launched", CloudNS)
 	License                   = fmt.Sprintf("%s:license", CloudNS)
 	Lifecycle                 = fmt.Sprintf("%s:lifecycle", CloudNS)
 	LoadBalancer              = fmt.Sprintf("%s:loadBalancer", CloudNS)
+	MACAddress                = fmt.Sprintf("%s:macAddress", netNS)
 	Main                      = fmt.Sprintf("%s:main", CloudNS)
+	MaxSize                   = fmt.Sprintf("%s:maxSize", CloudNS)
+	Memory                    = fmt.Sprintf("%s:memory", CloudNS)
 	Messages                  = fmt.Sprintf("%s:messages", CloudNS)
+	Metric                    = fmt.Sprintf("%s:metric", CloudNS)
+	MinSize                   = fmt.Sprintf("%s:minSize", CloudNS)
 	Modified                  = fmt.Sprintf("%s:modified", CloudNS)
 	MonitoringInterval        = fmt.Sprintf("%s:monitoringInterval", CloudNS)
 	MonitoringRole            = fmt.Sprintf("%s:monitoringRole", CloudNS)
 	MultiAZ                   = fmt.Sprintf("%s:multiAZ", CloudNS)
 	Name                      = fmt.Sprintf("%s:name", CloudNS)
+	Namespace                 = fmt.Sprintf("%s:namespace", CloudNS)
+	NetworkInterface          = fmt.Sprintf("%s:networkInterface", CloudNS)
 	NetworkInterfaces         = fmt.Sprintf("%s:networkInterfaces", CloudNS)
 	OptionGroups              = fmt.Sprintf("%s:optionGroups", CloudNS)
 	OutboundRules             = fmt.Sprintf("%s:outboundRules", netNS)
@@ -156,9 +176,13 @@ var (
 	RecordCount               = fmt.Sprintf("%s:recordCount", CloudNS)
 	Records                   = fmt.Sprintf("%s:records", CloudNS)
 	Region                    = fmt.Sprintf("%s:region", CloudNS)
+	Role                      = fmt.Sprintf("%s:role", CloudNS)
 	RootDevice                = fmt.Sprintf("%s:rootDevice", CloudNS)
 	RootDeviceType            = fmt.Sprintf("%s:rootDeviceType", CloudNS)
 	Routes                    = fmt.Sprintf("%s:routes", netNS)
+	Runtime                   = fmt.Sprintf("%s:runtime", CloudNS)
+	ScalingAdjustment         = fmt.Sprintf("%s:scalingAdjustment", CloudNS)
+	ScalingGroupName          = fmt.Sprintf("%s:scalingGroupName", CloudNS)
 	Scheme                    = fmt.Sprintf("%s:scheme", netNS)
 	SecondaryAvailabilityZone = fmt.Sprintf("%s:secondaryAvailabilityZone", CloudNS)
 	SecurityGroups            = fmt.Sprintf("%s:securityGroups", CloudNS)
@@ -167,10 +191,14 @@ var (
 	SpotInstanceRequestId     = fmt.Sprintf("%s:spotInstanceRequestId", CloudNS)
 	SSHKey                    = fmt.Sprintf("%s:sshKey", CloudNS)
 	State                     = fmt.Sprintf("%s:state", CloudNS)
+	Statistic                 = fmt.Sprintf("%s:statistic", CloudNS)
 	Storage                   = fmt.Sprintf("%s:storage", CloudNS)
 	StorageType               = fmt.Sprintf("%s:storageType", CloudNS)
 	Subnet                    = fmt.Sprintf("%s:subnet", CloudNS)
 	Subnets                   = fmt.Sprintf("%s:subnets", CloudNS)
+	Tags                      = fmt.Sprintf("%s:tags", CloudNS)
+	Threshold                 = fmt.Sprintf("%s:threshold", CloudNS)
+	Timeout                   = fmt.Sprintf("%s:timeout", CloudNS)
 	Timezone                  = fmt.Sprintf("%s:timezone", CloudNS)
 	Topic                     = fmt.Sprintf("%s:topic", CloudNS)
 	TrafficPolicyInstance     = fmt.Sprintf("%s:trafficPolicyInstance", CloudNS)
@@ -179,6 +207,7 @@ var (
 	UnhealthyThresholdCount   = fmt.Sprintf("%s:unhealthyThresholdCount", CloudNS)
 	Updated                   = fmt.Sprintf("%s:updated", CloudNS)
 	Username                  = fmt.Sprintf("%s:username", CloudNS)
+	Volume                    = fmt.Sprintf("%s:volume", CloudNS)
 	Vpc                       = fmt.Sprintf("%s:vpc", CloudNS)
 	Vpcs                      = fmt.Sprintf("%s:vpcs", CloudNS)
 	Weight                    = fmt.Sprintf("%s:weight", CloudNS)
@@ -187,11 +216,15 @@ var (
 
 var Labels = map[string]string{
 	properties.Actions:                   Actions,
+	properties.ActionsEnabled:            ActionsEnabled,
+	properties.AdjustmentType:            AdjustmentType,
 	properties.Affinity:                  Affinity,
 	properties.ApproximateMessageCount:   ApproximateMessageCount,
 	properties.Architecture:              Architecture,
 	properties.Arn:                       Arn,
+	properties.Association:               Association,
 	properties.Attachable:                Attachable,
+	properties.Attachment:                Attachment,
 	properties.AutoUpgrade:               AutoUpgrade,
 	properties.AvailabilityZone:          AvailabilityZone,
 	properties.AvailabilityZones:         AvailabilityZones,
@@ -211,8 +244,10 @@ var Labels = map[string]string{
 	properties.CipherSuite:               CipherSuite,
 	properties.Class:                     Class,
 	properties.Cluster:                   Cluster,
+	properties.CodeSize:                  CodeSize,
 	properties.Comment:                   Comment,
 	properties.Continent:                 Continent,
+	properties.Cooldown:                  Cooldown,
 	properties.CopyTagsToSnapshot:        CopyTagsToSnapshot,
 	properties.Country:                   Country,
 	properties.Created:                   Created,
@@ -221,6 +256,9 @@ var Labels = map[string]string{
 	properties.Default:                   Default,
 	properties.Delay:                     Delay,
 	properties.Description:               Description,
+	properties.DesiredCapacity:           DesiredCapacity,
+	properties.Dimensions:                Dimensions,
+	properties.Domain:                    Domain,
 	properties.Encrypted:                 Encrypted,
 	properties.Endpoint:                  Endpoint,
 	properties.Engine:                    Engine,
@@ -229,7 +267,9 @@ var Labels = map[string]string{
 	properties.Fingerprint:               Fingerprint,
 	properties.GlobalID:                  GlobalID,
 	properties.Grants:                    Grants,
+	properties.Handler:                   Handler,
 	properties.HealthCheck:               HealthCheck,
+	properties.HealthCheckType:           HealthCheckType,
 	properties.HealthyThresholdCount:     HealthyThresholdCount,
 	properties.Host:                      Host,
 	properties.Hypervisor:                Hypervisor,
@@ -237,21 +277,30 @@ var Labels = map[string]string{
 	properties.Image:                     Image,
 	properties.InboundRules:              InboundRules,
 	properties.InlinePolicies:            InlinePolicies,
+	properties.Instance:                  Instance,
 	properties.IOPS:                      IOPS,
 	properties.IPType:                    IPType,
 	properties.Key:                       Key,
 	properties.LatestRestorableTime:      LatestRestorableTime,
+	properties.LaunchConfigurationName:   LaunchConfigurationName,
 	properties.Launched:                  Launched,
 	properties.License:                   License,
 	properties.Lifecycle:                 Lifecycle,
 	properties.LoadBalancer:              LoadBalancer,
+	properties.MACAddress:                MACAddress,
 	properties.Main:                      Main,
+	properties.MaxSize:                   MaxSize,
+	properties.Memory:                    Memory,
 	properties.Messages:                  Messages,
+	properties.Metric:                    Metric,
+	properties.MinSize:                   MinSize,
 	properties.Modified:                  Modified,
 	properties.MonitoringInterval:        MonitoringInterval,
 	properties.MonitoringRole:            MonitoringRole,
 	properties.MultiAZ:                   MultiAZ,
 	properties.Name:                      Name,
+	properties.Namespace:                 Namespace,
+	properties.NetworkInterface:          NetworkInterface,
 	properties.NetworkInterfaces:         NetworkInterfaces,
 	properties.OptionGroups:              OptionGroups,
 	properties.OutboundRules:             OutboundRules,
@@ -273,9 +322,13 @@ var Labels = map[string]string{
 	properties.Records:                   Records,
 	properties.RecordCount:               RecordCount,
 	properties.Region:                    Region,
+	properties.Role:                      Role,
 	properties.RootDevice:                RootDevice,
 	properties.RootDeviceType:            RootDeviceType,
 	properties.Routes:                    Routes,
+	properties.Runtime:                   Runtime,
+	properties.ScalingAdjustment:         ScalingAdjustment,
+	properties.ScalingGroupName:          ScalingGroupName,
 	properties.Scheme:                    Scheme,
 	properties.SecondaryAvailabilityZone: SecondaryAvailabilityZone,
 	properties.SecurityGroups:            SecurityGroups,
@@ -284,10 +337,14 @@ var Labels = map[string]string{
 	properties.SpotInstanceRequestId:     SpotInstanceRequestId,
 	properties.SSHKey:                    SSHKey,
 	properties.State:                     State,
+	properties.Statistic:                 Statistic,
 	properties.Storage:                   Storage,
 	properties.StorageType:               StorageType,
 	properties.Subnet:                    Subnet,
 	properties.Subnets:                   Subnets,
+	properties.Tags:                      Tags,
+	properties.Threshold:                 Threshold,
+	properties.Timeout:                   Timeout,
 	properties.Timezone:                  Timezone,
 	properties.Topic:                     Topic,
 	properties.TrafficPolicyInstance:     TrafficPolicyInstance,
@@ -296,6 +353,7 @@ var Labels = map[string]string{
 	properties.UnhealthyThresholdCount:   UnhealthyThresholdCount,
 	properties.Updated:                   Updated,
 	properties.Username:                  Username,
+	properties.Volume:                    Volume,
 	properties.Vpc:                       Vpc,
 	properties.Vpcs:                      Vpcs,
 	properties.Weight:                    Weight,
@@ -304,11 +362,15 @@ var Labels = map[string]string{
 
 var RdfProperties = map[string]rdfProp{
 	Actions:                 {ID: Actions, RdfType: RdfProperty, RdfsLabel: properties.Actions, RdfsDefinedBy: RdfsList, RdfsDataType: XsdString},
+	ActionsEnabled:          {ID: ActionsEnabled, RdfType: RdfProperty, RdfsLabel: properties.ActionsEnabled, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdBoolean},
+	AdjustmentType:          {ID: AdjustmentType, RdfType: RdfProperty, RdfsLabel: properties.AdjustmentType, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	Affinity:                {ID: Affinity, RdfType: RdfProperty, RdfsLabel: properties.Affinity, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	ApproximateMessageCount: {ID: ApproximateMessageCount, RdfType: RdfProperty, RdfsLabel: properties.ApproximateMessageCount, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdInt},
 	Architecture:            {ID: Architecture, RdfType: RdfProperty, RdfsLabel: properties.Architecture, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	Arn:                     {ID: Arn, RdfType: RdfProperty, RdfsLabel: properties.Arn, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
+	Association:             {ID: Association, RdfType: RdfProperty, RdfsLabel: properties.Association, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	Attachable:              {ID: Attachable, RdfType: RdfProperty, RdfsLabel: properties.Attachable, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdBoolean},
+	Attachment:              {ID: Attachment, RdfType: RdfProperty, RdfsLabel: properties.Attachment, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	AutoUpgrade:             {ID: AutoUpgrade, RdfType: RdfProperty, RdfsLabel: properties.AutoUpgrade, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdBoolean},
 	AvailabilityZone:        {ID: AvailabilityZone, RdfType: RdfProperty, RdfsLabel: properties.AvailabilityZone, RdfsDefinedBy: RdfsClass, RdfsDataType: XsdString},
 	AvailabilityZones:       {ID: AvailabilityZones, RdfType: RdfProperty, RdfsLabel: properties.AvailabilityZones, RdfsDefinedBy: RdfsList, RdfsDataType: RdfsClass},
@@ -328,8 +390,10 @@ var RdfProperties = map[string]rdfProp{
 	CipherSuite:             {ID: CipherSuite, RdfType: RdfProperty, RdfsLabel: properties.CipherSuite, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	Class:                   {ID: Class, RdfType: RdfProperty, RdfsLabel: properties.Class, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	Cluster:                 {ID: Cluster, RdfType: RdfProperty, RdfsLabel: properties.Cluster, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
+	CodeSize:                {ID: CodeSize, RdfType: RdfProperty, RdfsLabel: properties.CodeSize, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdInt},
 	Comment:                 {ID: Comment, RdfType: RdfProperty, RdfsLabel: properties.Comment, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	Continent:               {ID: Continent, RdfType: RdfProperty, RdfsLabel: properties.Continent, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
+	Cooldown:                {ID: Cooldown, RdfType: RdfProperty, RdfsLabel: properties.Cooldown, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdInt},
 	CopyTagsToSnapshot:      {ID: CopyTagsToSnapshot, RdfType: RdfProperty, RdfsLabel: properties.CopyTagsToSnapshot, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	Country:                 {ID: Country, RdfType: RdfProperty, RdfsLabel: properties.Country, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	Created:                 {ID: Created, RdfType: RdfProperty, RdfsLabel: properties.Created, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdDateTime},
@@ -338,6 +402,9 @@ var RdfProperties = map[string]rdfProp{
 	Default:                 {ID: Default, RdfType: RdfProperty, RdfsLabel: properties.Default, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdBoolean},
 	Delay:                   {ID: Delay, RdfType: RdfProperty, RdfsLabel: properties.Delay, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdInt},
 	Description:             {ID: Description, RdfType: RdfProperty, RdfsLabel: properties.Description, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
+	DesiredCapacity:         {ID: DesiredCapacity, RdfType: RdfProperty, RdfsLabel: properties.DesiredCapacity, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdInt},
+	Dimensions:              {ID: Dimensions, RdfType: RdfProperty, RdfsLabel: properties.Dimensions, RdfsDefinedBy: RdfsList, RdfsDataType: XsdString},
+	Domain:                  {ID: Domain, RdfType: RdfProperty, RdfsLabel: properties.Domain, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	Encrypted:               {ID: Encrypted, RdfType: RdfProperty, RdfsLabel: properties.Encrypted, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdBoolean},
 	Endpoint:                {ID: Endpoint, RdfType: RdfProperty, RdfsLabel: properties.Endpoint, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	Engine:                  {ID: Engine, RdfType: RdfProperty, RdfsLabel: properties.Engine, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
@@ -346,7 +413,9 @@ var RdfProperties = map[string]rdfProp{
 	Fingerprint:             {ID: Fingerprint, RdfType: RdfProperty, RdfsLabel: properties.Fingerprint, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	GlobalID:                {ID: GlobalID, RdfType: RdfProperty, RdfsLabel: properties.GlobalID, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	Grants:                  {ID: Grants, RdfType: RdfProperty, RdfsLabel: properties.Grants, RdfsDefinedBy: RdfsList, RdfsDataType: Grant},
+	Handler:                 {ID: Handler, RdfType: RdfProperty, RdfsLabel: properties.Handler, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	HealthCheck:             {ID: HealthCheck, RdfType: RdfProperty, RdfsLabel: properties.HealthCheck, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
+	HealthCheckType:         {ID: HealthCheckType, RdfType: RdfProperty, RdfsLabel: properties.HealthCheckType, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	HealthyThresholdCount:   {ID: HealthyThresholdCount, RdfType: RdfProperty, RdfsLabel: properties.HealthyThresholdCount, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdInt},
 	Host:                     {ID: Host, RdfType: RdfProperty, RdfsLabel: properties.Host, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	Hypervisor:               {ID: Hypervisor, RdfType: RdfProperty, RdfsLabel: properties.Hypervisor, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
@@ -354,21 +423,30 @@ var RdfProperties = map[string]rdfProp{
 	Image:                    {ID: Image, RdfType: RdfProperty, RdfsLabel: properties.Image, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	InboundRules:             {ID: InboundRules, RdfType: RdfProperty, RdfsLabel: properties.InboundRules, RdfsDefinedBy: RdfsList, RdfsDataType: NetFirewallRule},
 	InlinePolicies:           {ID: InlinePolicies, RdfType: RdfProperty, RdfsLabel: properties.InlinePolicies, RdfsDefinedBy: RdfsList, RdfsDataType: RdfsClass},
+	Instance:                 {ID: Instance, RdfType: RdfProperty, RdfsLabel: properties.Instance, RdfsDefinedBy: RdfsClass, RdfsDataType: XsdString},
 	IOPS:                     {ID: IOPS, RdfType: RdfProperty, RdfsLabel: properties.IOPS, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdInt},
 	IPType:                   {ID: IPType, RdfType: RdfProperty, RdfsLabel: properties.IPType, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	Key:                      {ID: Key, RdfType: RdfProperty, RdfsLabel: properties.Key, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	LatestRestorableTime:     {ID: LatestRestorableTime, RdfType: RdfProperty, RdfsLabel: properties.LatestRestorableTime, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdDateTime},
+	LaunchConfigurationName:  {ID: LaunchConfigurationName, RdfType: RdfProperty, RdfsLabel: properties.LaunchConfigurationName, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	Launched:                 {ID: Launched, RdfType: RdfProperty, RdfsLabel: properties.Launched, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdDateTime},
 	License:                  {ID: License, RdfType: RdfProperty, RdfsLabel: properties.License, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	Lifecycle:                {ID: Lifecycle, RdfType: RdfProperty, RdfsLabel: properties.Lifecycle, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	LoadBalancer:             {ID: LoadBalancer, RdfType: RdfProperty, RdfsLabel: properties.LoadBalancer, RdfsDefinedBy: RdfsClass, RdfsDataType: XsdString},
+	MACAddress:               {ID: MACAddress, RdfType: RdfProperty, RdfsLabel: properties.MACAddress, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	Main:                     {ID: Main, RdfType: RdfProperty, RdfsLabel: properties.Main, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdBoolean},
+	MaxSize:                  {ID: MaxSize, RdfType: RdfProperty, RdfsLabel: properties.MaxSize, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdInt},
+	Memory:                   {ID: Memory, RdfType: RdfProperty, RdfsLabel: properties.Memory, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdInt},
 	Messages:                 {ID: Messages, RdfType: RdfProperty, RdfsLabel: properties.Messages, RdfsDefinedBy: RdfsList, RdfsDataType: XsdString},
+	Metric:                   {ID: Metric, RdfType: RdfProperty, RdfsLabel: properties.Metric, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
+	MinSize:                  {ID: MinSize, RdfType: RdfProperty, RdfsLabel: properties.MinSize, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdInt},
 	Modified:                 {ID: Modified, RdfType: RdfProperty, RdfsLabel: properties.Modified, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdDateTime},
 	MonitoringInterval:       {ID: MonitoringInterval, RdfType: RdfProperty, RdfsLabel: properties.MonitoringInterval, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	MonitoringRole:           {ID: MonitoringRole, RdfType: RdfProperty, RdfsLabel: properties.MonitoringRole, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	MultiAZ:                  {ID: MultiAZ, RdfType: RdfProperty, RdfsLabel: properties.MultiAZ, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	Name:                     {ID: Name, RdfType: RdfProperty, RdfsLabel: properties.Name, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
+	Namespace:                {ID: Namespace, RdfType: RdfProperty, RdfsLabel: properties.Namespace, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
+	NetworkInterface:         {ID: NetworkInterface, RdfType: RdfProperty, RdfsLabel: properties.NetworkInterface, RdfsDefinedBy: RdfsClass, RdfsDataType: XsdString},
 	NetworkInterfaces:        {ID: NetworkInterfaces, RdfType: RdfProperty, RdfsLabel: properties.NetworkInterfaces, RdfsDefinedBy: RdfsList, RdfsDataType: XsdString},
 	OptionGroups:             {ID: OptionGroups, RdfType: RdfProperty, RdfsLabel: properties.OptionGroups, RdfsDefinedBy: RdfsList, RdfsDataType: XsdString},
 	OutboundRules:            {ID: OutboundRules, RdfType: RdfProperty, RdfsLabel: properties.OutboundRules, RdfsDefinedBy: RdfsList, RdfsDataType: NetFirewallRule},
@@ -390,9 +468,13 @@ var RdfProperties = map[string]rdfProp{
 	Records:                  {ID: Records, RdfType: RdfProperty, RdfsLabel: properties.Records, RdfsDefinedBy: RdfsList, RdfsDataType: XsdString},
 	RecordCount:              {ID: RecordCount, RdfType: RdfProperty, RdfsLabel: properties.RecordCount, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdInt},
 	Region:                   {ID: Region, RdfType: RdfProperty, RdfsLabel: properties.Region, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
+	Role:                     {ID: Role, RdfType: RdfProperty, RdfsLabel: properties.Role, RdfsDefinedBy: RdfsClass, RdfsDataType: XsdString},
 	RootDevice:               {ID: RootDevice, RdfType: RdfProperty, RdfsLabel: properties.RootDevice, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	RootDeviceType:           {ID: RootDeviceType, RdfType: RdfProperty, RdfsLabel: properties.RootDeviceType, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	Routes:                   {ID: Routes, RdfType: RdfProperty, RdfsLabel: properties.Routes, RdfsDefinedBy: RdfsList, RdfsDataType: NetRoute},
+	Runtime:                  {ID: Runtime, RdfType: RdfProperty, RdfsLabel: properties.Runtime, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
+	ScalingAdjustment:        {ID: ScalingAdjustment, RdfType: RdfProperty, RdfsLabel: properties.ScalingAdjustment, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdInt},
+	ScalingGroupName:         {ID: ScalingGroupName, RdfType: RdfProperty, RdfsLabel: properties.ScalingGroupName, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	Scheme:                   {ID: Scheme, RdfType: RdfProperty, RdfsLabel: properties.Scheme, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	SecondaryAvailabilityZone: {ID: SecondaryAvailabilityZone, RdfType: RdfProperty, RdfsLabel: properties.SecondaryAvailabilityZone, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	SecurityGroups:            {ID: SecurityGroups, RdfType: RdfProperty, RdfsLabel: properties.SecurityGroups, RdfsDefinedBy: RdfsList, RdfsDataType: RdfsClass},
@@ -401,10 +483,14 @@ var RdfProperties = map[string]rdfProp{
 	SpotInstanceRequestId: {ID: SpotInstanceRequestId, RdfType: RdfProperty, RdfsLabel: properties.SpotInstanceRequestId, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	SSHKey:                {ID: SSHKey, RdfType: RdfProperty, RdfsLabel: properties.SSHKey, RdfsDefinedBy: RdfsClass, RdfsDataType: XsdString},
 	State:                 {ID: State, RdfType: RdfProperty, RdfsLabel: properties.State, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
+	Statistic:             {ID: Statistic, RdfType: RdfProperty, RdfsLabel: properties.Statistic, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	Storage:               {ID: Storage, RdfType: RdfProperty, RdfsLabel: properties.Storage, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	StorageType:           {ID: StorageType, RdfType: RdfProperty, RdfsLabel: properties.StorageType, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	Subnet:                {ID: Subnet, RdfType: RdfProperty, RdfsLabel: properties.Subnet, RdfsDefinedBy: RdfsClass, RdfsDataType: XsdString},
 	Subnets:               {ID: Subnets, RdfType: RdfProperty, RdfsLabel: properties.Subnets, RdfsDefinedBy: RdfsList, RdfsDataType: RdfsClass},
+	Tags:                  {ID: Tags, RdfType: RdfProperty, RdfsLabel: properties.Tags, RdfsDefinedBy: RdfsList, RdfsDataType: XsdString},
+	Threshold:             {ID: Threshold, RdfType: RdfProperty, RdfsLabel: properties.Threshold, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
+	Timeout:               {ID: Timeout, RdfType: RdfProperty, RdfsLabel: properties.Timeout, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdInt},
 	Timezone:              {ID: Timezone, RdfType: RdfProperty, RdfsLabel: properties.Timezone, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	Topic:                 {ID: Topic, RdfType: RdfProperty, RdfsLabel: properties.Topic, RdfsDefinedBy: RdfsClass, RdfsDataType: XsdString},
 	TrafficPolicyInstance: {ID: TrafficPolicyInstance, RdfType: RdfProperty, RdfsLabel: properties.TrafficPolicyInstance, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
@@ -413,6 +499,7 @@ var RdfProperties = map[string]rdfProp{
 	UnhealthyThresholdCount: {ID: UnhealthyThresholdCount, RdfType: RdfProperty, RdfsLabel: properties.UnhealthyThresholdCount, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdInt},
 	Updated:                 {ID: Updated, RdfType: RdfProperty, RdfsLabel: properties.Updated, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
 	Username:                {ID: Username, RdfType: RdfProperty, RdfsLabel: properties.Username, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
+	Volume:                  {ID: Volume, RdfType: RdfProperty, RdfsLabel: properties.Volume, RdfsDefinedBy: RdfsClass, RdfsDataType: XsdString},
 	Vpc:                     {ID: Vpc, RdfType: RdfProperty, RdfsLabel: properties.Vpc, RdfsDefinedBy: RdfsClass, RdfsDataType: XsdString},
 	Vpcs:                    {ID: Vpcs, RdfType: RdfProperty, RdfsLabel: properties.Vpcs, RdfsDefinedBy: RdfsList, RdfsDataType: RdfsClass},
 	Weight:                  {ID: Weight, RdfType: RdfProperty, RdfsLabel: properties.Weight, RdfsDefinedBy: RdfsLiteral, RdfsDataType: XsdString},
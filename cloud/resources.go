@@ -11,13 +11,21 @@ const (
 	AvailabilityZone string = "availabilityzone"
 	Keypair          string = "keypair"
 	Volume           string = "volume"
+	Snapshot         string = "snapshot"
 	Instance         string = "instance"
 	InternetGateway  string = "internetgateway"
 	RouteTable       string = "routetable"
+	ElasticIP        string = "elasticip"
+	NatGateway       string = "natgateway"
+	NetworkInterface string = "networkinterface"
 	//loadbalancer
 	LoadBalancer string = "loadbalancer"
 	TargetGroup  string = "targetgroup"
 	Listener     string = "listener"
+	//autoscaling
+	LaunchConfiguration string = "launchconfiguration"
+	ScalingGroup        string = "scalinggroup"
+	ScalingPolicy       string = "scalingpolicy"
 	//database
 	Database      string = "database"
 	DbSubnetGroup string = "dbsubnetgroup"
@@ -39,4 +47,8 @@ const (
 	//dns
 	Zone   string = "zone"
 	Record string = "record"
+	//lambda
+	Function string = "function"
+	//monitoring
+	Alarm string = "alarm"
 )
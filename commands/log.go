@@ -55,7 +55,7 @@ var logCmd = &cobra.Command{
 		exitOn(err)
 
 		for _, templ := range all {
-			printer := template.NewLogPrinter(os.Stdout)
+			printer := template.NewLogPrinter(os.Stdout, lookupDefinitionsFunc)
 			printer.RenderKO = renderRedFn
 			printer.RenderOK = renderGreenFn
 
@@ -21,6 +21,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/wallix/awless/database"
+	"github.com/wallix/awless/template"
 )
 
 func init() {
@@ -44,14 +45,45 @@ var revertCmd = &cobra.Command{
 		db, err, dbclose := database.Current()
 		exitOn(err)
 		tpl, err := db.GetTemplate(revertId)
+		if err == nil {
+			tpl, err = withResumedRuns(db, tpl)
+		}
 		dbclose()
 		exitOn(err)
 
-		reverted, err := tpl.Revert()
+		reverted, err := tpl.Revert(lookupDefinitionsFunc)
 		exitOn(err)
 
-		exitOn(runTemplate(reverted))
+		// reverts run serially: their statements (ex: deletions in the reverse
+		// order of creations) are ordered without being linked by references
+		exitOn(runTemplateConcurrently(reverted, newTemplateEnv(), 1))
 
 		return nil
 	},
 }
+
+// withResumedRuns returns the template merging the statements of all the
+// runs linked to the given one by resumption, in their running order
+func withResumedRuns(db *database.DB, tpl *template.Template) (*template.Template, error) {
+	runs := []*template.Template{tpl}
+	for first := tpl; first.ResumeOf != ""; {
+		previous, err := db.GetTemplate(first.ResumeOf)
+		if err != nil {
+			return nil, err
+		}
+		runs = append([]*template.Template{previous}, runs...)
+		first = previous
+	}
+	for last := tpl; last.ResumedBy != ""; {
+		next, err := db.GetTemplate(last.ResumedBy)
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, next)
+		last = next
+	}
+	if len(runs) == 1 {
+		return tpl, nil
+	}
+	return template.MergeRuns(runs...), nil
+}
@@ -18,15 +18,18 @@ package commands
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"os"
+	"os/signal"
 	"sort"
 	"strings"
 	stdsync "sync"
+	"time"
 
 	"github.com/chzyer/readline"
 	"github.com/spf13/cobra"
@@ -34,6 +37,7 @@ import (
 	awscloud "github.com/wallix/awless/aws/driver"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/console"
 	"github.com/wallix/awless/database"
 	"github.com/wallix/awless/graph"
 	"github.com/wallix/awless/logger"
@@ -42,8 +46,25 @@ import (
 	"github.com/wallix/awless/template/driver"
 )
 
+var (
+	rollbackOnFailureFlag bool
+	paramsFilesFlag       []string
+	noPromptFlag          bool
+	planOnlyFlag          bool
+	resumeFlag            string
+	timeoutFlag           time.Duration
+	hooksFlag             []string
+)
+
 func init() {
 	RootCmd.AddCommand(runCmd)
+	runCmd.Flags().BoolVar(&rollbackOnFailureFlag, "rollback-on-failure", false, "Automatically revert what has been done when the template fails midway")
+	runCmd.Flags().StringSliceVar(&paramsFilesFlag, "params-file", nil, "Fill holes from params files (YAML, JSON or key=value), applied in order. Given params take precedence")
+	runCmd.Flags().BoolVar(&noPromptFlag, "no-prompt", false, "Fail listing unfilled holes instead of prompting for them")
+	runCmd.Flags().StringVar(&resumeFlag, "resume", "", "Resume a failed template run given its ID, re-running its failed and pending statements. Given params override the failed statement params")
+	runCmd.Flags().BoolVar(&planOnlyFlag, "plan-only", false, "Show the predicted changes on your local synced resources without running the template")
+	runCmd.Flags().DurationVar(&timeoutFlag, "timeout", 0, "Stop the run gracefully once the duration has expired (ex: 10m), as on interruption with Ctrl+C")
+	runCmd.Flags().StringSliceVar(&hooksFlag, "hook", nil, "Executables or HTTP endpoints receiving the run events as JSON, in addition to the 'template.hooks' config. A failing hook on before events vetoes the run")
 	for action, entities := range awscloud.DriverSupportedActions() {
 		RootCmd.AddCommand(
 			createDriverCommands(action, entities),
@@ -53,12 +74,16 @@ func init() {
 
 var runCmd = &cobra.Command{
 	Use:               "run PATH",
-	Short:             "Run a template given a filepath or a URL (prefixed with http)",
-	Example:           "  awless run ~/templates/my-infra.txt\n  awless run https://raw.githubusercontent.com/wallix/awless-templates/master/create_vpc.awls\n  awless run repo:create_vpc",
+	Short:             "Run a template given a filepath or a URL (prefixed with http). JSON (.json) and YAML (.yml, .yaml) templates are detected",
+	Example:           "  awless run ~/templates/my-infra.txt\n  awless run https://raw.githubusercontent.com/wallix/awless-templates/master/create_vpc.awls\n  awless run repo:create_vpc\n  awless run ~/templates/my-infra.json\n  awless run --resume 01BA7RV6ES86PZYCM3H28WM6KZ type=t2.small",
 	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, initSyncerHook),
 	PersistentPostRun: applyHooks(saveHistoryHook, verifyNewVersionHook),
 
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if resumeFlag != "" {
+			exitOn(resumeTemplate(resumeFlag, args))
+			return nil
+		}
 		if len(args) < 1 {
 			return errors.New("missing PATH arg (filepath or url)")
 		}
@@ -68,27 +93,85 @@ var runCmd = &cobra.Command{
 
 		logger.Verbosef("Loaded template text:\n\n%s\n", content)
 
-		templ, err := template.Parse(string(content))
+		templ, err := template.ParseFile(args[0], content)
 		exitOn(err)
 
-		extraParams, err := template.ParseParams(strings.Join(args[1:], " "))
+		extraParams, err := template.ParseParams(template.QuoteParamsArgs(args[1:]))
 		exitOn(err)
 
-		exitOn(runTemplate(templ, config.Defaults, extraParams))
+		fillers := append([]map[string]interface{}{config.Defaults}, paramsFilesFillers()...)
+		env := newTemplateEnv(append(fillers, extraParams)...)
+		env.Source = args[0]
+		exitOn(runTemplateConcurrently(templ, env, config.GetTemplateConcurrency()))
 
 		return nil
 	},
 }
 
-func missingHolesStdinFunc() func(string) interface{} {
+func resumeTemplate(id string, args []string) error {
+	db, err, dbclose := database.Current()
+	if err != nil {
+		return err
+	}
+	failed, err := db.GetTemplate(id)
+	dbclose()
+	if err != nil {
+		return err
+	}
+	if failed.ResumedBy != "" {
+		return fmt.Errorf("template %s already resumed by %s", id, failed.ResumedBy)
+	}
+	if failed.RollbackID != "" {
+		return fmt.Errorf("template %s already rolled back by %s", id, failed.RollbackID)
+	}
+
+	overrides, err := template.ParseParams(template.QuoteParamsArgs(args))
+	if err != nil {
+		return err
+	}
+
+	resumed, err := failed.Resume(overrides)
+	if err != nil {
+		return err
+	}
+	logger.Infof("Resuming template %s", id)
+
+	return runTemplate(resumed)
+}
+
+func paramsFilesFillers() (fillers []map[string]interface{}) {
+	for _, path := range paramsFilesFlag {
+		content, err := ioutil.ReadFile(path)
+		exitOn(err)
+		params, err := template.ParseParamsFile(path, content)
+		exitOn(err)
+		logger.Verbosef("holes fillers from params file %s: %s", path, sprintProcessedParams(params))
+		fillers = append(fillers, params)
+	}
+	return
+}
+
+func missingHolesFunc(env *template.Env) func(string) interface{} {
+	if noPromptFlag {
+		return nil
+	}
+	return missingHolesStdinFunc(env)
+}
+
+func missingHolesStdinFunc(env *template.Env) func(string) interface{} {
 	var count int
 	return func(hole string) (response interface{}) {
 		if count < 1 {
 			fmt.Println("Please specify (Ctrl+C to quit, Tab for completion):")
 		}
 
+		spec, ok := env.HoleSpecs[hole]
+		if !ok {
+			spec = template.HoleSpec{Name: hole}
+		}
+
 		var err error
-		for response, err = askHole(hole); err != nil; response, err = askHole(hole) {
+		for response, err = askHole(spec); err != nil; response, err = askHole(spec) {
 			logger.Errorf("invalid value: %s", err)
 		}
 		count++
@@ -96,10 +179,15 @@ func missingHolesStdinFunc() func(string) interface{} {
 	}
 }
 
-func askHole(hole string) (interface{}, error) {
+func askHole(spec template.HoleSpec) (interface{}, error) {
+	hole := spec.Name
+	completed := hole
+	if entity := spec.Entity(); entity != "" {
+		completed = entity
+	}
 	l, err := readline.NewEx(&readline.Config{
-		Prompt:          fmt.Sprintf("%s? ", hole),
-		AutoComplete:    idAndNameCompleter(hole),
+		Prompt:          fmt.Sprintf("%s? ", spec),
+		AutoComplete:    idAndNameCompleter(completed),
 		InterruptPrompt: "^C",
 		EOFPrompt:       "exit",
 	})
@@ -122,14 +210,16 @@ func askHole(hole string) (interface{}, error) {
 
 		line = strings.TrimSpace(line)
 		switch {
+		case line == "" && spec.Default != "":
+			return spec.Convert(spec.Default)
 		case line == "":
 			return nil, errors.New("empty")
 		default:
-			params, err := template.ParseParams(fmt.Sprintf("%s=%s", hole, line))
+			params, err := template.ParseParams(template.QuoteParamsArgs([]string{fmt.Sprintf("%s=%s", hole, line)}))
 			if err != nil {
 				return nil, err
 			}
-			return params[hole], nil
+			return spec.Convert(params[hole])
 		}
 	}
 	return nil, nil
@@ -186,13 +276,26 @@ func idAndNameCompleter(hole string) readline.AutoCompleter {
 }
 
 func runTemplate(templ *template.Template, fillers ...map[string]interface{}) error {
+	return runTemplateConcurrently(templ, newTemplateEnv(fillers...), config.GetTemplateConcurrency())
+}
+
+func newTemplateEnv(fillers ...map[string]interface{}) *template.Env {
 	env := template.NewEnv()
 	env.Log = logger.DefaultLogger
 	env.AddFillers(fillers...)
 	env.DefLookupFunc = lookupDefinitionsFunc
 	env.AliasFunc = resolveAliasFunc
-	env.MissingHolesFunc = missingHolesStdinFunc()
+	env.LookupGraph = lookupLocalGraphFunc
+	env.IncludeFunc = getTemplateText
+	env.MissingHolesFunc = missingHolesFunc(env)
+	return env
+}
 
+// runTemplateConcurrently compiles, confirms and runs the template following
+// the DAG of its statements, linked by the references they use: a statement
+// starts once the statements it references have completed, with at most
+// `concurrency` statements running at once (the template.concurrency config)
+func runTemplateConcurrently(templ *template.Template, env *template.Env, concurrency int) error {
 	if len(env.Fillers) > 0 {
 		logger.Verbosef("default/given holes fillers: %s", sprintProcessedParams(env.Fillers))
 	}
@@ -201,7 +304,16 @@ func runTemplate(templ *template.Template, fillers ...map[string]interface{}) er
 	templ, env, err = template.Compile(templ, env)
 	exitOn(err)
 
-	validateTemplate(templ)
+	denied := validateTemplate(templ)
+
+	if planOnlyFlag {
+		fmt.Printf("%s\n", renderGreenFn(templ))
+		printPlan(templ)
+		return nil
+	}
+	if denied {
+		exitOn(errors.New("template run denied by policy"))
+	}
 
 	var drivers []driver.Driver
 	for _, s := range cloud.ServiceRegistry {
@@ -210,11 +322,18 @@ func runTemplate(templ *template.Template, fillers ...map[string]interface{}) er
 	awsDriver := driver.NewMultiDriver(drivers...)
 
 	awsDriver.SetLogger(logger.DefaultLogger)
+	awsDriver.(*driver.MultiDriver).SetRetryPolicy(&driver.RetryPolicy{
+		MaxAttempts: config.GetTemplateRetryAttempts(),
+		BaseDelay:   config.GetTemplateRetryDelay(),
+		MaxDelay:    30 * time.Second,
+		Classify:    awscloud.ClassifyError,
+	})
 
 	err = templ.DryRun(awsDriver)
 	exitOn(err)
 
 	fmt.Printf("%s\n", renderGreenFn(templ))
+	printPlan(templ)
 
 	var yesorno string
 	if forceGlobalFlag {
@@ -227,24 +346,71 @@ func runTemplate(templ *template.Template, fillers ...map[string]interface{}) er
 	}
 
 	if strings.TrimSpace(yesorno) == "y" {
-		newTempl, err := templ.Run(awsDriver)
+		ctx, stop := runContext()
+		templ.Hooks = templateHooks()
+		newTempl, runErr := templ.RunConcurrently(ctx, awsDriver, concurrency)
+		cancelled := ctx.Err()
+		stop()
+		if veto, ok := runErr.(*template.VetoError); ok && veto.Event == template.BeforeRun {
+			exitOn(runErr)
+		}
 
 		printer := template.NewDefaultPrinter(os.Stdout)
 		printer.RenderKO = renderRedFn
 		printer.RenderOK = renderGreenFn
 		printer.Print(newTempl)
+		printOutputs(newTempl)
+
+		failed := runErr != nil || newTempl.HasErrors()
+
+		var revertNow bool
+		if cancelled != nil && len(newTempl.Pending) > 0 {
+			fmt.Println()
+			if cancelled == context.DeadlineExceeded {
+				logger.Warningf("Run cancelled as the timeout of %s expired: %d statement(s) not run", timeoutFlag, len(newTempl.Pending))
+			} else {
+				logger.Warningf("Run interrupted: %d statement(s) not run", len(newTempl.Pending))
+			}
+			if !rollbackOnFailureFlag && !forceGlobalFlag && template.IsRevertible(newTempl, lookupDefinitionsFunc) {
+				fmt.Print("Revert what has been done? (y/n): ")
+				var revertYesOrNo string
+				fmt.Scanln(&revertYesOrNo)
+				revertNow = strings.TrimSpace(revertYesOrNo) == "y"
+			}
+		}
+
+		var rollback *template.Template
+		if failed && (rollbackOnFailureFlag || revertNow) {
+			rollback = rollbackTemplate(newTempl, awsDriver)
+		}
 
 		db, err, close := database.Current()
 		exitOn(err)
 		defer close()
 
+		newTempl.ResumeOf = templ.ResumeOf
 		db.AddTemplate(newTempl)
-		if template.IsRevertible(newTempl) {
+		if rollback != nil {
+			db.AddTemplate(rollback)
+		}
+		if newTempl.ResumeOf != "" {
+			if resumed, err := db.GetTemplate(newTempl.ResumeOf); err != nil {
+				logger.Errorf("cannot link resumed template %s: %s", newTempl.ResumeOf, err)
+			} else {
+				resumed.ResumedBy = newTempl.ID
+				db.AddTemplate(resumed)
+			}
+		}
+
+		if rollback == nil && template.IsRevertible(newTempl, lookupDefinitionsFunc) {
 			fmt.Println()
 			logger.Infof("Revert this template with `awless revert %s`", newTempl.ID)
 		}
+		if failed && rollback == nil {
+			logger.Infof("Resume this template with `awless run --resume %s`", newTempl.ID)
+		}
 
-		if err == nil && !newTempl.HasErrors() {
+		if !failed || rollback != nil {
 			runSyncFor(newTempl)
 		}
 	}
@@ -252,20 +418,201 @@ func runTemplate(templ *template.Template, fillers ...map[string]interface{}) er
 	return nil
 }
 
-func validateTemplate(tpl *template.Template) {
-	unicityRule := &template.UniqueNameValidator{LookupGraph: func(key string) (*graph.Graph, bool) {
-		g := sync.LoadCurrentLocalGraph(aws.ServicePerResourceType[key])
-		return g, true
-	}}
+func printOutputs(tpl *template.Template) {
+	outputs := tpl.Outputs()
+	if len(outputs) == 0 {
+		return
+	}
+
+	var names []string
+	for name := range outputs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Println()
+	fmt.Println("Outputs:")
+	for _, name := range names {
+		fmt.Printf("\t%s = %v\n", name, outputs[name])
+	}
+}
 
-	errs := tpl.Validate(unicityRule, &template.ParamIsSetValidator{Action: "create", Entity: "instance", Param: "key", WarningMessage: "This instance has no access key. You might not be able to connect to it. Use `awless create instance key=my-key ...`"})
+func printPlan(tpl *template.Template) {
+	local, err := sync.LoadAllGraphs()
+	if err != nil {
+		logger.Warningf("plan: cannot load local resources: %s", err)
+		return
+	}
+
+	root := graph.InitResource(cloud.Region, config.GetAWSRegion())
+	plan, err := tpl.Plan(local, root, lookupDefinitionsFunc)
+	if err != nil {
+		logger.Warningf("plan: %s", err)
+		return
+	}
+
+	fmt.Println()
+	if !plan.HasChanges() {
+		fmt.Println("Plan: no predicted changes on your local synced resources")
+		return
+	}
+
+	fmt.Println("Plan:")
+	if plan.Diff.HasDiff() {
+		displayer := console.BuildOptions(
+			console.WithFormat("tree"),
+			console.WithRootNode(root),
+		).SetSource(plan.Diff).Build()
+		exitOn(displayer.Print(os.Stdout))
+	}
+	if len(plan.Modified) > 0 {
+		fmt.Println()
+		displayer := console.BuildOptions(
+			console.WithFormat("table"),
+			console.WithRootNode(root),
+		).SetSource(plan.Diff).Build()
+		exitOn(displayer.Print(os.Stdout))
+	}
+	if len(plan.Affected) > 0 {
+		fmt.Println()
+		fmt.Println("Affected resources (depending on deleted or modified ones):")
+		for _, res := range plan.Affected {
+			fmt.Printf("\t%s\n", res)
+		}
+	}
+}
+
+func rollbackTemplate(failed *template.Template, d driver.Driver) *template.Template {
+	fmt.Println()
+	if !template.IsRevertible(failed, lookupDefinitionsFunc) {
+		logger.Info("Rollback: nothing to revert")
+		return nil
+	}
+
+	reverted, err := failed.Revert(lookupDefinitionsFunc)
+	if err != nil {
+		logger.Errorf("Rollback: %s", err)
+		return nil
+	}
+
+	env := template.NewEnv()
+	env.Log = logger.DefaultLogger
+	env.DefLookupFunc = lookupDefinitionsFunc
+	env.MissingHolesFunc = missingHolesFunc(env)
+
+	if reverted, _, err = template.Compile(reverted, env); err != nil {
+		logger.Errorf("Rollback: %s", err)
+		return nil
+	}
+
+	logger.Infof("Rolling back template %s:", failed.ID)
+	fmt.Printf("%s\n\n", renderGreenFn(reverted))
+
+	reverted.Hooks = templateHooks()
+	// rollbacks run serially: their statements (ex: deletions in the reverse
+	// order of creations) are ordered without being linked by references
+	rollback, err := reverted.Run(context.Background(), d)
+
+	printer := template.NewDefaultPrinter(os.Stdout)
+	printer.RenderKO = renderRedFn
+	printer.RenderOK = renderGreenFn
+	printer.Print(rollback)
+
+	rollback.RollbackOf = failed.ID
+	if err != nil || rollback.HasErrors() {
+		fmt.Println()
+		logger.Errorf("Rollback failed. Retry later with `awless revert %s`", failed.ID)
+		return rollback
+	}
+	failed.RollbackID = rollback.ID
+
+	return rollback
+}
+
+// runContext returns the context of a template run, cancelled on interruption
+// (Ctrl+C) or when the --timeout expires, and the func to call once the run
+// is over. Interrupting again exits right away.
+func runContext() (context.Context, func()) {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if timeoutFlag > 0 {
+		ctx, cancel = context.WithTimeout(context.Background(), timeoutFlag)
+	} else {
+		ctx, cancel = context.WithCancel(context.Background())
+	}
+
+	interrupts := make(chan os.Signal, 1)
+	signal.Notify(interrupts, os.Interrupt)
+	over := make(chan struct{})
+	go func() {
+		select {
+		case <-interrupts:
+			fmt.Println()
+			logger.Warning("Interrupted: waiting for the running statements to complete, the others are cancelled (Ctrl+C again to exit now)")
+			cancel()
+		case <-ctx.Done():
+		case <-over:
+			return
+		}
+		select {
+		case <-interrupts:
+			os.Exit(1)
+		case <-over:
+		}
+	}()
+
+	return ctx, func() {
+		signal.Stop(interrupts)
+		close(over)
+		cancel()
+	}
+}
+
+// templateHooks returns the hooks of the 'template.hooks' config and of the
+// --hook flag. Their errors on after events are only logged.
+func templateHooks() (hooks []template.Hook) {
+	for _, target := range append(config.GetTemplateHooks(), hooksFlag...) {
+		hook := template.NewHook(target)
+		hooks = append(hooks, func(ctx context.Context, e *template.Event) error {
+			err := hook(ctx, e)
+			if err != nil && !e.IsBefore() {
+				logger.Warningf("%s hook: %s", e.Type, err)
+				return nil
+			}
+			return err
+		})
+	}
+	return
+}
+
+// validateTemplate reports the validation errors and returns true when
+// a deny rule of the policy fired
+func validateTemplate(tpl *template.Template) bool {
+	unicityRule := &template.UniqueNameValidator{LookupGraph: lookupLocalGraphFunc}
+	rules := []template.Validator{unicityRule, &template.ParamIsSetValidator{Action: "create", Entity: "instance", Param: "key", WarningMessage: "This instance has no access key. You might not be able to connect to it. Use `awless create instance key=my-key ...`"}}
+
+	if path := config.GetTemplatePolicy(); path != "" {
+		content, err := ioutil.ReadFile(path)
+		exitOn(err)
+		policy, err := template.ParsePolicy(path, content)
+		exitOn(err)
+		rules = append(rules, &template.PolicyValidator{Policy: policy, LookupGraph: lookupLocalGraphFunc})
+	}
+
+	errs := tpl.Validate(rules...)
 
 	if len(errs) > 0 {
 		for _, err := range errs {
-			logger.Warning(err)
+			if v, ok := err.(*template.PolicyViolation); ok && v.Rule.Effect == template.PolicyDeny {
+				logger.Error(err)
+			} else {
+				logger.Warning(err)
+			}
 		}
 		fmt.Fprintln(os.Stderr)
 	}
+
+	return template.IsDenied(errs)
 }
 
 func createDriverCommands(action string, entities []string) *cobra.Command {
@@ -283,26 +630,32 @@ func createDriverCommands(action string, entities []string) *cobra.Command {
 		}
 		run := func(def template.Definition) func(cmd *cobra.Command, args []string) error {
 			return func(cmd *cobra.Command, args []string) error {
-				text := fmt.Sprintf("%s %s %s", def.Action, def.Entity, strings.Join(args, " "))
+				text := fmt.Sprintf("%s %s %s", def.Action, def.Entity, template.QuoteParamsArgs(args))
 
 				templ, err := template.Parse(text)
 				exitOn(err)
 
-				exitOn(runTemplate(templ, config.Defaults))
+				fillers := append([]map[string]interface{}{config.Defaults}, paramsFilesFillers()...)
+				exitOn(runTemplate(templ, fillers...))
 				return nil
 			}
 		}
 
-		actionCmd.AddCommand(
-			&cobra.Command{
-				Use:               templDef.Entity,
-				PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, initSyncerHook),
-				PersistentPostRun: applyHooks(saveHistoryHook, verifyNewVersionHook),
-				Short:             fmt.Sprintf("%s a %s", strings.Title(action), templDef.Entity),
-				Long:              fmt.Sprintf("%s a %s\n\tRequired params: %s\n\tExtra params: %s", strings.Title(templDef.Action), templDef.Entity, strings.Join(templDef.Required(), ", "), strings.Join(templDef.Extra(), ", ")),
-				RunE:              run(templDef),
-			},
-		)
+		entityCmd := &cobra.Command{
+			Use:               templDef.Entity,
+			PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, initSyncerHook),
+			PersistentPostRun: applyHooks(saveHistoryHook, verifyNewVersionHook),
+			Short:             fmt.Sprintf("%s a %s", strings.Title(action), templDef.Entity),
+			Long:              fmt.Sprintf("%s a %s\n\tRequired params: %s\n\tExtra params: %s", strings.Title(templDef.Action), templDef.Entity, strings.Join(templDef.Required(), ", "), strings.Join(templDef.Extra(), ", ")),
+			RunE:              run(templDef),
+		}
+		entityCmd.Flags().BoolVar(&rollbackOnFailureFlag, "rollback-on-failure", false, "Automatically revert what has been done when the command fails")
+		entityCmd.Flags().StringSliceVar(&paramsFilesFlag, "params-file", nil, "Fill holes from params files (YAML, JSON or key=value), applied in order")
+		entityCmd.Flags().BoolVar(&noPromptFlag, "no-prompt", false, "Fail listing unfilled holes instead of prompting for them")
+		entityCmd.Flags().DurationVar(&timeoutFlag, "timeout", 0, "Stop the command gracefully once the duration has expired (ex: 10m)")
+		entityCmd.Flags().StringSliceVar(&hooksFlag, "hook", nil, "Executables or HTTP endpoints receiving the run events as JSON, in addition to the 'template.hooks' config")
+
+		actionCmd.AddCommand(entityCmd)
 	}
 
 	return actionCmd
@@ -350,11 +703,16 @@ func printReport(t *template.Template) {
 		}
 	}
 
-	if template.IsRevertible(t) {
+	if template.IsRevertible(t, lookupDefinitionsFunc) {
 		logger.Infof("revert this template with `awless revert %s`", t.ID)
 	}
 }
 
+func lookupLocalGraphFunc(key string) (*graph.Graph, bool) {
+	g := sync.LoadCurrentLocalGraph(aws.ServicePerResourceType[key])
+	return g, true
+}
+
 func resolveAliasFunc(entity, key, alias string) string {
 	gph := sync.LoadCurrentLocalGraph(aws.ServicePerResourceType[entity])
 	resType := key
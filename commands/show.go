@@ -154,6 +154,13 @@ func showResource(resource *graph.Resource, gph *graph.Graph) {
 	exitOn(err)
 	printResourceList(renderCyanBoldFn("Depending on"), dependingOn)
 
+	// alarms live in the monitoring graph, apart from the resources they watch
+	if resource.Type() != cloud.Alarm {
+		alarms, err := sync.LoadCurrentLocalGraph(aws.ServicePerResourceType[cloud.Alarm]).ListResourcesDependingOn(resource)
+		exitOn(err)
+		printResourceList(renderCyanBoldFn("Alarms"), alarms)
+	}
+
 	var siblings []*graph.Resource
 	err = gph.Accept(&graph.SiblingsVisitor{From: resource, Each: graph.VisitorCollectFunc(&siblings)})
 	exitOn(err)
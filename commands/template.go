@@ -0,0 +1,171 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/template"
+)
+
+var (
+	fmtWriteFlag  bool
+	fmtListFlag   bool
+	lintJSONFlag  bool
+	convertToFlag string
+)
+
+func init() {
+	RootCmd.AddCommand(templateCmd)
+	templateCmd.AddCommand(templateFmtCmd)
+	templateCmd.AddCommand(templateLintCmd)
+	templateCmd.AddCommand(templateConvertCmd)
+
+	templateFmtCmd.Flags().BoolVarP(&fmtWriteFlag, "write", "w", false, "Write the formatted template to the file instead of stdout")
+	templateFmtCmd.Flags().BoolVar(&fmtListFlag, "list", false, "List the files whose formatting differs")
+	templateLintCmd.Flags().BoolVar(&lintJSONFlag, "json", false, "Output the issues as JSON")
+	templateConvertCmd.Flags().StringVar(&convertToFlag, "to", template.JSONFormat, fmt.Sprintf("Output format: %s, %s or %s", template.DSLFormat, template.JSONFormat, template.YAMLFormat))
+}
+
+var templateCmd = &cobra.Command{
+	Use:              "template",
+	Short:            "Format, lint and convert awless template files offline",
+	PersistentPreRun: applyHooks(initLoggerHook),
+}
+
+var templateFmtCmd = &cobra.Command{
+	Use:     "fmt FILE...",
+	Short:   "Format awless template files with canonical params ordering and spacing",
+	Example: "  awless template fmt infra.awls\n  awless template fmt -w infra.awls network.awls",
+
+	RunE: func(c *cobra.Command, args []string) error {
+		if len(args) == 0 {
+			return errors.New("missing template file(s)")
+		}
+
+		for _, path := range args {
+			content, err := ioutil.ReadFile(path)
+			exitOn(err)
+
+			formatted, err := template.Format(string(content))
+			if err != nil {
+				exitOn(fmt.Errorf("%s: %s", path, err))
+			}
+
+			if fmtListFlag && formatted != string(content) {
+				fmt.Println(path)
+			}
+			if fmtWriteFlag {
+				if formatted != string(content) {
+					exitOn(ioutil.WriteFile(path, []byte(formatted), 0644))
+				}
+			} else if !fmtListFlag {
+				fmt.Print(formatted)
+			}
+		}
+
+		return nil
+	},
+}
+
+type fileLintIssue struct {
+	File string `json:"file"`
+	*template.LintIssue
+}
+
+var templateLintCmd = &cobra.Command{
+	Use:     "lint FILE...",
+	Short:   "Report syntax errors, unknown commands and params, undefined or unused references and likely mistakes in awless template files",
+	Example: "  awless template lint infra.awls\n  awless template lint --json infra.awls network.awls",
+
+	RunE: func(c *cobra.Command, args []string) error {
+		if len(args) == 0 {
+			return errors.New("missing template file(s)")
+		}
+
+		all := []*fileLintIssue{}
+		var errorsCount int
+		for _, path := range args {
+			content, err := ioutil.ReadFile(path)
+			exitOn(err)
+
+			for _, issue := range template.Lint(string(content), lookupDefinitionsFunc) {
+				if issue.Severity == template.LintError {
+					errorsCount++
+				}
+				all = append(all, &fileLintIssue{File: path, LintIssue: issue})
+			}
+		}
+
+		if lintJSONFlag {
+			b, err := json.MarshalIndent(all, "", " ")
+			exitOn(err)
+			fmt.Println(string(b))
+		} else {
+			for _, issue := range all {
+				fmt.Printf("%s:%s\n", issue.File, issue.LintIssue)
+			}
+		}
+
+		if errorsCount > 0 {
+			os.Exit(1)
+		}
+		return nil
+	},
+}
+
+var templateConvertCmd = &cobra.Command{
+	Use:     "convert FILE",
+	Short:   "Convert a template file between the awless template syntax and its JSON or YAML representation",
+	Example: "  awless template convert infra.awls --to json\n  awless template convert infra.yml --to awless",
+
+	RunE: func(c *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			return errors.New("expecting one template file")
+		}
+
+		content, err := ioutil.ReadFile(args[0])
+		exitOn(err)
+
+		tpl, err := template.ParseFile(args[0], content)
+		exitOn(err)
+
+		var out []byte
+		switch convertToFlag {
+		case template.JSONFormat:
+			out, err = tpl.EncodeJSON()
+			out = append(out, '\n')
+		case template.YAMLFormat:
+			out, err = tpl.EncodeYAML()
+		case template.DSLFormat:
+			var formatted string
+			formatted, err = template.Format(tpl.String())
+			out = []byte(formatted)
+		default:
+			err = fmt.Errorf("unknown format '%s', expecting %s, %s or %s", convertToFlag, template.DSLFormat, template.JSONFormat, template.YAMLFormat)
+		}
+		exitOn(err)
+
+		fmt.Print(string(out))
+		return nil
+	},
+}
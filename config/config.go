@@ -24,6 +24,11 @@ const (
 	//Config
 	autosyncConfigKey              = "autosync"
 	checkUpgradeFrequencyConfigKey = "upgrade.checkfrequency"
+	templateConcurrencyConfigKey   = "template.concurrency"
+	templateRetryAttemptsKey       = "template.retry.attempts"
+	templateRetryDelayKey          = "template.retry.delay"
+	templateHooksKey               = "template.hooks"
+	templatePolicyKey              = "template.policy"
 	RegionConfigKey                = "aws.region"
 	ProfileConfigKey               = "aws.profile"
 
@@ -41,14 +46,21 @@ var configDefinitions = map[string]*Definition{
 	autosyncConfigKey:                {help: "Automatically synchronize your cloud locally", defaultValue: "true", parseParamFn: parseBool},
 	RegionConfigKey:                  {help: "AWS region", defaultValue: "us-east-1", parseParamFn: awsconfig.ParseRegion, stdinParamProviderFn: awsconfig.StdinRegionSelector, onUpdateFn: awsconfig.WarningChangeRegion},
 	ProfileConfigKey:                 {help: "AWS profile", defaultValue: "default"},
-	"aws.infra.sync":                 {help: "Sync AWS EC2/ELBv2 service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
+	"aws.infra.sync":                 {help: "Sync AWS EC2/ELBv2/RDS/AutoScaling service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
 	"aws.access.sync":                {help: "Sync AWS IAM service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
 	"aws.storage.sync":               {help: "Sync AWS S3 service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
 	"aws.storage.storageobject.sync": {help: "Sync AWS S3/storageobject (when empty: true)", defaultValue: "false", parseParamFn: parseBool},
 	"aws.notification.sync":          {help: "Sync AWS SNS service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
 	"aws.queue.sync":                 {help: "Sync AWS SQS service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
 	"aws.dns.sync":                   {help: "Sync Route53 service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
+	"aws.lambda.sync":                {help: "Sync AWS Lambda service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
+	"aws.monitoring.sync":            {help: "Sync AWS CloudWatch service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
 	checkUpgradeFrequencyConfigKey:   {help: "Upgrade check frequency (hours); a negative value disables check", defaultValue: "8", parseParamFn: parseInt},
+	templateConcurrencyConfigKey:     {help: "Maximum number of independent template statements run concurrently", defaultValue: "4", parseParamFn: parseInt},
+	templateRetryAttemptsKey:         {help: "Maximum number of attempts of a template statement failing with a retryable error (throttling, eventual consistency, conflict)", defaultValue: "3", parseParamFn: parseInt},
+	templateRetryDelayKey:            {help: "Base delay (milliseconds) between retries of a template statement, doubled on each attempt", defaultValue: "1000", parseParamFn: parseInt},
+	templateHooksKey:                 {help: "Comma separated executables or HTTP endpoints receiving the template run events as JSON. A failing hook on before-run or before-statement events vetoes the run"},
+	templatePolicyKey:                {help: "Path of the policy file (YAML or JSON) of deny and warn rules evaluated before running templates"},
 }
 
 var defaultsDefinitions = map[string]*Definition{
@@ -46,6 +46,44 @@ func GetConfigWithPrefix(prefix string) map[string]interface{} {
 	return conf
 }
 
+func GetTemplateConcurrency() int {
+	if concurrency, ok := Config[templateConcurrencyConfigKey].(int); ok && concurrency > 0 {
+		return concurrency
+	}
+	return 4
+}
+
+func GetTemplateRetryAttempts() int {
+	if attempts, ok := Config[templateRetryAttemptsKey].(int); ok && attempts > 0 {
+		return attempts
+	}
+	return 3
+}
+
+func GetTemplateRetryDelay() time.Duration {
+	if delay, ok := Config[templateRetryDelayKey].(int); ok && delay >= 0 {
+		return time.Duration(delay) * time.Millisecond
+	}
+	return time.Second
+}
+
+func GetTemplateHooks() (hooks []string) {
+	value, _ := Config[templateHooksKey].(string)
+	for _, hook := range strings.Split(value, ",") {
+		if hook = strings.TrimSpace(hook); hook != "" {
+			hooks = append(hooks, hook)
+		}
+	}
+	return
+}
+
+func GetTemplatePolicy() string {
+	if path, ok := Config[templatePolicyKey].(string); ok {
+		return strings.TrimSpace(path)
+	}
+	return ""
+}
+
 func getCheckUpgradeFrequency() time.Duration {
 	if frequency, ok := Config[checkUpgradeFrequencyConfigKey].(int); ok {
 		return time.Duration(frequency) * time.Hour
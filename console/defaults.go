@@ -99,12 +99,63 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created, Friendly: "Created"}},
 		StringColumnDefinition{Prop: properties.AvailabilityZone, Friendly: "Zone"},
 	},
+	cloud.Snapshot: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Name, DisableTruncate: true},
+		StringColumnDefinition{Prop: properties.Volume},
+		StringColumnDefinition{Prop: properties.State},
+		StringColumnDefinition{Prop: properties.Size, Friendly: "Size (Gb)"},
+		StringColumnDefinition{Prop: properties.Encrypted},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created, Friendly: "Created"}},
+		StringColumnDefinition{Prop: properties.Description, TruncateRight: true},
+	},
+	cloud.Image: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Name, DisableTruncate: true},
+		StringColumnDefinition{Prop: properties.State},
+		StringColumnDefinition{Prop: properties.Architecture, Friendly: "Arch"},
+		StringColumnDefinition{Prop: properties.RootDeviceType, Friendly: "RootDevice"},
+		StringColumnDefinition{Prop: properties.Public},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created, Friendly: "Created"}},
+	},
 	cloud.AvailabilityZone: {
 		StringColumnDefinition{Prop: properties.Name},
 		StringColumnDefinition{Prop: properties.State},
 		StringColumnDefinition{Prop: properties.Region},
 		StringColumnDefinition{Prop: properties.Messages},
 	},
+	cloud.ElasticIP: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.PublicIP, Friendly: "Public IP"},
+		StringColumnDefinition{Prop: properties.PrivateIP, Friendly: "Private IP"},
+		StringColumnDefinition{Prop: properties.Instance},
+		StringColumnDefinition{Prop: properties.NetworkInterface, Friendly: "Interface"},
+		StringColumnDefinition{Prop: properties.Domain},
+	},
+	cloud.NatGateway: {
+		StringColumnDefinition{Prop: properties.ID},
+		ColoredValueColumnDefinition{
+			StringColumnDefinition: StringColumnDefinition{Prop: properties.State},
+			ColoredValues:          map[string]color.Attribute{"available": color.FgGreen, "failed": color.FgRed}},
+		StringColumnDefinition{Prop: properties.PublicIP, Friendly: "Public IP"},
+		StringColumnDefinition{Prop: properties.PrivateIP, Friendly: "Private IP"},
+		StringColumnDefinition{Prop: properties.Subnet},
+		StringColumnDefinition{Prop: properties.Vpc},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
+	},
+	cloud.NetworkInterface: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Name, DisableTruncate: true},
+		StringColumnDefinition{Prop: properties.Type},
+		ColoredValueColumnDefinition{
+			StringColumnDefinition: StringColumnDefinition{Prop: properties.State},
+			ColoredValues:          map[string]color.Attribute{"in-use": color.FgGreen, "available": color.FgYellow}},
+		StringColumnDefinition{Prop: properties.Instance},
+		StringColumnDefinition{Prop: properties.PrivateIP, Friendly: "Private IP"},
+		StringColumnDefinition{Prop: properties.PublicIP, Friendly: "Public IP"},
+		StringColumnDefinition{Prop: properties.Subnet},
+		StringColumnDefinition{Prop: properties.Description, DisableTruncate: true},
+	},
 	// Loadbalancer
 	cloud.LoadBalancer: {
 		StringColumnDefinition{Prop: properties.Name},
@@ -133,6 +184,33 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		StringColumnDefinition{Prop: properties.Protocol},
 		StringColumnDefinition{Prop: properties.CipherSuite},
 	},
+	// Autoscaling
+	cloud.LaunchConfiguration: {
+		StringColumnDefinition{Prop: properties.Name, DisableTruncate: true},
+		StringColumnDefinition{Prop: properties.Image},
+		StringColumnDefinition{Prop: properties.Type},
+		StringColumnDefinition{Prop: properties.SSHKey, Friendly: "Access Key"},
+		StringColumnDefinition{Prop: properties.SecurityGroups},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
+	},
+	cloud.ScalingGroup: {
+		StringColumnDefinition{Prop: properties.Name, DisableTruncate: true},
+		StringColumnDefinition{Prop: properties.LaunchConfigurationName, Friendly: "LaunchConfiguration"},
+		StringColumnDefinition{Prop: properties.DesiredCapacity, Friendly: "Desired"},
+		StringColumnDefinition{Prop: properties.MinSize, Friendly: "Min"},
+		StringColumnDefinition{Prop: properties.MaxSize, Friendly: "Max"},
+		StringColumnDefinition{Prop: properties.HealthCheckType, Friendly: "HealthCheck"},
+		StringColumnDefinition{Prop: properties.State, Friendly: "Status"},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
+	},
+	cloud.ScalingPolicy: {
+		StringColumnDefinition{Prop: properties.Name, DisableTruncate: true},
+		StringColumnDefinition{Prop: properties.ScalingGroupName, Friendly: "ScalingGroup"},
+		StringColumnDefinition{Prop: properties.Type},
+		StringColumnDefinition{Prop: properties.AdjustmentType},
+		StringColumnDefinition{Prop: properties.ScalingAdjustment, Friendly: "Adjustment"},
+		StringColumnDefinition{Prop: properties.Cooldown},
+	},
 	// Database
 	cloud.Database: {
 		StringColumnDefinition{Prop: properties.ID, DisableTruncate: true},
@@ -231,4 +309,30 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		SliceColumnDefinition{StringColumnDefinition{Prop: properties.Records}},
 		StringColumnDefinition{Prop: properties.TTL},
 	},
+	// Lambda
+	cloud.Function: {
+		StringColumnDefinition{Prop: properties.ID, TruncateRight: true},
+		StringColumnDefinition{Prop: properties.Name, DisableTruncate: true},
+		StringColumnDefinition{Prop: properties.Runtime},
+		StringColumnDefinition{Prop: properties.Memory, Friendly: "Memory(MB)"},
+		StringColumnDefinition{Prop: properties.Timeout, Friendly: "Timeout(s)"},
+		StringColumnDefinition{Prop: properties.CodeSize, Friendly: "CodeSize(B)"},
+		StringColumnDefinition{Prop: properties.Role, TruncateRight: true},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Modified, Friendly: "Modified"}},
+	},
+	// Monitoring
+	cloud.Alarm: {
+		StringColumnDefinition{Prop: properties.Name, DisableTruncate: true},
+		ColoredValueColumnDefinition{
+			StringColumnDefinition: StringColumnDefinition{Prop: properties.State},
+			ColoredValues:          map[string]color.Attribute{"OK": color.FgGreen, "ALARM": color.FgRed},
+		},
+		StringColumnDefinition{Prop: properties.Namespace},
+		StringColumnDefinition{Prop: properties.Metric},
+		StringColumnDefinition{Prop: properties.Statistic},
+		StringColumnDefinition{Prop: properties.Threshold},
+		StringColumnDefinition{Prop: properties.Dimensions},
+		StringColumnDefinition{Prop: properties.ActionsEnabled, Friendly: "Enabled"},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Updated, Friendly: "Updated"}},
+	},
 }
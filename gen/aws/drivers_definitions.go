@@ -20,6 +20,7 @@ import (
 	"sort"
 
 	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
 )
 
 type param struct {
@@ -33,8 +34,60 @@ type driver struct {
 	ExtraParams                               []param
 	Action, Entity                            string
 	Input, Output, ApiMethod, OutputExtractor string
-	DryRunUnsupported                         bool
-	ManualFuncDefinition                      bool
+	// OutputProperties extractors, per property name, of the outputs
+	// that can be referenced in templates (ex: $inst.PrivateIP)
+	OutputProperties map[string]string
+	// SensitiveOutputs are the output properties (ex: secrets) that can be
+	// referenced but are never stored with the template, printed or sent to hooks
+	SensitiveOutputs     []string
+	DryRunUnsupported    bool
+	ManualFuncDefinition bool
+	// Revert rule of the driver, nil when its commands cannot be reverted
+	Revert *revert
+	// Planned properties of the resource once the command has run (ex: State)
+	Planned map[string]interface{}
+	// DeprecatedParams maps the template names of params still accepted,
+	// and declared in the params, to the params superseding them
+	DeprecatedParams map[string]string
+}
+
+// revert declares the inverse command reverting a driver command, see
+// the template.RevertRule fields for the param mapping and the wait step
+type revert struct {
+	Action       string
+	ResultParam  string
+	Params       map[string]string
+	Constants    map[string]string
+	Inverted     map[string]map[string]string
+	Previous     map[string]string
+	CheckState   string
+	CheckTimeout int
+}
+
+// PublicOutputs returns the output properties extractors, sensitive ones excepted
+func (d *driver) PublicOutputs() map[string]string {
+	return d.outputs(false)
+}
+
+// SensitiveOutputProperties returns the extractors of the sensitive output properties
+func (d *driver) SensitiveOutputProperties() map[string]string {
+	return d.outputs(true)
+}
+
+func (d *driver) outputs(sensitive bool) map[string]string {
+	outputs := make(map[string]string)
+	for name, extractor := range d.OutputProperties {
+		var isSensitive bool
+		for _, s := range d.SensitiveOutputs {
+			if s == name {
+				isSensitive = true
+			}
+		}
+		if isSensitive == sensitive {
+			outputs[name] = extractor
+		}
+	}
+	return outputs
 }
 
 func (d *driver) RequiredKeys() []string {
@@ -81,7 +134,8 @@ var DriversDefs = []driversDef{
 		Drivers: []driver{
 			// VPC
 			{
-				Action: "create", Entity: cloud.Vpc, Input: "CreateVpcInput", Output: "CreateVpcOutput", ApiMethod: "CreateVpc", OutputExtractor: "aws.StringValue(output.Vpc.VpcId)",
+				Action: "create", Entity: cloud.Vpc, Input: "CreateVpcInput", Output: "CreateVpcOutput", ApiMethod: "CreateVpc", OutputExtractor: "aws.StringValue(output.Vpc.VpcId)", OutputProperties: map[string]string{"CidrBlock": "aws.StringValue(output.Vpc.CidrBlock)"},
+				Revert: &revert{Action: "delete", ResultParam: "id"},
 				RequiredParams: []param{
 					{AwsField: "CidrBlock", TemplateName: "cidr", AwsType: "awsstr"},
 				},
@@ -98,7 +152,8 @@ var DriversDefs = []driversDef{
 
 			// SUBNET
 			{
-				Action: "create", Entity: cloud.Subnet, Input: "CreateSubnetInput", Output: "CreateSubnetOutput", ApiMethod: "CreateSubnet", OutputExtractor: "aws.StringValue(output.Subnet.SubnetId)",
+				Action: "create", Entity: cloud.Subnet, Input: "CreateSubnetInput", Output: "CreateSubnetOutput", ApiMethod: "CreateSubnet", OutputExtractor: "aws.StringValue(output.Subnet.SubnetId)", OutputProperties: map[string]string{"AvailabilityZone": "aws.StringValue(output.Subnet.AvailabilityZone)", "CidrBlock": "aws.StringValue(output.Subnet.CidrBlock)"},
+				Revert: &revert{Action: "delete", ResultParam: "id"},
 				RequiredParams: []param{
 					{AwsField: "CidrBlock", TemplateName: "cidr", AwsType: "awsstr"},
 					{AwsField: "VpcId", TemplateName: "vpc", AwsType: "awsstr"},
@@ -110,6 +165,7 @@ var DriversDefs = []driversDef{
 			},
 			{
 				Action: "update", Entity: cloud.Subnet, Input: "ModifySubnetAttributeInput", Output: "ModifySubnetAttributeOutput", ApiMethod: "ModifySubnetAttribute", DryRunUnsupported: true,
+				Revert: &revert{Action: "update", Params: map[string]string{"id": "id"}, Previous: map[string]string{"public": properties.Public}},
 				RequiredParams: []param{
 					{AwsField: "SubnetId", TemplateName: "id", AwsType: "awsstr"},
 				},
@@ -126,7 +182,8 @@ var DriversDefs = []driversDef{
 
 			// INSTANCES
 			{
-				Action: "create", Entity: cloud.Instance, Input: "RunInstancesInput", Output: "Reservation", ApiMethod: "RunInstances", OutputExtractor: "aws.StringValue(output.Instances[0].InstanceId)",
+				Action: "create", Entity: cloud.Instance, Input: "RunInstancesInput", Output: "Reservation", ApiMethod: "RunInstances", OutputExtractor: "aws.StringValue(output.Instances[0].InstanceId)", OutputProperties: map[string]string{"PrivateIP": "aws.StringValue(output.Instances[0].PrivateIpAddress)"},
+				Revert: &revert{Action: "delete", ResultParam: "id", CheckState: "terminated", CheckTimeout: 180},
 				RequiredParams: []param{
 					{AwsField: "ImageId", TemplateName: "image", AwsType: "awsstr"},
 					{AwsField: "MaxCount", TemplateName: "count", AwsType: "awsint64"},
@@ -145,6 +202,7 @@ var DriversDefs = []driversDef{
 			},
 			{
 				Action: "update", Entity: cloud.Instance, Input: "ModifyInstanceAttributeInput", Output: "ModifyInstanceAttributeOutput", ApiMethod: "ModifyInstanceAttribute",
+				Revert: &revert{Action: "update", Params: map[string]string{"id": "id"}, Previous: map[string]string{"type": properties.Type, "group": properties.SecurityGroups}},
 				RequiredParams: []param{
 					{AwsField: "InstanceId", TemplateName: "id", AwsType: "awsstr"},
 				},
@@ -162,27 +220,24 @@ var DriversDefs = []driversDef{
 			},
 			{
 				Action: "start", Entity: cloud.Instance, Input: "StartInstancesInput", Output: "StartInstancesOutput", ApiMethod: "StartInstances", OutputExtractor: "aws.StringValue(output.StartingInstances[0].InstanceId)",
+				Revert:  &revert{Action: "stop", ResultParam: "id"},
+				Planned: map[string]interface{}{"State": "running"},
 				RequiredParams: []param{
 					{AwsField: "InstanceIds", TemplateName: "id", AwsType: "awsstringslice"},
 				},
 			},
 			{
 				Action: "stop", Entity: cloud.Instance, Input: "StopInstancesInput", Output: "StopInstancesOutput", ApiMethod: "StopInstances", OutputExtractor: "aws.StringValue(output.StoppingInstances[0].InstanceId)",
+				Revert:  &revert{Action: "start", ResultParam: "id"},
+				Planned: map[string]interface{}{"State": "stopped"},
 				RequiredParams: []param{
 					{AwsField: "InstanceIds", TemplateName: "id", AwsType: "awsstringslice"},
 				},
 			},
-			{
-				Action: "check", Entity: cloud.Instance, ManualFuncDefinition: true,
-				RequiredParams: []param{
-					{TemplateName: "id"},
-					{TemplateName: "state"},
-					{TemplateName: "timeout"},
-				},
-			},
 			// Security Group
 			{
 				Action: "create", Entity: cloud.SecurityGroup, Input: "CreateSecurityGroupInput", Output: "CreateSecurityGroupOutput", ApiMethod: "CreateSecurityGroup", OutputExtractor: "aws.StringValue(output.GroupId)",
+				Revert: &revert{Action: "delete", ResultParam: "id"},
 				RequiredParams: []param{
 					{AwsField: "GroupName", TemplateName: "name", AwsType: "awsstr"},
 					{AwsField: "VpcId", TemplateName: "vpc", AwsType: "awsstr"},
@@ -191,6 +246,7 @@ var DriversDefs = []driversDef{
 			},
 			{
 				Action: "update", Entity: cloud.SecurityGroup, ManualFuncDefinition: true,
+				Revert: &revert{Action: "update", Params: map[string]string{"id": "id", "cidr": "cidr", "protocol": "protocol", "portrange": "portrange"}, Inverted: map[string]map[string]string{"inbound": map[string]string{"authorize": "revoke", "revoke": "authorize"}, "outbound": map[string]string{"authorize": "revoke", "revoke": "authorize"}}},
 				RequiredParams: []param{
 					{TemplateName: "id"},
 					{TemplateName: "cidr"},
@@ -211,7 +267,8 @@ var DriversDefs = []driversDef{
 
 			// VOLUME
 			{
-				Action: "create", Entity: cloud.Volume, Input: "CreateVolumeInput", Output: "Volume", ApiMethod: "CreateVolume", OutputExtractor: "aws.StringValue(output.VolumeId)",
+				Action: "create", Entity: cloud.Volume, Input: "CreateVolumeInput", Output: "Volume", ApiMethod: "CreateVolume", OutputExtractor: "aws.StringValue(output.VolumeId)", OutputProperties: map[string]string{"AvailabilityZone": "aws.StringValue(output.AvailabilityZone)"},
+				Revert: &revert{Action: "delete", ResultParam: "id"},
 				RequiredParams: []param{
 					{AwsField: "AvailabilityZone", TemplateName: "zone", AwsType: "awsstr"},
 					{AwsField: "Size", TemplateName: "size", AwsType: "awsint64"},
@@ -225,6 +282,7 @@ var DriversDefs = []driversDef{
 			},
 			{
 				Action: "attach", Entity: cloud.Volume, Input: "AttachVolumeInput", Output: "VolumeAttachment", ApiMethod: "AttachVolume", OutputExtractor: "aws.StringValue(output.VolumeId)",
+				Revert: &revert{Action: "detach", Params: map[string]string{"device": "device", "id": "id", "instance": "instance"}},
 				RequiredParams: []param{
 					{AwsField: "Device", TemplateName: "device", AwsType: "awsstr"},
 					{AwsField: "VolumeId", TemplateName: "id", AwsType: "awsstr"},
@@ -233,6 +291,7 @@ var DriversDefs = []driversDef{
 			},
 			{
 				Action: "detach", Entity: cloud.Volume, Input: "DetachVolumeInput", Output: "VolumeAttachment", ApiMethod: "DetachVolume", OutputExtractor: "aws.StringValue(output.VolumeId)",
+				Revert: &revert{Action: "attach", Params: map[string]string{"device": "device", "id": "id", "instance": "instance"}},
 				RequiredParams: []param{
 					{AwsField: "Device", TemplateName: "device", AwsType: "awsstr"},
 					{AwsField: "VolumeId", TemplateName: "id", AwsType: "awsstr"},
@@ -242,9 +301,59 @@ var DriversDefs = []driversDef{
 					{AwsField: "Force", TemplateName: "force", AwsType: "awsbool"},
 				},
 			},
+			// SNAPSHOT
+			{
+				Action: "create", Entity: cloud.Snapshot, Input: "CreateSnapshotInput", Output: "Snapshot", ApiMethod: "CreateSnapshot", OutputExtractor: "aws.StringValue(output.SnapshotId)",
+				Revert: &revert{Action: "delete", ResultParam: "id"},
+				RequiredParams: []param{
+					{AwsField: "VolumeId", TemplateName: "volume", AwsType: "awsstr"},
+				},
+				ExtraParams: []param{
+					{AwsField: "Description", TemplateName: "description", AwsType: "awsstr"},
+				},
+			},
+			{
+				Action: "delete", Entity: cloud.Snapshot, Input: "DeleteSnapshotInput", Output: "DeleteSnapshotOutput", ApiMethod: "DeleteSnapshot",
+				RequiredParams: []param{
+					{AwsField: "SnapshotId", TemplateName: "id", AwsType: "awsstr"},
+				},
+			},
+			// IMAGE
+			{
+				Action: "create", Entity: cloud.Image, Input: "CreateImageInput", Output: "CreateImageOutput", ApiMethod: "CreateImage", OutputExtractor: "aws.StringValue(output.ImageId)",
+				Revert: &revert{Action: "delete", ResultParam: "id"},
+				RequiredParams: []param{
+					{AwsField: "InstanceId", TemplateName: "instance", AwsType: "awsstr"},
+					{AwsField: "Name", TemplateName: "name", AwsType: "awsstr"},
+				},
+				ExtraParams: []param{
+					{AwsField: "Description", TemplateName: "description", AwsType: "awsstr"},
+					{AwsField: "NoReboot", TemplateName: "noreboot", AwsType: "awsbool"},
+				},
+			},
+			{
+				Action: "copy", Entity: cloud.Image, Input: "CopyImageInput", Output: "CopyImageOutput", ApiMethod: "CopyImage", OutputExtractor: "aws.StringValue(output.ImageId)",
+				Revert: &revert{Action: "delete", ResultParam: "id"},
+				RequiredParams: []param{
+					{AwsField: "SourceImageId", TemplateName: "id", AwsType: "awsstr"},
+					{AwsField: "SourceRegion", TemplateName: "region", AwsType: "awsstr"}, // copying into the current region
+					{AwsField: "Name", TemplateName: "name", AwsType: "awsstr"},
+				},
+				ExtraParams: []param{
+					{AwsField: "Description", TemplateName: "description", AwsType: "awsstr"},
+					{AwsField: "Encrypted", TemplateName: "encrypted", AwsType: "awsbool"},
+				},
+			},
+			{
+				Action: "delete", Entity: cloud.Image, ManualFuncDefinition: true,
+				RequiredParams: []param{
+					{TemplateName: "id"},
+				},
+			},
 			// INTERNET GATEWAYS
 			{
 				Action: "create", Entity: cloud.InternetGateway, Input: "CreateInternetGatewayInput", Output: "CreateInternetGatewayOutput", ApiMethod: "CreateInternetGateway", OutputExtractor: "aws.StringValue(output.InternetGateway.InternetGatewayId)",
+				Revert: &revert{Action: "delete", ResultParam: "id"},
 			},
 			{
 				Action: "delete", Entity: cloud.InternetGateway, Input: "DeleteInternetGatewayInput", Output: "DeleteInternetGatewayOutput", ApiMethod: "DeleteInternetGateway",
@@ -254,6 +363,7 @@ var DriversDefs = []driversDef{
 			},
 			{
 				Action: "attach", Entity: cloud.InternetGateway, Input: "AttachInternetGatewayInput", Output: "AttachInternetGatewayOutput", ApiMethod: "AttachInternetGateway",
+				Revert: &revert{Action: "detach", Params: map[string]string{"id": "id", "vpc": "vpc"}},
 				RequiredParams: []param{
 					{AwsField: "InternetGatewayId", TemplateName: "id", AwsType: "awsstr"},
 					{AwsField: "VpcId", TemplateName: "vpc", AwsType: "awsstr"},
@@ -261,6 +371,7 @@ var DriversDefs = []driversDef{
 			},
 			{
 				Action: "detach", Entity: cloud.InternetGateway, Input: "DetachInternetGatewayInput", Output: "DetachInternetGatewayOutput", ApiMethod: "DetachInternetGateway",
+				Revert: &revert{Action: "attach", Params: map[string]string{"id": "id", "vpc": "vpc"}},
 				RequiredParams: []param{
 					{AwsField: "InternetGatewayId", TemplateName: "id", AwsType: "awsstr"},
 					{AwsField: "VpcId", TemplateName: "vpc", AwsType: "awsstr"},
@@ -269,6 +380,7 @@ var DriversDefs = []driversDef{
 			// ROUTE TABLES
 			{
 				Action: "create", Entity: cloud.RouteTable, Input: "CreateRouteTableInput", Output: "CreateRouteTableOutput", ApiMethod: "CreateRouteTable", OutputExtractor: "aws.StringValue(output.RouteTable.RouteTableId)",
+				Revert: &revert{Action: "delete", ResultParam: "id"},
 				RequiredParams: []param{
 					{AwsField: "VpcId", TemplateName: "vpc", AwsType: "awsstr"}},
 			},
@@ -280,6 +392,7 @@ var DriversDefs = []driversDef{
 			},
 			{
 				Action: "attach", Entity: cloud.RouteTable, Input: "AssociateRouteTableInput", Output: "AssociateRouteTableOutput", ApiMethod: "AssociateRouteTable", OutputExtractor: "aws.StringValue(output.AssociationId)",
+				Revert: &revert{Action: "detach", ResultParam: "association"},
 				RequiredParams: []param{
 					{AwsField: "RouteTableId", TemplateName: "id", AwsType: "awsstr"},
 					{AwsField: "SubnetId", TemplateName: "subnet", AwsType: "awsstr"},
@@ -294,6 +407,7 @@ var DriversDefs = []driversDef{
 			// ROUTES
 			{
 				Action: "create", Entity: "route", Input: "CreateRouteInput", Output: "CreateRouteOutput", ApiMethod: "CreateRoute",
+				Revert: &revert{Action: "delete", Params: map[string]string{"cidr": "cidr", "table": "table"}},
 				RequiredParams: []param{
 					{AwsField: "RouteTableId", TemplateName: "table", AwsType: "awsstr"},
 					{AwsField: "DestinationCidrBlock", TemplateName: "cidr", AwsType: "awsstr"},
@@ -307,9 +421,95 @@ var DriversDefs = []driversDef{
 					{AwsField: "DestinationCidrBlock", TemplateName: "cidr", AwsType: "awsstr"},
 				},
 			},
+			// ELASTIC IP
+			{
+				Action: "create", Entity: cloud.ElasticIP, Input: "AllocateAddressInput", Output: "AllocateAddressOutput", ApiMethod: "AllocateAddress", OutputExtractor: "aws.StringValue(output.AllocationId)", OutputProperties: map[string]string{"PublicIP": "aws.StringValue(output.PublicIp)"},
+				Revert: &revert{Action: "delete", ResultParam: "id"},
+				RequiredParams: []param{
+					{AwsField: "Domain", TemplateName: "domain", AwsType: "awsstr"}, // vpc | standard
+				},
+			},
+			{
+				Action: "delete", Entity: cloud.ElasticIP, Input: "ReleaseAddressInput", Output: "ReleaseAddressOutput", ApiMethod: "ReleaseAddress",
+				RequiredParams: []param{
+					{AwsField: "AllocationId", TemplateName: "id", AwsType: "awsstr"},
+				},
+			},
+			{
+				Action: "attach", Entity: cloud.ElasticIP, Input: "AssociateAddressInput", Output: "AssociateAddressOutput", ApiMethod: "AssociateAddress", OutputExtractor: "aws.StringValue(output.AssociationId)",
+				Revert: &revert{Action: "detach", ResultParam: "association"},
+				RequiredParams: []param{
+					{AwsField: "AllocationId", TemplateName: "id", AwsType: "awsstr"},
+				},
+				ExtraParams: []param{
+					{AwsField: "InstanceId", TemplateName: "instance", AwsType: "awsstr"},
+					{AwsField: "NetworkInterfaceId", TemplateName: "networkinterface", AwsType: "awsstr"},
+					{AwsField: "PrivateIpAddress", TemplateName: "privateip", AwsType: "awsstr"},
+					{AwsField: "AllowReassociation", TemplateName: "allowreassociation", AwsType: "awsbool"},
+				},
+			},
+			{
+				Action: "detach", Entity: cloud.ElasticIP, Input: "DisassociateAddressInput", Output: "DisassociateAddressOutput", ApiMethod: "DisassociateAddress",
+				RequiredParams: []param{
+					{AwsField: "AssociationId", TemplateName: "association", AwsType: "awsstr"},
+				},
+			},
+			// NAT GATEWAY
+			{
+				Action: "create", Entity: cloud.NatGateway, Input: "CreateNatGatewayInput", Output: "CreateNatGatewayOutput", ApiMethod: "CreateNatGateway", OutputExtractor: "aws.StringValue(output.NatGateway.NatGatewayId)", DryRunUnsupported: true,
+				Revert: &revert{Action: "delete", ResultParam: "id", CheckState: "deleted", CheckTimeout: 300},
+				RequiredParams: []param{
+					{AwsField: "AllocationId", TemplateName: "elasticip", AwsType: "awsstr"},
+					{AwsField: "SubnetId", TemplateName: "subnet", AwsType: "awsstr"},
+				},
+			},
+			{
+				Action: "delete", Entity: cloud.NatGateway, Input: "DeleteNatGatewayInput", Output: "DeleteNatGatewayOutput", ApiMethod: "DeleteNatGateway", DryRunUnsupported: true,
+				RequiredParams: []param{
+					{AwsField: "NatGatewayId", TemplateName: "id", AwsType: "awsstr"},
+				},
+			},
+			// NETWORK INTERFACE
+			{
+				Action: "create", Entity: cloud.NetworkInterface, Input: "CreateNetworkInterfaceInput", Output: "CreateNetworkInterfaceOutput", ApiMethod: "CreateNetworkInterface", OutputExtractor: "aws.StringValue(output.NetworkInterface.NetworkInterfaceId)", OutputProperties: map[string]string{"PrivateIP": "aws.StringValue(output.NetworkInterface.PrivateIpAddress)"},
+				Revert: &revert{Action: "delete", ResultParam: "id"},
+				RequiredParams: []param{
+					{AwsField: "SubnetId", TemplateName: "subnet", AwsType: "awsstr"},
+				},
+				ExtraParams: []param{
+					{AwsField: "Description", TemplateName: "description", AwsType: "awsstr"},
+					{AwsField: "Groups", TemplateName: "group", AwsType: "awsstringslice"},
+					{AwsField: "PrivateIpAddress", TemplateName: "privateip", AwsType: "awsstr"},
+				},
+			},
+			{
+				Action: "delete", Entity: cloud.NetworkInterface, Input: "DeleteNetworkInterfaceInput", Output: "DeleteNetworkInterfaceOutput", ApiMethod: "DeleteNetworkInterface",
+				RequiredParams: []param{
+					{AwsField: "NetworkInterfaceId", TemplateName: "id", AwsType: "awsstr"},
+				},
+			},
+			{
+				Action: "attach", Entity: cloud.NetworkInterface, Input: "AttachNetworkInterfaceInput", Output: "AttachNetworkInterfaceOutput", ApiMethod: "AttachNetworkInterface", OutputExtractor: "aws.StringValue(output.AttachmentId)",
+				Revert: &revert{Action: "detach", ResultParam: "attachment"},
+				RequiredParams: []param{
+					{AwsField: "NetworkInterfaceId", TemplateName: "id", AwsType: "awsstr"},
+					{AwsField: "InstanceId", TemplateName: "instance", AwsType: "awsstr"},
+					{AwsField: "DeviceIndex", TemplateName: "deviceindex", AwsType: "awsint64"},
+				},
+			},
+			{
+				Action: "detach", Entity: cloud.NetworkInterface, Input: "DetachNetworkInterfaceInput", Output: "DetachNetworkInterfaceOutput", ApiMethod: "DetachNetworkInterface",
+				RequiredParams: []param{
+					{AwsField: "AttachmentId", TemplateName: "attachment", AwsType: "awsstr"},
+				},
+				ExtraParams: []param{
+					{AwsField: "Force", TemplateName: "force", AwsType: "awsbool"},
+				},
+			},
 			// TAG
 			{
 				Action: "create", Entity: "tag", ManualFuncDefinition: true,
+				Revert: &revert{Action: "delete", Params: map[string]string{"key": "key", "resource": "resource", "value": "value"}},
 				RequiredParams: []param{
 					{TemplateName: "resource"},
 					{TemplateName: "key"},
@@ -318,6 +518,7 @@ var DriversDefs = []driversDef{
 			},
 			{
 				Action: "delete", Entity: "tag", ManualFuncDefinition: true,
+				Revert: &revert{Action: "create", Params: map[string]string{"key": "key", "resource": "resource", "value": "value"}},
 				RequiredParams: []param{
 					{TemplateName: "resource"},
 					{TemplateName: "key"},
@@ -328,6 +529,7 @@ var DriversDefs = []driversDef{
 			// Keypair
 			{
 				Action: "create", Entity: cloud.Keypair, ManualFuncDefinition: true,
+				Revert: &revert{Action: "delete", ResultParam: "id"},
 				RequiredParams: []param{
 					{TemplateName: "name"},
 				},
@@ -345,7 +547,8 @@ var DriversDefs = []driversDef{
 		Drivers: []driver{
 			// LoadBalancer
 			{
-				Action: "create", Entity: cloud.LoadBalancer, Input: "CreateLoadBalancerInput", Output: "CreateLoadBalancerOutput", ApiMethod: "CreateLoadBalancer", DryRunUnsupported: true, OutputExtractor: "aws.StringValue(output.LoadBalancers[0].LoadBalancerArn)",
+				Action: "create", Entity: cloud.LoadBalancer, Input: "CreateLoadBalancerInput", Output: "CreateLoadBalancerOutput", ApiMethod: "CreateLoadBalancer", DryRunUnsupported: true, OutputExtractor: "aws.StringValue(output.LoadBalancers[0].LoadBalancerArn)", OutputProperties: map[string]string{"CanonicalHostedZoneID": "aws.StringValue(output.LoadBalancers[0].CanonicalHostedZoneId)", "DNSName": "aws.StringValue(output.LoadBalancers[0].DNSName)"},
+				Revert: &revert{Action: "delete", ResultParam: "id", CheckState: "not-found", CheckTimeout: 300},
 				RequiredParams: []param{
 					{AwsField: "Name", TemplateName: "name", AwsType: "awsstr"},
 					{AwsField: "Subnets", TemplateName: "subnets", AwsType: "awsstringslice"},
@@ -365,6 +568,7 @@ var DriversDefs = []driversDef{
 			// Listener
 			{
 				Action: "create", Entity: cloud.Listener, Input: "CreateListenerInput", Output: "CreateListenerOutput", ApiMethod: "CreateListener", DryRunUnsupported: true, OutputExtractor: "aws.StringValue(output.Listeners[0].ListenerArn)",
+				Revert: &revert{Action: "delete", ResultParam: "id"},
 				RequiredParams: []param{
 					{AwsField: "DefaultActions[0]Type", TemplateName: "actiontype", AwsType: "awsslicestruct"}, //always forward
 					{AwsField: "DefaultActions[0]TargetGroupArn", TemplateName: "target", AwsType: "awsslicestruct"},
@@ -386,6 +590,7 @@ var DriversDefs = []driversDef{
 			// Target group
 			{
 				Action: "create", Entity: cloud.TargetGroup, Input: "CreateTargetGroupInput", Output: "CreateTargetGroupOutput", ApiMethod: "CreateTargetGroup", DryRunUnsupported: true, OutputExtractor: "aws.StringValue(output.TargetGroups[0].TargetGroupArn)",
+				Revert: &revert{Action: "delete", ResultParam: "id"},
 				RequiredParams: []param{
 					{AwsField: "Name", TemplateName: "name", AwsType: "awsstr"},
 					{AwsField: "Port", TemplateName: "port", AwsType: "awsint64"},
@@ -411,6 +616,7 @@ var DriversDefs = []driversDef{
 			},
 			{
 				Action: "attach", Entity: cloud.Instance, ApiMethod: "RegisterTargets", Input: "RegisterTargetsInput", Output: "RegisterTargetsOutput", DryRunUnsupported: true,
+				Revert: &revert{Action: "detach", Params: map[string]string{"group": "group", "id": "id"}},
 				RequiredParams: []param{
 					{AwsField: "TargetGroupArn", TemplateName: "group", AwsType: "awsstr"},
 					{AwsField: "Targets[0]Id", TemplateName: "id", AwsType: "awsslicestruct"},
@@ -421,6 +627,7 @@ var DriversDefs = []driversDef{
 			},
 			{
 				Action: "detach", Entity: cloud.Instance, ApiMethod: "DeregisterTargets", Input: "DeregisterTargetsInput", Output: "DeregisterTargetsOutput", DryRunUnsupported: true,
+				Revert: &revert{Action: "attach", Params: map[string]string{"group": "group", "id": "id"}},
 				RequiredParams: []param{
 					{AwsField: "TargetGroupArn", TemplateName: "group", AwsType: "awsstr"},
 					{AwsField: "Targets[0]Id", TemplateName: "id", AwsType: "awsslicestruct"},
@@ -434,6 +641,7 @@ var DriversDefs = []driversDef{
 			// LoadBalancer
 			{
 				Action: "create", Entity: cloud.Database, Input: "CreateDBInstanceInput", Output: "CreateDBInstanceOutput", ApiMethod: "CreateDBInstance", DryRunUnsupported: true, OutputExtractor: "aws.StringValue(output.DBInstance.DBInstanceIdentifier)",
+				Revert: &revert{Action: "delete", ResultParam: "id", Constants: map[string]string{"skipsnapshot": "true"}, CheckState: "not-found", CheckTimeout: 900},
 				RequiredParams: []param{
 					{AwsField: "DBInstanceClass", TemplateName: "type", AwsType: "awsstr"},
 					{AwsField: "DBInstanceIdentifier", TemplateName: "id", AwsType: "awsstr"},
@@ -480,6 +688,7 @@ var DriversDefs = []driversDef{
 			},
 			{
 				Action: "create", Entity: cloud.DbSubnetGroup, ApiMethod: "CreateDBSubnetGroup", Input: "CreateDBSubnetGroupInput", Output: "CreateDBSubnetGroupOutput", DryRunUnsupported: true, OutputExtractor: "aws.StringValue(output.DBSubnetGroup.DBSubnetGroupName)",
+				Revert: &revert{Action: "delete", ResultParam: "id"},
 				RequiredParams: []param{
 					{AwsField: "DBSubnetGroupDescription", TemplateName: "description", AwsType: "awsstr"},
 					{AwsField: "DBSubnetGroupName", TemplateName: "name", AwsType: "awsstr"},
@@ -494,6 +703,104 @@ var DriversDefs = []driversDef{
 			},
 		},
 	},
+	{
+		Api:          "autoscaling",
+		ApiInterface: "AutoScalingAPI",
+		Drivers: []driver{
+			// Launch configuration
+			{
+				Action: "create", Entity: cloud.LaunchConfiguration, Input: "CreateLaunchConfigurationInput", Output: "CreateLaunchConfigurationOutput", ApiMethod: "CreateLaunchConfiguration", DryRunUnsupported: true, OutputExtractor: "fmt.Sprint(params[\"name\"])",
+				Revert: &revert{Action: "delete", ResultParam: "id"},
+				RequiredParams: []param{
+					{AwsField: "ImageId", TemplateName: "image", AwsType: "awsstr"},
+					{AwsField: "InstanceType", TemplateName: "type", AwsType: "awsstr"},
+					{AwsField: "LaunchConfigurationName", TemplateName: "name", AwsType: "awsstr"},
+				},
+				ExtraParams: []param{
+					{AwsField: "AssociatePublicIpAddress", TemplateName: "public", AwsType: "awsbool"},
+					{AwsField: "IamInstanceProfile", TemplateName: "role", AwsType: "awsstr"},
+					{AwsField: "KeyName", TemplateName: "key", AwsType: "awsstr"},
+					{AwsField: "SecurityGroups", TemplateName: "groups", AwsType: "awsstringslice"},
+					{AwsField: "SpotPrice", TemplateName: "spotprice", AwsType: "awsstr"},
+					{AwsField: "UserData", TemplateName: "userdata", AwsType: "awsstr"},
+				},
+			},
+			{
+				Action: "delete", Entity: cloud.LaunchConfiguration, Input: "DeleteLaunchConfigurationInput", Output: "DeleteLaunchConfigurationOutput", ApiMethod: "DeleteLaunchConfiguration", DryRunUnsupported: true,
+				RequiredParams: []param{
+					{AwsField: "LaunchConfigurationName", TemplateName: "id", AwsType: "awsstr"},
+				},
+			},
+			// Scaling group
+			{
+				Action: "create", Entity: cloud.ScalingGroup, Input: "CreateAutoScalingGroupInput", Output: "CreateAutoScalingGroupOutput", ApiMethod: "CreateAutoScalingGroup", DryRunUnsupported: true, OutputExtractor: "fmt.Sprint(params[\"name\"])",
+				Revert: &revert{Action: "delete", ResultParam: "id", Constants: map[string]string{"force": "true"}, CheckState: "not-found", CheckTimeout: 600},
+				RequiredParams: []param{
+					{AwsField: "AutoScalingGroupName", TemplateName: "name", AwsType: "awsstr"},
+					{AwsField: "LaunchConfigurationName", TemplateName: "launchconfiguration", AwsType: "awsstr"},
+					{AwsField: "MaxSize", TemplateName: "max-size", AwsType: "awsint64"},
+					{AwsField: "MinSize", TemplateName: "min-size", AwsType: "awsint64"},
+					{AwsField: "VPCZoneIdentifier", TemplateName: "subnets", AwsType: "awscsvstr"},
+				},
+				ExtraParams: []param{
+					{AwsField: "DefaultCooldown", TemplateName: "cooldown", AwsType: "awsint64"},
+					{AwsField: "DesiredCapacity", TemplateName: "desired-capacity", AwsType: "awsint64"},
+					{AwsField: "HealthCheckGracePeriod", TemplateName: "healthcheck-grace-period", AwsType: "awsint64"},
+					{AwsField: "HealthCheckType", TemplateName: "healthcheck-type", AwsType: "awsstr"}, // EC2 | ELB
+					{AwsField: "NewInstancesProtectedFromScaleIn", TemplateName: "new-instances-protected", AwsType: "awsbool"},
+					{AwsField: "TargetGroupARNs", TemplateName: "targetgroups", AwsType: "awsstringslice"},
+				},
+			},
+			{
+				Action: "update", Entity: cloud.ScalingGroup, Input: "UpdateAutoScalingGroupInput", Output: "UpdateAutoScalingGroupOutput", ApiMethod: "UpdateAutoScalingGroup", DryRunUnsupported: true,
+				Revert: &revert{Action: "update", Params: map[string]string{"id": "id"}, Previous: map[string]string{"cooldown": properties.Cooldown, "desired-capacity": properties.DesiredCapacity, "healthcheck-type": properties.HealthCheckType, "launchconfiguration": properties.LaunchConfigurationName, "max-size": properties.MaxSize, "min-size": properties.MinSize}},
+				RequiredParams: []param{
+					{AwsField: "AutoScalingGroupName", TemplateName: "id", AwsType: "awsstr"},
+				},
+				ExtraParams: []param{
+					{AwsField: "DefaultCooldown", TemplateName: "cooldown", AwsType: "awsint64"},
+					{AwsField: "DesiredCapacity", TemplateName: "desired-capacity", AwsType: "awsint64"},
+					{AwsField: "HealthCheckGracePeriod", TemplateName: "healthcheck-grace-period", AwsType: "awsint64"},
+					{AwsField: "HealthCheckType", TemplateName: "healthcheck-type", AwsType: "awsstr"},
+					{AwsField: "LaunchConfigurationName", TemplateName: "launchconfiguration", AwsType: "awsstr"},
+					{AwsField: "MaxSize", TemplateName: "max-size", AwsType: "awsint64"},
+					{AwsField: "MinSize", TemplateName: "min-size", AwsType: "awsint64"},
+					{AwsField: "NewInstancesProtectedFromScaleIn", TemplateName: "new-instances-protected", AwsType: "awsbool"},
+					{AwsField: "VPCZoneIdentifier", TemplateName: "subnets", AwsType: "awscsvstr"},
+				},
+			},
+			{
+				Action: "delete", Entity: cloud.ScalingGroup, Input: "DeleteAutoScalingGroupInput", Output: "DeleteAutoScalingGroupOutput", ApiMethod: "DeleteAutoScalingGroup", DryRunUnsupported: true,
+				RequiredParams: []param{
+					{AwsField: "AutoScalingGroupName", TemplateName: "id", AwsType: "awsstr"},
+				},
+				ExtraParams: []param{
+					{AwsField: "ForceDelete", TemplateName: "force", AwsType: "awsbool"}, // also terminates the instances of the group
+				},
+			},
+			// Scaling policy
+			{
+				Action: "create", Entity: cloud.ScalingPolicy, Input: "PutScalingPolicyInput", Output: "PutScalingPolicyOutput", ApiMethod: "PutScalingPolicy", DryRunUnsupported: true, OutputExtractor: "aws.StringValue(output.PolicyARN)",
+				Revert: &revert{Action: "delete", ResultParam: "id"},
+				RequiredParams: []param{
+					{AwsField: "AdjustmentType", TemplateName: "adjustment-type", AwsType: "awsstr"}, // ChangeInCapacity | ExactCapacity | PercentChangeInCapacity
+					{AwsField: "AutoScalingGroupName", TemplateName: "scalinggroup", AwsType: "awsstr"},
+					{AwsField: "PolicyName", TemplateName: "name", AwsType: "awsstr"},
+					{AwsField: "ScalingAdjustment", TemplateName: "scaling-adjustment", AwsType: "awsint64"},
+				},
+				ExtraParams: []param{
+					{AwsField: "Cooldown", TemplateName: "cooldown", AwsType: "awsint64"},
+					{AwsField: "MinAdjustmentMagnitude", TemplateName: "adjustment-magnitude", AwsType: "awsint64"},
+				},
+			},
+			{
+				Action: "delete", Entity: cloud.ScalingPolicy, Input: "DeletePolicyInput", Output: "DeletePolicyOutput", ApiMethod: "DeletePolicy", DryRunUnsupported: true,
+				RequiredParams: []param{
+					{AwsField: "PolicyName", TemplateName: "id", AwsType: "awsstr"}, // policy ARN
+				},
+			},
+		},
+	},
 	{
 		Api:     "sts",
 		Drivers: []driver{},
@@ -504,6 +811,7 @@ var DriversDefs = []driversDef{
 			// USER
 			{
 				Action: "create", Entity: cloud.User, DryRunUnsupported: true, Input: "CreateUserInput", Output: "CreateUserOutput", ApiMethod: "CreateUser", OutputExtractor: "aws.StringValue(output.User.UserId)",
+				Revert: &revert{Action: "delete", Params: map[string]string{"name": "name"}},
 				RequiredParams: []param{
 					{AwsField: "UserName", TemplateName: "name", AwsType: "awsstr"},
 				},
@@ -516,6 +824,7 @@ var DriversDefs = []driversDef{
 			},
 			{
 				Action: "attach", Entity: cloud.User, DryRunUnsupported: true, Input: "AddUserToGroupInput", Output: "AddUserToGroupOutput", ApiMethod: "AddUserToGroup",
+				Revert: &revert{Action: "detach", Params: map[string]string{"group": "group", "name": "name"}},
 				RequiredParams: []param{
 					{AwsField: "GroupName", TemplateName: "group", AwsType: "awsstr"},
 					{AwsField: "UserName", TemplateName: "name", AwsType: "awsstr"},
@@ -523,6 +832,7 @@ var DriversDefs = []driversDef{
 			},
 			{
 				Action: "detach", Entity: cloud.User, DryRunUnsupported: true, Input: "RemoveUserFromGroupInput", Output: "RemoveUserFromGroupOutput", ApiMethod: "RemoveUserFromGroup",
+				Revert: &revert{Action: "attach", Params: map[string]string{"group": "group", "name": "name"}},
 				RequiredParams: []param{
 					{AwsField: "GroupName", TemplateName: "group", AwsType: "awsstr"},
 					{AwsField: "UserName", TemplateName: "name", AwsType: "awsstr"},
@@ -532,6 +842,8 @@ var DriversDefs = []driversDef{
 			// Access key
 			{
 				Action: "create", Entity: cloud.AccessKey, DryRunUnsupported: true, ManualFuncDefinition: true,
+				OutputProperties: map[string]string{"Secret": "aws.StringValue(output.AccessKey.SecretAccessKey)"}, SensitiveOutputs: []string{"Secret"},
+				Revert: &revert{Action: "delete", ResultParam: "id", Params: map[string]string{"user": "user"}},
 				RequiredParams: []param{
 					{TemplateName: "user"},
 				},
@@ -541,11 +853,15 @@ var DriversDefs = []driversDef{
 				RequiredParams: []param{
 					{AwsField: "AccessKeyId", TemplateName: "id", AwsType: "awsstr"},
 				},
+				ExtraParams: []param{
+					{AwsField: "UserName", TemplateName: "user", AwsType: "awsstr"},
+				},
 			},
 
 			// GROUP
 			{
 				Action: "create", Entity: cloud.Group, DryRunUnsupported: true, Input: "CreateGroupInput", Output: "CreateGroupOutput", ApiMethod: "CreateGroup", OutputExtractor: "aws.StringValue(output.Group.GroupId)",
+				Revert: &revert{Action: "delete", Params: map[string]string{"name": "name"}},
 				RequiredParams: []param{
 					{AwsField: "GroupName", TemplateName: "name", AwsType: "awsstr"},
 				},
@@ -560,6 +876,7 @@ var DriversDefs = []driversDef{
 			// POLICY
 			{
 				Action: "attach", Entity: cloud.Policy, ManualFuncDefinition: true,
+				Revert: &revert{Action: "detach", Params: map[string]string{"arn": "arn", "group": "group", "user": "user"}},
 				RequiredParams: []param{
 					{TemplateName: "arn"},
 				},
@@ -570,6 +887,7 @@ var DriversDefs = []driversDef{
 			},
 			{
 				Action: "detach", Entity: cloud.Policy, ManualFuncDefinition: true,
+				Revert: &revert{Action: "attach", Params: map[string]string{"arn": "arn", "group": "group", "user": "user"}},
 				RequiredParams: []param{
 					{TemplateName: "arn"},
 				},
@@ -586,6 +904,7 @@ var DriversDefs = []driversDef{
 			// BUCKET
 			{
 				Action: "create", Entity: cloud.Bucket, DryRunUnsupported: true, Input: "CreateBucketInput", Output: "CreateBucketOutput", ApiMethod: "CreateBucket", OutputExtractor: "params[\"name\"]",
+				Revert: &revert{Action: "delete", Params: map[string]string{"name": "name"}},
 				RequiredParams: []param{
 					{AwsField: "Bucket", TemplateName: "name", AwsType: "awsstr"},
 				},
@@ -600,6 +919,7 @@ var DriversDefs = []driversDef{
 			// OBJECT
 			{
 				Action: "create", Entity: cloud.Object, ManualFuncDefinition: true,
+				Revert: &revert{Action: "delete", Params: map[string]string{"bucket": "bucket", "key": "name"}},
 				RequiredParams: []param{
 					{AwsField: "Bucket", TemplateName: "bucket", AwsType: "awsstr"},
 					{AwsField: "Body", TemplateName: "file", AwsType: "awsstr"},
@@ -623,6 +943,7 @@ var DriversDefs = []driversDef{
 			// TOPIC
 			{
 				Action: "create", Entity: cloud.Topic, DryRunUnsupported: true, Input: "CreateTopicInput", Output: "CreateTopicOutput", ApiMethod: "CreateTopic", OutputExtractor: "aws.StringValue(output.TopicArn)",
+				Revert: &revert{Action: "delete", ResultParam: "id"},
 				RequiredParams: []param{
 					{AwsField: "Name", TemplateName: "name", AwsType: "awsstr"},
 				},
@@ -636,6 +957,7 @@ var DriversDefs = []driversDef{
 			//Subscription
 			{
 				Action: "create", Entity: cloud.Subscription, DryRunUnsupported: true, Input: "SubscribeInput", Output: "SubscribeOutput", ApiMethod: "Subscribe", OutputExtractor: "aws.StringValue(output.SubscriptionArn)",
+				Revert: &revert{Action: "delete", ResultParam: "id"},
 				RequiredParams: []param{
 					{AwsField: "TopicArn", TemplateName: "topic", AwsType: "awsstr"},
 					{AwsField: "Endpoint", TemplateName: "endpoint", AwsType: "awsstr"},
@@ -656,6 +978,7 @@ var DriversDefs = []driversDef{
 			// QUEUE
 			{
 				Action: "create", Entity: cloud.Queue, DryRunUnsupported: true, Input: "CreateQueueInput", Output: "CreateQueueOutput", ApiMethod: "CreateQueue", OutputExtractor: "aws.StringValue(output.QueueUrl)",
+				Revert: &revert{Action: "delete", ResultParam: "url"},
 				RequiredParams: []param{
 					{AwsField: "QueueName", TemplateName: "name", AwsType: "awsstr"},
 				},
@@ -683,6 +1006,7 @@ var DriversDefs = []driversDef{
 		Drivers: []driver{
 			{
 				Action: "create", Entity: cloud.Zone, DryRunUnsupported: true, Input: "CreateHostedZoneInput", Output: "CreateHostedZoneOutput", ApiMethod: "CreateHostedZone", OutputExtractor: "aws.StringValue(output.HostedZone.Id)",
+				Revert: &revert{Action: "delete", ResultParam: "id"},
 				RequiredParams: []param{
 					{AwsField: "CallerReference", TemplateName: "callerreference", AwsType: "awsstr"}, // unique string (random/date/timestamp)
 					{AwsField: "Name", TemplateName: "name", AwsType: "awsstr"},
@@ -703,6 +1027,7 @@ var DriversDefs = []driversDef{
 			},
 			{
 				Action: "create", Entity: cloud.Record, DryRunUnsupported: true, ManualFuncDefinition: true,
+				Revert: &revert{Action: "delete", Params: map[string]string{"name": "name", "ttl": "ttl", "type": "type", "value": "value", "zone": "zone"}},
 				RequiredParams: []param{
 					{TemplateName: "zone"},
 					{TemplateName: "name"},
@@ -716,6 +1041,7 @@ var DriversDefs = []driversDef{
 			},
 			{
 				Action: "delete", Entity: cloud.Record, DryRunUnsupported: true, ManualFuncDefinition: true,
+				Revert: &revert{Action: "create", Params: map[string]string{"name": "name", "ttl": "ttl", "type": "type", "value": "value", "zone": "zone"}},
 				RequiredParams: []param{
 					{TemplateName: "zone"},
 					{TemplateName: "name"},
@@ -726,4 +1052,94 @@ var DriversDefs = []driversDef{
 			},
 		},
 	},
+	{
+		Api:          "lambda",
+		ApiInterface: "LambdaAPI",
+		Drivers: []driver{
+			// FUNCTION
+			{
+				Action: "create", Entity: cloud.Function, DryRunUnsupported: true, ManualFuncDefinition: true,
+				Revert: &revert{Action: "delete", ResultParam: "id"},
+				RequiredParams: []param{
+					{AwsField: "FunctionName", TemplateName: "name", AwsType: "awsstr"},
+					{AwsField: "Handler", TemplateName: "handler", AwsType: "awsstr"},
+					{AwsField: "Role", TemplateName: "role", AwsType: "awsstr"},
+					{AwsField: "Runtime", TemplateName: "runtime", AwsType: "awsstr"},
+				},
+				ExtraParams: []param{
+					{AwsField: "Code.ZipFile", TemplateName: "zipfile", AwsType: "awsstr"}, // either a zip file or an S3 object
+					{AwsField: "Code.S3Bucket", TemplateName: "bucket", AwsType: "awsstr"},
+					{AwsField: "Code.S3Key", TemplateName: "object", AwsType: "awsstr"},
+					{AwsField: "Code.S3ObjectVersion", TemplateName: "objectversion", AwsType: "awsstr"},
+					{AwsField: "Description", TemplateName: "description", AwsType: "awsstr"},
+					{AwsField: "MemorySize", TemplateName: "memory", AwsType: "awsint64"},
+					{AwsField: "Timeout", TemplateName: "timeout", AwsType: "awsint64"},
+					{AwsField: "Publish", TemplateName: "publish", AwsType: "awsbool"},
+					{AwsField: "VpcConfig.SubnetIds", TemplateName: "subnets", AwsType: "awsstringslice"},
+					{AwsField: "VpcConfig.SecurityGroupIds", TemplateName: "groups", AwsType: "awsstringslice"},
+				},
+			},
+			{
+				Action: "delete", Entity: cloud.Function, DryRunUnsupported: true, Input: "DeleteFunctionInput", Output: "DeleteFunctionOutput", ApiMethod: "DeleteFunction",
+				RequiredParams: []param{
+					{AwsField: "FunctionName", TemplateName: "id", AwsType: "awsstr"},
+				},
+				ExtraParams: []param{
+					{AwsField: "Qualifier", TemplateName: "version", AwsType: "awsstr"},
+				},
+			},
+		},
+	},
+	{
+		Api:          "cloudwatch",
+		ApiInterface: "CloudWatchAPI",
+		Drivers: []driver{
+			// ALARM
+			{
+				Action: "create", Entity: cloud.Alarm, ManualFuncDefinition: true,
+				Revert: &revert{Action: "delete", ResultParam: "id"},
+				RequiredParams: []param{
+					{AwsField: "AlarmName", TemplateName: "name", AwsType: "awsstr"},
+					{AwsField: "ComparisonOperator", TemplateName: "operator", AwsType: "awsstr"}, // GreaterThanOrEqualToThreshold | GreaterThanThreshold | LessThanThreshold | LessThanOrEqualToThreshold
+					{AwsField: "MetricName", TemplateName: "metric", AwsType: "awsstr"},
+					{AwsField: "Namespace", TemplateName: "namespace", AwsType: "awsstr"},
+					{AwsField: "EvaluationPeriods", TemplateName: "evaluation-periods", AwsType: "awsint64"},
+					{AwsField: "Period", TemplateName: "period", AwsType: "awsint64"},     // in seconds
+					{AwsField: "Statistic", TemplateName: "statistic", AwsType: "awsstr"}, // SampleCount | Average | Sum | Minimum | Maximum
+					{AwsField: "Threshold", TemplateName: "threshold", AwsType: "awsfloat"},
+				},
+				ExtraParams: []param{
+					{AwsField: "ActionsEnabled", TemplateName: "enabled", AwsType: "awsbool"},
+					{AwsField: "AlarmActions", TemplateName: "alarm-actions", AwsType: "awsstringslice"},
+					{AwsField: "InsufficientDataActions", TemplateName: "insufficientdata-actions", AwsType: "awsstringslice"},
+					{AwsField: "OKActions", TemplateName: "ok-actions", AwsType: "awsstringslice"},
+					{AwsField: "AlarmDescription", TemplateName: "description", AwsType: "awsstr"},
+					{AwsField: "Dimensions", TemplateName: "dimensions", AwsType: "awsdimensionslice"}, // list of Name:Value (ex: InstanceId:i-1234)
+					{AwsField: "Unit", TemplateName: "unit", AwsType: "awsstr"},
+				},
+			},
+			{
+				Action: "delete", Entity: cloud.Alarm, DryRunUnsupported: true, Input: "DeleteAlarmsInput", Output: "DeleteAlarmsOutput", ApiMethod: "DeleteAlarms",
+				RequiredParams: []param{
+					{AwsField: "AlarmNames", TemplateName: "id", AwsType: "awsstringslice"},
+				},
+			},
+			{
+				Action: "start", Entity: cloud.Alarm, DryRunUnsupported: true, Input: "EnableAlarmActionsInput", Output: "EnableAlarmActionsOutput", ApiMethod: "EnableAlarmActions",
+				Revert:  &revert{Action: "stop", Params: map[string]string{"id": "id"}},
+				Planned: map[string]interface{}{"ActionsEnabled": true},
+				RequiredParams: []param{
+					{AwsField: "AlarmNames", TemplateName: "id", AwsType: "awsstringslice"},
+				},
+			},
+			{
+				Action: "stop", Entity: cloud.Alarm, DryRunUnsupported: true, Input: "DisableAlarmActionsInput", Output: "DisableAlarmActionsOutput", ApiMethod: "DisableAlarmActions",
+				Revert:  &revert{Action: "start", Params: map[string]string{"id": "id"}},
+				Planned: map[string]interface{}{"ActionsEnabled": false},
+				RequiredParams: []param{
+					{AwsField: "AlarmNames", TemplateName: "id", AwsType: "awsstringslice"},
+				},
+			},
+		},
+	},
 }
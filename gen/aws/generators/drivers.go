@@ -33,7 +33,10 @@ func generateTemplateTemplates() {
 	}
 
 	var buff bytes.Buffer
-	err = templ.Execute(&buff, aws.DriversDefs)
+	err = templ.Execute(&buff, struct {
+		Drivers  interface{}
+		Fetchers interface{}
+	}{aws.DriversDefs, aws.FetchersDefs})
 	if err != nil {
 		panic(err)
 	}
@@ -106,7 +109,7 @@ import (
 )
 
 var AWSTemplatesDefinitions = map[string]template.Definition{
-{{- range $, $service := . }}
+{{- range $, $service := .Drivers }}
 {{- range $index, $def := $service.Drivers }}
 	"{{ $def.Action }}{{ $def.Entity }}": template.Definition{
 			Action: "{{ $def.Action }}",
@@ -114,6 +117,47 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 			Api: "{{ $service.Api }}",
 			RequiredParams: []string{ {{- range $key := $def.RequiredKeys }}"{{ $key }}", {{- end}} },
 			ExtraParams: []string{ {{- range $key := $def.ExtraKeys }}"{{ $key }}", {{- end}} },
+			{{- with $def.Revert }}
+			Revert: &template.RevertRule{
+				Action: "{{ .Action }}",
+				{{- if .ResultParam }}
+				ResultParam: "{{ .ResultParam }}",
+				{{- end }}
+				{{- if .Params }}
+				Params: {{ printf "%#v" .Params }},
+				{{- end }}
+				{{- if .Constants }}
+				Constants: {{ printf "%#v" .Constants }},
+				{{- end }}
+				{{- if .Inverted }}
+				Inverted: {{ printf "%#v" .Inverted }},
+				{{- end }}
+				{{- if .Previous }}
+				Previous: {{ printf "%#v" .Previous }},
+				{{- end }}
+				{{- if .CheckState }}
+				CheckState: "{{ .CheckState }}",
+				CheckTimeout: {{ .CheckTimeout }},
+				{{- end }}
+			},
+			{{- end }}
+			{{- if $def.DeprecatedParams }}
+			DeprecatedParams: {{ printf "%#v" $def.DeprecatedParams }},
+			{{- end }}
+			{{- if $def.Planned }}
+			PlannedProperties: {{ printf "%#v" $def.Planned }},
+			{{- end }}
+		},
+{{- end }}
+{{- end }}
+{{- range $, $service := .Fetchers }}
+{{- range $index, $fetcher := $service.Fetchers }}
+	"check{{ $fetcher.ResourceType }}": template.Definition{
+			Action: "check",
+			Entity: "{{ $fetcher.ResourceType }}",
+			Api: "{{ $fetcher.Api }}",
+			RequiredParams: []string{"id", "state", "timeout"},
+			ExtraParams: []string{"interval"},
 		},
 {{- end }}
 {{- end }}
@@ -121,10 +165,15 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 
 func DriverSupportedActions() map[string][]string { 
 	supported := make(map[string][]string)
-{{- range $, $service := . }}
+{{- range $, $service := .Drivers }}
 {{- range $index, $def := $service.Drivers }}
 	supported["{{ $def.Action }}"] = append(supported["{{ $def.Action }}"], "{{ $def.Entity }}")
 {{- end }}
+{{- end }}
+{{- range $, $service := .Fetchers }}
+{{- range $index, $fetcher := $service.Fetchers }}
+	supported["check"] = append(supported["check"], "{{ $fetcher.ResourceType }}")
+{{- end }}
 {{- end }}
 	return supported
 }
@@ -150,6 +199,7 @@ limitations under the License.
 package aws
 
 import (
+	"context"
 	"errors"
 	"strings"
 	"time"
@@ -160,6 +210,7 @@ import (
 	{{- range $index, $service := . }}
 	"github.com/aws/aws-sdk-go/service/{{ $service.Api }}"
 	{{- end }}
+	"github.com/wallix/awless/template/driver"
 )
 
 const (
@@ -172,7 +223,7 @@ const (
 
 {{- if $def.DryRunUnsupported }}
 // This function was auto generated
-func (d *{{ Title $service.Api }}Driver) {{ Title $def.Action }}_{{ Title $def.Entity }}_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *{{ Title $service.Api }}Driver) {{ Title $def.Action }}_{{ Title $def.Entity }}_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	{{- range $awsField, $field := $def.RequiredParams }}
 	if _, ok := params["{{ $field.TemplateName }}"]; !ok {
 		return nil, errors.New("{{ $def.Action }} {{ $def.Entity }}: missing required params '{{ $field.TemplateName }}'")
@@ -183,7 +234,7 @@ func (d *{{ Title $service.Api }}Driver) {{ Title $def.Action }}_{{ Title $def.E
 }
 {{ else }}
 // This function was auto generated
-func (d *{{ Title $service.Api }}Driver) {{ Title $def.Action }}_{{ Title $def.Entity }}_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *{{ Title $service.Api }}Driver) {{ Title $def.Action }}_{{ Title $def.Entity }}_DryRun(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &{{ $service.Api }}.{{ $def.Input }}{}
 	input.DryRun = aws.Bool(true)
 	var err error
@@ -220,7 +271,7 @@ func (d *{{ Title $service.Api }}Driver) {{ Title $def.Action }}_{{ Title $def.E
 			{{- range $i, $field := $def.RequiredParams }}
 				{{- if $field.AsAwsTag }}
 				// Required param as tag
-			_, err = d.Create_Tag_DryRun(map[string]interface{}{"key":"{{ $field.AwsField }}", "value":params["{{ $field.TemplateName }}"], "resource":id})
+			_, err = d.Create_Tag_DryRun(ctx, map[string]interface{}{"key":"{{ $field.AwsField }}", "value":params["{{ $field.TemplateName }}"], "resource":id})
 			if err != nil {
 				return nil, fmt.Errorf("dry run: {{ $def.Action }} {{ $def.Entity }}: adding tags: %s",err)
 			}
@@ -230,7 +281,7 @@ func (d *{{ Title $service.Api }}Driver) {{ Title $def.Action }}_{{ Title $def.E
 				{{- if $field.AsAwsTag }}
 				// Extra param as tag
 			if v, ok := params["{{ $field.TemplateName }}"]; ok {
-				_, err = d.Create_Tag_DryRun(map[string]interface{}{"key":"{{ $field.AwsField }}", "value":v, "resource":id})
+				_, err = d.Create_Tag_DryRun(ctx, map[string]interface{}{"key":"{{ $field.AwsField }}", "value":v, "resource":id})
 				if err != nil {
 					return nil, fmt.Errorf("dry run: {{ $def.Action }} {{ $def.Entity }}: adding tags: %s",err)
 				}
@@ -246,7 +297,7 @@ func (d *{{ Title $service.Api }}Driver) {{ Title $def.Action }}_{{ Title $def.E
 }
 {{ end }}
 // This function was auto generated
-func (d *{{ Title $service.Api }}Driver) {{ Title $def.Action }}_{{ Title $def.Entity }}(params map[string]interface{}) (interface{}, error) {
+func (d *{{ Title $service.Api }}Driver) {{ Title $def.Action }}_{{ Title $def.Entity }}(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 	input := &{{ $service.Api }}.{{ $def.Input }}{}
 	var err error
 	{{if gt (len $def.RequiredParams) 0 }}
@@ -289,7 +340,7 @@ func (d *{{ Title $service.Api }}Driver) {{ Title $def.Action }}_{{ Title $def.E
 	{{- range $i, $field := $def.RequiredParams }}
 		{{- if $field.AsAwsTag }}
 		// Required param as tag
-	_, err = d.Create_Tag(map[string]interface{}{"key":"{{ $field.AwsField }}", "value":params["{{ $field.TemplateName }}"], "resource":id})
+	_, err = d.Create_Tag(ctx, map[string]interface{}{"key":"{{ $field.AwsField }}", "value":params["{{ $field.TemplateName }}"], "resource":id})
 	if err != nil {
 		return nil, fmt.Errorf("{{ $def.Action }} {{ $def.Entity }}: adding tags: %s",err)
 	}
@@ -299,7 +350,7 @@ func (d *{{ Title $service.Api }}Driver) {{ Title $def.Action }}_{{ Title $def.E
 		{{- if $field.AsAwsTag }}
 		// Extra param as tag
 	if v, ok := params["{{ $field.TemplateName }}"]; ok {
-		_, err = d.Create_Tag(map[string]interface{}{"key":"{{ $field.AwsField }}", "value":v, "resource":id})
+		_, err = d.Create_Tag(ctx, map[string]interface{}{"key":"{{ $field.AwsField }}", "value":v, "resource":id})
 		if err != nil {
 			return nil, fmt.Errorf("{{ $def.Action }} {{ $def.Entity }}: adding tags: %s",err)
 		}
@@ -308,7 +359,21 @@ func (d *{{ Title $service.Api }}Driver) {{ Title $def.Action }}_{{ Title $def.E
 	{{- end }}
 	
 	d.logger.Verbosef("{{ $def.Action }} {{ $def.Entity }} '%s' done", id)
+	{{- if $def.OutputProperties }}
+	return &driver.Output{ID: id, Properties: map[string]interface{}{
+		{{- range $name, $extractor := $def.PublicOutputs }}
+		"{{ $name }}": {{ $extractor }},
+		{{- end }}
+	}
+	{{- if $def.SensitiveOutputs }}, Sensitive: map[string]interface{}{
+		{{- range $name, $extractor := $def.SensitiveOutputProperties }}
+		"{{ $name }}": {{ $extractor }},
+		{{- end }}
+	}
+	{{- end }}}, nil
+	{{- else }}
 	return id, nil
+	{{- end }}
 	{{- else }}
 	d.logger.Verbose("{{ $def.Action }} {{ $def.Entity }} done")
 	return output, nil
@@ -170,6 +170,7 @@ func (s *{{ Title $service.Name }}) Drivers() []driver.Driver {
 			{{- end }}
 		
 		{{- end }}
+		awsdriver.NewCheckDriver(s.FetchByID, s.ResourceTypes()...),
 	}
 }
 
@@ -283,14 +284,34 @@ func (s *{{ Title $service.Name }}) FetchByType(t string) (*graph.Graph, error)
   }
 }
 
+// FetchByID fetches the resource of the given type and id, or all the
+// resources of the type when they cannot be described by id
+func (s *{{ Title $service.Name }}) FetchByID(t, id string) (*graph.Graph, error) {
+  switch t {
+  {{- range $index, $fetcher := $service.Fetchers }}
+  {{- if $fetcher.IDFilter }}
+  case "{{ $fetcher.ResourceType }}":
+		graph, _, err := s.fetch_{{ $fetcher.ResourceType }}_graph(&{{ $fetcher.InputByID }})
+    return graph, err
+  {{- end }}
+  {{- end }}
+  default:
+    return s.FetchByType(t)
+  }
+}
+
 {{ range $index, $fetcher := $service.Fetchers }}
 {{- if not $fetcher.ManualFetcher }}
 func (s *{{ Title $service.Name }}) fetch_all_{{ $fetcher.ResourceType }}_graph() (*graph.Graph, []*{{ $fetcher.AWSType }}, error) {
+	return s.fetch_{{ $fetcher.ResourceType }}_graph(&{{ $fetcher.Input }})
+}
+
+func (s *{{ Title $service.Name }}) fetch_{{ $fetcher.ResourceType }}_graph(input *{{ $fetcher.InputType }}) (*graph.Graph, []*{{ $fetcher.AWSType }}, error) {
   g := graph.NewGraph()
 	var cloudResources []*{{ $fetcher.AWSType }}
 	{{- if $fetcher.Multipage }}
 	var badResErr error
-	err := s.{{ $fetcher.ApiMethod }}(&{{ $fetcher.Input }},
+	err := s.{{ $fetcher.ApiMethod }}(input,
 		func(out *{{ $fetcher.Output }}, lastPage bool) (shouldContinue bool) {
 			{{- if ne $fetcher.OutputsContainers "" }}
 			for _, all := range out.{{ $fetcher.OutputsContainers }} {
@@ -327,7 +348,7 @@ func (s *{{ Title $service.Name }}) fetch_all_{{ $fetcher.ResourceType }}_graph(
 
 	return g, cloudResources, badResErr
 	{{- else }}
-  out, err := s.{{ $fetcher.ApiMethod }}(&{{ $fetcher.Input }})
+  out, err := s.{{ $fetcher.ApiMethod }}(input)
   if err != nil {
     return nil, cloudResources, err
   }
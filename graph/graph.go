@@ -72,6 +72,30 @@ func (g *Graph) AddAppliesOnRelation(parent, child *Resource) error {
 	return g.addRelation(parent, child, cloudrdf.ApplyOn)
 }
 
+// UpdateResource replaces the type and properties of the resource in the graph,
+// keeping its relations with other resources
+func (g *Graph) UpdateResource(res *Resource) error {
+	triples, err := res.marshalFullRDF()
+	if err != nil {
+		return err
+	}
+
+	for _, tri := range g.store.Snapshot().WithSubject(res.Id()) {
+		if pred := tri.Predicate(); pred != cloudrdf.ParentOf && pred != cloudrdf.ApplyOn {
+			g.store.Remove(tri)
+		}
+	}
+	g.store.Add(triples...)
+	return nil
+}
+
+// DeleteResource removes the resource and all its relations from the graph
+func (g *Graph) DeleteResource(res *Resource) {
+	snap := g.store.Snapshot()
+	g.store.Remove(snap.WithSubject(res.Id())...)
+	g.store.Remove(snap.WithObject(tstore.Resource(res.Id()))...)
+}
+
 func (g *Graph) GetResource(t string, id string) (*Resource, error) {
 	resource := InitResource(t, id)
 	snap := g.store.Snapshot()
@@ -63,3 +63,56 @@ func TestAddGraphRelation(t *testing.T) {
 		}
 	})
 }
+
+func TestUpdateAndDeleteResource(t *testing.T) {
+	newGraph := func() *Graph {
+		g := NewGraph()
+		inst := InitResource("instance", "inst_1")
+		inst.Properties["State"] = "running"
+		sub := InitResource("subnet", "subnet_1")
+		g.AddResource(inst, sub)
+		g.AddParentRelation(sub, inst)
+		g.AddAppliesOnRelation(InitResource("securitygroup", "sg_1"), inst)
+		return g
+	}
+
+	t.Run("Update", func(t *testing.T) {
+		g := newGraph()
+		inst := InitResource("instance", "inst_1")
+		inst.Properties["State"] = "stopped"
+		if err := g.UpdateResource(inst); err != nil {
+			t.Fatal(err)
+		}
+
+		expTriples := tstore.Triples([]tstore.Triple{
+			tstore.SubjPred("inst_1", "rdf:type").Resource("cloud-owl:Instance"),
+			tstore.SubjPred("inst_1", "cloud:state").StringLiteral("stopped"),
+			tstore.SubjPred("subnet_1", "rdf:type").Resource("cloud-owl:Subnet"),
+			tstore.SubjPred("subnet_1", "cloud-rel:parentOf").Resource("inst_1"),
+			tstore.SubjPred("sg_1", "cloud-rel:applyOn").Resource("inst_1"),
+		})
+		if got, want := tstore.Triples(g.store.Snapshot().Triples()), expTriples; !got.Equal(want) {
+			t.Fatalf("got\n%q\nwant\n%q\n", got, want)
+		}
+
+		inst.Properties["Unknown"] = "value"
+		if err := g.UpdateResource(inst); err == nil {
+			t.Fatal("expected error for unknown property")
+		}
+		if got, want := tstore.Triples(g.store.Snapshot().Triples()), expTriples; !got.Equal(want) {
+			t.Fatalf("got\n%q\nwant\n%q\n", got, want)
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		g := newGraph()
+		g.DeleteResource(InitResource("instance", "inst_1"))
+
+		expTriples := tstore.Triples([]tstore.Triple{
+			tstore.SubjPred("subnet_1", "rdf:type").Resource("cloud-owl:Subnet"),
+		})
+		if got, want := tstore.Triples(g.store.Snapshot().Triples()), expTriples; !got.Equal(want) {
+			t.Fatalf("got\n%q\nwant\n%q\n", got, want)
+		}
+	})
+}
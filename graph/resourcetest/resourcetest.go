@@ -49,6 +49,18 @@ func RouteTable(id string) *rBuilder {
 	return new("routetable", id).Prop(properties.ID, id)
 }
 
+func Volume(id string) *rBuilder {
+	return new("volume", id).Prop(properties.ID, id)
+}
+
+func Snapshot(id string) *rBuilder {
+	return new("snapshot", id).Prop(properties.ID, id)
+}
+
+func Image(id string) *rBuilder {
+	return new("image", id).Prop(properties.ID, id)
+}
+
 func LoadBalancer(id string) *rBuilder {
 	return new("loadbalancer", id).Prop(properties.ID, id)
 }
@@ -77,6 +89,30 @@ func Listener(id string) *rBuilder {
 	return new("listener", id).Prop(properties.ID, id)
 }
 
+func ElasticIP(id string) *rBuilder {
+	return new("elasticip", id).Prop(properties.ID, id)
+}
+
+func NatGateway(id string) *rBuilder {
+	return new("natgateway", id).Prop(properties.ID, id)
+}
+
+func NetworkInterface(id string) *rBuilder {
+	return new("networkinterface", id).Prop(properties.ID, id)
+}
+
+func LaunchConfiguration(id string) *rBuilder {
+	return new("launchconfiguration", id).Prop(properties.ID, id)
+}
+
+func ScalingGroup(id string) *rBuilder {
+	return new("scalinggroup", id).Prop(properties.ID, id)
+}
+
+func ScalingPolicy(id string) *rBuilder {
+	return new("scalingpolicy", id).Prop(properties.ID, id)
+}
+
 func Bucket(id string) *rBuilder {
 	return new("bucket", id).Prop(properties.ID, id)
 }
@@ -89,6 +125,14 @@ func Record(id string) *rBuilder {
 	return new("record", id).Prop(properties.ID, id)
 }
 
+func Function(id string) *rBuilder {
+	return new("function", id).Prop(properties.ID, id)
+}
+
+func Alarm(id string) *rBuilder {
+	return new("alarm", id).Prop(properties.ID, id)
+}
+
 func (b *rBuilder) Prop(key string, value interface{}) *rBuilder {
 	b.props[key] = value
 	return b
@@ -1,11 +1,16 @@
 package template
 
 import (
+	"errors"
 	"fmt"
+	"path/filepath"
 	"sort"
 	"strings"
 
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
 	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/driver"
 	"github.com/wallix/awless/template/internal/ast"
 )
 
@@ -14,8 +19,14 @@ type Env struct {
 
 	Resolved         map[string]interface{}
 	DefLookupFunc    DefinitionLookupFunc
+	LookupGraph      LookupGraphFunc
 	AliasFunc        func(entity, key, alias string) string
 	MissingHolesFunc func(string) interface{}
+	IncludeFunc      func(source string) ([]byte, error)
+	HoleSpecs        map[string]HoleSpec
+	// Source is the path or URL of the compiled template, against which its
+	// relative includes are resolved
+	Source string
 
 	Log *logger.Logger
 }
@@ -43,11 +54,16 @@ func (e *Env) AddFillers(fills ...map[string]interface{}) {
 
 func Compile(tpl *Template, env *Env) (*Template, *Env, error) {
 	pass := newMultiPass(
+		expandBlocksPass,
 		resolveAgainstDefinitions,
 		checkReferencesDeclaration,
+		resolveHoleSpecsPass,
 		resolveHolesPass,
 		resolveMissingHolesPass,
+		checkRetryParamPass,
 		resolveAliasPass,
+		resolveEnsurePass,
+		capturePreviousValuesPass,
 	)
 
 	return pass.compile(tpl, env)
@@ -76,34 +92,268 @@ func (p *multiPass) compile(tpl *Template, env *Env) (newTpl *Template, newEnv *
 	return
 }
 
-func resolveAgainstDefinitions(tpl *Template, env *Env) (*Template, *Env, error) {
-	each := func(cmd *ast.CommandNode) error {
-		key := fmt.Sprintf("%s%s", cmd.Action, cmd.Entity)
-		def, ok := env.DefLookupFunc(key)
-		if !ok {
-			return fmt.Errorf("cannot find template definition for '%s'", key)
+func expandBlocksPass(tpl *Template, env *Env) (*Template, *Env, error) {
+	expansion := &blocksExpansion{env: env, outputs: make(map[string]struct{})}
+	if env.Source != "" {
+		expansion.includes = []string{includeSource(env.Source, "")}
+	}
+	statements, err := expansion.expand(tpl.Statements)
+	if err != nil {
+		return tpl, env, err
+	}
+	tpl.Statements = statements
+
+	tpl.visitCommandNodes(func(cmd *ast.CommandNode) {
+		for _, ref := range cmd.UsedRefs() {
+			decl, _ := splitRefProperty(ref, func(r string) bool { _, ok := expansion.outputs[r]; return ok })
+			delete(expansion.outputs, decl)
 		}
+	})
+	for _, sts := range tpl.Statements {
+		if decl, ok := sts.Node.(*ast.DeclarationNode); ok {
+			if _, unused := expansion.outputs[decl.Ident]; unused {
+				env.Log.ExtraVerbosef("discarding unused included reference '%s'", decl.Ident)
+				sts.Node = decl.Expr
+			}
+		}
+	}
 
-		for _, key := range cmd.Keys() {
-			var found bool
+	return tpl, env, nil
+}
 
-			for _, k := range def.Required() {
-				if k == key {
-					found = true
-					break
+type blocksExpansion struct {
+	env      *Env
+	includes []string            // sources being included, to detect cycles
+	outputs  map[string]struct{} // references declared by included templates
+}
+
+func (e *blocksExpansion) expand(statements []*ast.Statement) (expanded []*ast.Statement, err error) {
+	for _, sts := range statements {
+		switch sts.Node.(type) {
+		case *ast.ForNode:
+			loop := sts.Node.(*ast.ForNode)
+			for _, value := range loop.Values {
+				body, err := Parse(loop.Expand(value))
+				if err != nil {
+					return expanded, fmt.Errorf("for %s in %s: %s", loop.Variable, strings.Join(loop.Values, ","), err)
 				}
+				nested, err := e.expand(body.Statements)
+				if err != nil {
+					return expanded, err
+				}
+				expanded = append(expanded, nested...)
+			}
+		case *ast.IfNode:
+			block := sts.Node.(*ast.IfNode)
+			found, err := resourceExists(block.Entity, block.Filters, e.env.LookupGraph)
+			if err != nil {
+				return expanded, fmt.Errorf("%s: %s", block.Condition(), err)
+			}
+			if found == block.Negate {
+				e.env.Log.Infof("%s: condition not met, skipping block", block.Condition())
+				continue
+			}
+			e.env.Log.Infof("%s: condition met, running block", block.Condition())
+			body, err := Parse(block.Body)
+			if err != nil {
+				return expanded, fmt.Errorf("%s: %s", block.Condition(), err)
+			}
+			nested, err := e.expand(body.Statements)
+			if err != nil {
+				return expanded, err
 			}
+			expanded = append(expanded, nested...)
+		case *ast.IncludeNode:
+			nested, err := e.include(sts.Node.(*ast.IncludeNode))
+			if err != nil {
+				return expanded, err
+			}
+			expanded = append(expanded, nested...)
+		default:
+			expanded = append(expanded, sts)
+		}
+	}
+
+	return
+}
+
+// include expands the statements of the included template, prefixing its
+// declared references with the include identifier, if any, and filling its
+// holes with the include params.
+func (e *blocksExpansion) include(node *ast.IncludeNode) ([]*ast.Statement, error) {
+	if e.env.IncludeFunc == nil {
+		return nil, fmt.Errorf("include %s: no template loader available", node.Source)
+	}
+
+	var parent string
+	if l := len(e.includes); l > 0 {
+		parent = e.includes[l-1]
+	}
+	source := includeSource(node.Source, parent)
+	for i, included := range e.includes {
+		if included == source {
+			cycle := append(append([]string{}, e.includes[i:]...), source)
+			return nil, fmt.Errorf("cyclic include: %s", strings.Join(cycle, " -> "))
+		}
+	}
+
+	e.env.Log.ExtraVerbosef("including template '%s'", source)
+	text, err := e.env.IncludeFunc(source)
+	if err != nil {
+		return nil, fmt.Errorf("include %s: %s", source, err)
+	}
+	tpl, err := ParseFile(source, text)
+	if err != nil {
+		return nil, fmt.Errorf("include %s: %s", source, err)
+	}
+
+	nested := &blocksExpansion{env: e.env, includes: append(append([]string{}, e.includes...), source), outputs: e.outputs}
+	if tpl.Statements, err = nested.expand(tpl.Statements); err != nil {
+		return nil, err
+	}
 
-			for _, k := range def.Extra() {
-				if k == key {
+	if node.Ident != "" {
+		names := make(map[string]string)
+		tpl.visitCommandDeclarationNodes(func(decl *ast.DeclarationNode) {
+			names[decl.Ident] = fmt.Sprintf("%s.%s", node.Ident, decl.Ident)
+			decl.Ident = names[decl.Ident]
+		})
+		tpl.visitCommandNodes(func(cmd *ast.CommandNode) {
+			cmd.RenameRefs(names)
+		})
+	}
+
+	holes := make(map[string]struct{})
+	tpl.visitCommandNodes(func(cmd *ast.CommandNode) {
+		for _, hole := range cmd.UsedHoles() {
+			holes[hole] = struct{}{}
+		}
+	})
+	for _, key := range node.Args.Keys() {
+		if _, ok := holes[key]; !ok {
+			var available []string
+			for hole := range holes {
+				available = append(available, hole)
+			}
+			sort.Strings(available)
+			return nil, fmt.Errorf("include %s: unexpected param '%s' (available holes: %s)", source, key, strings.Join(available, ", "))
+		}
+	}
+	for key, v := range node.Args.Params {
+		if _, ok := v.(ast.Interpolation); ok {
+			return nil, fmt.Errorf("include %s: unsupported interpolated value for param '%s'", source, key)
+		}
+	}
+
+	tpl.visitCommandNodes(func(cmd *ast.CommandNode) {
+		cmd.ProcessHoles(node.Args.Params)
+		cmd.ProcessHolesWithRefs(node.Args.Refs)
+		cmd.RenameHoles(node.Args.Holes)
+	})
+	tpl.visitCommandDeclarationNodes(func(decl *ast.DeclarationNode) {
+		e.outputs[decl.Ident] = struct{}{}
+	})
+
+	return tpl.Statements, nil
+}
+
+// includeSource returns the source of an included template, local relative
+// paths being relative to the including template when it is a local file
+func includeSource(source, parent string) string {
+	if isRemoteSource(source) {
+		return source
+	}
+	if parent == "" || isRemoteSource(parent) || filepath.IsAbs(source) {
+		return filepath.Clean(source)
+	}
+	return filepath.Join(filepath.Dir(parent), source)
+}
+
+func isRemoteSource(source string) bool {
+	return strings.HasPrefix(source, "http") || strings.HasPrefix(source, "repo:")
+}
+
+// resourceExists looks up in the local graph a resource of the given entity
+// matching all the filters. Filters on 'id' match the resource id, filters on
+// 'name' or with an alias value (ex: @my-sg) match the resource name, others
+// match the resource property of the same name (case insensitive).
+func resourceExists(entity string, filters map[string]string, lookup LookupGraphFunc) (bool, error) {
+	res, err := findResource(entity, filters, lookup)
+	return res != nil, err
+}
+
+// findResource returns the first resource of the local graph matching the
+// filters as in resourceExists, or nil if none matches
+func findResource(entity string, filters map[string]string, lookup LookupGraphFunc) (*graph.Resource, error) {
+	if lookup == nil {
+		return nil, errors.New("no local graph available to look up resources")
+	}
+	g, ok := lookup(entity)
+	if !ok {
+		return nil, fmt.Errorf("no local graph available for '%s'", entity)
+	}
+	resources, err := g.GetAllResources(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, res := range resources {
+		if matchFilters(res, filters) {
+			return res, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func matchFilters(res *graph.Resource, filters map[string]string) bool {
+	for key, val := range filters {
+		switch {
+		case strings.HasPrefix(val, "@"):
+			if name, ok := res.Properties[properties.Name]; !ok || fmt.Sprint(name) != strings.TrimPrefix(val, "@") {
+				return false
+			}
+		case key == "id":
+			if res.Id() != val {
+				return false
+			}
+		default:
+			var found bool
+			for prop, propVal := range res.Properties {
+				if strings.EqualFold(prop, key) && fmt.Sprint(propVal) == val {
 					found = true
 					break
 				}
 			}
 			if !found {
-				return fmt.Errorf("%s %s: unexpected param key '%s'\n\t- required params: %s\n\t- extra params: %s\n", cmd.Action, cmd.Entity, key, strings.Join(def.Required(), ", "), strings.Join(def.Extra(), ", "))
+				return false
 			}
 		}
+	}
+
+	return true
+}
+
+// definitionKey returns the key of the command definition. 'ensure' commands
+// share the definition of the creation of their entity
+func definitionKey(cmd *ast.CommandNode) string {
+	if cmd.Action == "ensure" {
+		return fmt.Sprintf("create%s", cmd.Entity)
+	}
+	return fmt.Sprintf("%s%s", cmd.Action, cmd.Entity)
+}
+
+func resolveAgainstDefinitions(tpl *Template, env *Env) (*Template, *Env, error) {
+	each := func(cmd *ast.CommandNode) error {
+		key := definitionKey(cmd)
+		def, ok := env.DefLookupFunc(key)
+		if !ok {
+			return fmt.Errorf("cannot find template definition for '%s'", key)
+		}
+
+		if unexpected := unexpectedParams(cmd, def); len(unexpected) > 0 {
+			return fmt.Errorf("%s %s: unexpected param key '%s'\n\t- required params: %s\n\t- extra params: %s\n", cmd.Action, cmd.Entity, unexpected[0], strings.Join(def.Required(), ", "), strings.Join(def.Extra(), ", "))
+		}
 
 		return nil
 	}
@@ -116,8 +366,7 @@ func resolveAgainstDefinitions(tpl *Template, env *Env) (*Template, *Env, error)
 		if cmd.Holes == nil {
 			cmd.Holes = make(map[string]string)
 		}
-		key := fmt.Sprintf("%s%s", cmd.Action, cmd.Entity)
-		def, _ := env.DefLookupFunc(key)
+		def, _ := env.DefLookupFunc(definitionKey(cmd))
 		for _, required := range def.Required() {
 			var isInParams bool
 			var isInRefs bool
@@ -148,28 +397,104 @@ func resolveAgainstDefinitions(tpl *Template, env *Env) (*Template, *Env, error)
 	return tpl, env, nil
 }
 
+// unexpectedParams returns the sorted params of the command unknown to its definition
+func unexpectedParams(cmd *ast.CommandNode, def Definition) (unexpected []string) {
+	for _, key := range cmd.Keys() {
+		if key == driver.RetryParam {
+			continue
+		}
+		var found bool
+
+		for _, k := range def.Required() {
+			if k == key {
+				found = true
+				break
+			}
+		}
+
+		for _, k := range def.Extra() {
+			if k == key {
+				found = true
+				break
+			}
+		}
+		if !found {
+			unexpected = append(unexpected, key)
+		}
+	}
+	sort.Strings(unexpected)
+	return
+}
+
 func checkReferencesDeclaration(tpl *Template, env *Env) (*Template, *Env, error) {
-	usedRefs := make(map[string]struct{})
+	var used, declared []string
 	tpl.visitCommandNodes(func(cmd *ast.CommandNode) {
-		for _, v := range cmd.Refs {
-			usedRefs[v] = struct{}{}
-		}
+		used = append(used, cmd.UsedRefs()...)
 	})
-
-	declRefs := make(map[string]struct{})
 	tpl.visitCommandDeclarationNodes(func(decl *ast.DeclarationNode) {
-		declRefs[decl.Ident] = struct{}{}
+		declared = append(declared, decl.Ident)
 	})
 
-	for r := range usedRefs {
-		if _, ok := declRefs[r]; !ok {
-			return tpl, env, fmt.Errorf("using reference '$%s' but '%s' is undefined in template\n", r, r)
+	undefined, unused := checkReferences(used, declared)
+	if len(undefined) > 0 {
+		return tpl, env, fmt.Errorf("using reference '$%s' but '%s' is undefined in template\n", undefined[0], undefined[0])
+	}
+	if len(unused) > 0 {
+		return tpl, env, fmt.Errorf("unused reference '%s' in template\n", unused[0])
+	}
+
+	return tpl, env, nil
+}
+
+// checkReferences returns the sorted used references that are not declared,
+// and the sorted declared references that are not used
+func checkReferences(used, declared []string) (undefined, unused []string) {
+	declRefs := make(map[string]struct{})
+	for _, r := range declared {
+		declRefs[r] = struct{}{}
+	}
+	isDeclared := func(r string) bool {
+		_, ok := declRefs[r]
+		return ok
+	}
+
+	usedRefs, undefinedRefs := make(map[string]struct{}), make(map[string]struct{})
+	for _, r := range used {
+		if decl, _ := splitRefProperty(r, isDeclared); isDeclared(decl) {
+			usedRefs[decl] = struct{}{}
+		} else if _, done := undefinedRefs[r]; !done {
+			undefinedRefs[r] = struct{}{}
+			undefined = append(undefined, r)
 		}
 	}
 
 	for r := range declRefs {
 		if _, ok := usedRefs[r]; !ok {
-			return tpl, env, fmt.Errorf("unused reference '%s' in template\n", r)
+			unused = append(unused, r)
+		}
+	}
+
+	sort.Strings(undefined)
+	sort.Strings(unused)
+	return
+}
+
+// resolveHoleSpecsPass collects the holes declared with a type, a default
+// and/or a description, and validates the given fillers against them
+func resolveHoleSpecsPass(tpl *Template, env *Env) (*Template, *Env, error) {
+	specs, err := tpl.holeSpecs()
+	if err != nil {
+		return tpl, env, err
+	}
+	env.HoleSpecs = specs
+
+	for name, spec := range specs {
+		if v, ok := env.Fillers[name]; ok {
+			converted, err := spec.Convert(v)
+			if err != nil {
+				return tpl, env, err
+			}
+			env.Fillers[name] = converted
 		}
 	}
 
@@ -198,7 +523,7 @@ func resolveHolesPass(tpl *Template, env *Env) (*Template, *Env, error) {
 func resolveMissingHolesPass(tpl *Template, env *Env) (*Template, *Env, error) {
 	uniqueHoles := make(map[string]struct{})
 	tpl.visitCommandNodes(func(cmd *ast.CommandNode) {
-		for _, v := range cmd.Holes {
+		for _, v := range cmd.UsedHoles() {
 			uniqueHoles[v] = struct{}{}
 		}
 	})
@@ -209,9 +534,32 @@ func resolveMissingHolesPass(tpl *Template, env *Env) (*Template, *Env, error) {
 	sort.Strings(sortedHoles)
 
 	fillers := make(map[string]interface{})
+	var unfilled []string
 	for _, k := range sortedHoles {
-		actual := env.MissingHolesFunc(k)
-		fillers[k] = actual
+		spec, hasSpec := env.HoleSpecs[k]
+		if env.MissingHolesFunc == nil {
+			if !hasSpec || spec.Default == "" {
+				unfilled = append(unfilled, k)
+				continue
+			}
+			fillers[k] = spec.Default
+		} else {
+			fillers[k] = env.MissingHolesFunc(k)
+		}
+		if hasSpec {
+			if v := fillers[k]; (v == nil || v == "") && spec.Default != "" {
+				fillers[k] = spec.Default
+			}
+			converted, err := spec.Convert(fillers[k])
+			if err != nil {
+				return tpl, env, err
+			}
+			fillers[k] = converted
+		}
+	}
+
+	if len(unfilled) > 0 {
+		return tpl, env, fmt.Errorf("unfilled holes: %s", strings.Join(unfilled, ", "))
 	}
 
 	tpl.visitCommandNodes(func(expr *ast.CommandNode) {
@@ -221,6 +569,21 @@ func resolveMissingHolesPass(tpl *Template, env *Env) (*Template, *Env, error) {
 	return tpl, env, nil
 }
 
+// checkRetryParamPass validates the retry params once holes are filled, as
+// they are otherwise only read by the retry policy at run time, which dry runs
+// do not use
+func checkRetryParamPass(tpl *Template, env *Env) (*Template, *Env, error) {
+	var err error
+	tpl.visitCommandNodes(func(cmd *ast.CommandNode) {
+		if v, ok := cmd.Params[driver.RetryParam]; ok && err == nil {
+			if _, perr := driver.RetryAttempts(v); perr != nil {
+				err = fmt.Errorf("%s %s: %s", cmd.Action, cmd.Entity, perr)
+			}
+		}
+	})
+	return tpl, env, err
+}
+
 func resolveAliasPass(tpl *Template, env *Env) (*Template, *Env, error) {
 	var unresolved []string
 	each := func(cmd *ast.CommandNode) {
@@ -250,3 +613,168 @@ func resolveAliasPass(tpl *Template, env *Env) (*Template, *Env, error) {
 
 	return tpl, env, nil
 }
+
+// ensureIdentifyingParams are the params which, along with the name, identify
+// the existing resource of an 'ensure' command (ex: same name in another vpc)
+var ensureIdentifyingParams = []string{"vpc", "subnet", "availabilityzone"}
+
+// resolveEnsurePass turns 'ensure' commands into creations when no resource
+// with the same name and identifying params exists in the local graph.
+// Otherwise, the id of the existing resource is bound to the declared reference
+// and its differing properties are updated when the entity supports it.
+func resolveEnsurePass(tpl *Template, env *Env) (*Template, *Env, error) {
+	var statements []*ast.Statement
+	fills := make(map[string]interface{})
+
+	for _, sts := range tpl.Statements {
+		var ident string
+		var cmd *ast.CommandNode
+		switch sts.Node.(type) {
+		case *ast.CommandNode:
+			cmd = sts.Node.(*ast.CommandNode)
+		case *ast.DeclarationNode:
+			decl := sts.Node.(*ast.DeclarationNode)
+			if expr, ok := decl.Expr.(*ast.CommandNode); ok {
+				ident, cmd = decl.Ident, expr
+			}
+		}
+		if cmd != nil && len(fills) > 0 {
+			cmd.ProcessRefs(fills)
+		}
+		if cmd == nil || cmd.Action != "ensure" {
+			statements = append(statements, sts)
+			continue
+		}
+
+		name, ok := cmd.Params["name"]
+		if !ok {
+			return tpl, env, fmt.Errorf("ensure %s: missing param 'name' to look up an existing resource", cmd.Entity)
+		}
+		filters := map[string]string{"name": fmt.Sprint(name)}
+		var parentCreated bool
+		for _, key := range ensureIdentifyingParams {
+			if val, ok := cmd.Params[key]; ok {
+				filters[key] = fmt.Sprint(val)
+			}
+			if _, ok := cmd.Refs[key]; ok {
+				parentCreated = true
+			}
+		}
+		var res *graph.Resource
+		if !parentCreated {
+			var err error
+			if res, err = findResource(cmd.Entity, filters, env.LookupGraph); err != nil {
+				return tpl, env, fmt.Errorf("ensure %s: %s", cmd.Entity, err)
+			}
+		}
+		if res == nil {
+			env.Log.Verbosef("ensure %s: no existing '%v', it will be created", cmd.Entity, name)
+			cmd.Action = "create"
+			statements = append(statements, sts)
+			continue
+		}
+
+		env.Log.Infof("ensure %s: existing '%v' found (%s), skipping creation", cmd.Entity, name, res.Id())
+		if ident != "" {
+			fills[ident] = res.Id()
+			for prop, v := range res.Properties {
+				fills[ident+"."+prop] = v
+			}
+		}
+
+		if update := ensureUpdate(cmd, res, env); update != nil {
+			statements = append(statements, &ast.Statement{Node: update})
+		}
+	}
+
+	tpl.Statements = statements
+	return tpl, env, nil
+}
+
+// capturePreviousValuesPass records on the updates whose revert rule restores
+// previous values, the values of the updated params found in the local graph
+func capturePreviousValuesPass(tpl *Template, env *Env) (*Template, *Env, error) {
+	if env.DefLookupFunc == nil || env.LookupGraph == nil {
+		return tpl, env, nil
+	}
+
+	tpl.visitCommandNodes(func(cmd *ast.CommandNode) {
+		def, ok := env.DefLookupFunc(fmt.Sprintf("%s%s", cmd.Action, cmd.Entity))
+		if !ok || def.Revert == nil || def.Revert.Previous == nil {
+			return
+		}
+		id, ok := cmd.Params["id"]
+		if !ok {
+			return
+		}
+		res, err := findResource(cmd.Entity, map[string]string{"id": fmt.Sprint(id)}, env.LookupGraph)
+		if err != nil || res == nil {
+			env.Log.ExtraVerbosef("%s %s: no local resource '%v' to capture previous values from", cmd.Action, cmd.Entity, id)
+			return
+		}
+		for param, prop := range def.Revert.Previous {
+			if _, updated := cmd.Params[param]; !updated {
+				continue
+			}
+			if v, ok := res.Properties[prop]; ok {
+				if cmd.CmdPrevious == nil {
+					cmd.CmdPrevious = make(map[string]interface{})
+				}
+				cmd.CmdPrevious[param] = v
+			}
+		}
+	})
+
+	return tpl, env, nil
+}
+
+// ensureUpdate returns the update command of the properties of the existing
+// resource differing from the 'ensure' command params, or nil if none
+func ensureUpdate(cmd *ast.CommandNode, res *graph.Resource, env *Env) *ast.CommandNode {
+	var differing []string
+	for key, val := range cmd.Params {
+		for prop, propVal := range res.Properties {
+			if key != "name" && strings.EqualFold(prop, key) && fmt.Sprint(propVal) != fmt.Sprint(val) {
+				differing = append(differing, key)
+			}
+		}
+	}
+	if len(differing) == 0 {
+		return nil
+	}
+	sort.Strings(differing)
+
+	warnUnsupported := func() *ast.CommandNode {
+		env.Log.Warningf("ensure %s: existing '%s' differs on %s but cannot be updated", cmd.Entity, res.Id(), strings.Join(differing, ", "))
+		return nil
+	}
+
+	def, ok := env.DefLookupFunc(fmt.Sprintf("update%s", cmd.Entity))
+	if !ok {
+		return warnUnsupported()
+	}
+
+	params := map[string]interface{}{"id": res.Id()}
+	for _, key := range differing {
+		if !def.HasParam(key) {
+			return warnUnsupported()
+		}
+		params[key] = cmd.Params[key]
+	}
+	for _, key := range def.Required() {
+		if _, ok := params[key]; ok {
+			continue
+		}
+		val, ok := cmd.Params[key]
+		if !ok {
+			return warnUnsupported()
+		}
+		params[key] = val
+	}
+
+	env.Log.Infof("ensure %s: existing '%s' differs on %s, it will be updated", cmd.Entity, res.Id(), strings.Join(differing, ", "))
+	return &ast.CommandNode{
+		Action: "update", Entity: cmd.Entity,
+		Params: params, Refs: make(map[string]string), Holes: make(map[string]string),
+	}
+}
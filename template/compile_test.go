@@ -1,9 +1,14 @@
 package template
 
 import (
+	"fmt"
 	"reflect"
 	"strings"
 	"testing"
+
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+	"github.com/wallix/awless/template/internal/ast"
 )
 
 func TestCheckReferencesDeclarationPass(t *testing.T) {
@@ -32,6 +37,270 @@ func TestCheckReferencesDeclarationPass(t *testing.T) {
 		!strings.Contains(err.Error(), "sub") {
 		t.Fatalf("expected err with specific words. Got %s", err)
 	}
+	tpl = MustParse(`
+	sub = create subnet
+	create instance name="web in ${sub}"
+	`)
+
+	if _, _, err = checkReferencesDeclaration(tpl, env); err != nil {
+		t.Fatalf("expected interpolated reference to be declared and used. Got %s", err)
+	}
+}
+
+func TestExpandBlocksPass(t *testing.T) {
+	tpl := MustParse(`
+	vpc = create vpc
+	for i in 1-2 {
+	  sub{i} = create subnet vpc=$vpc cidr=10.0.{i}.0/24
+	  for name in web,db {
+	    create instance subnet=$sub{i} name={name}-{i} type={instance.type}
+	  }
+	}`)
+
+	_, _, err := expandBlocksPass(tpl, NewEnv())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exp := `vpc = create vpc
+sub1 = create subnet cidr=10.0.1.0/24 vpc=$vpc
+create instance name=web-1 subnet=$sub1 type={instance.type}
+create instance name=db-1 subnet=$sub1 type={instance.type}
+sub2 = create subnet cidr=10.0.2.0/24 vpc=$vpc
+create instance name=web-2 subnet=$sub2 type={instance.type}
+create instance name=db-2 subnet=$sub2 type={instance.type}`
+	if got, want := tpl.String(), exp; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+
+	if _, _, err = checkReferencesDeclaration(tpl, NewEnv()); err != nil {
+		t.Fatal(err)
+	}
+
+	tpl = MustParse("for i in 1-2 {\ncreate vpc{i}\n}")
+	if _, _, err = expandBlocksPass(tpl, NewEnv()); err == nil || !strings.Contains(err.Error(), "for i in 1,2") {
+		t.Fatalf("expected err on invalid expanded body, got %v", err)
+	}
+}
+
+func TestExpandConditionalBlocks(t *testing.T) {
+	g := graph.NewGraph()
+	g.AddResource(
+		resourcetest.SecGroup("sg-1234").Prop("Name", "my-sg").Build(),
+		resourcetest.Instance("i-1234").Prop("Name", "web").Prop("State", "running").Build(),
+	)
+	env := NewEnv()
+	env.LookupGraph = func(key string) (*graph.Graph, bool) { return g, true }
+
+	tcases := []struct {
+		tpl, exp string
+	}{
+		{tpl: "unless exists securitygroup name=my-sg {\ncreate securitygroup name=my-sg\n}", exp: ""},
+		{tpl: "unless exists securitygroup name=other-sg {\ncreate securitygroup name=other-sg\n}", exp: "create securitygroup name=other-sg"},
+		{tpl: "if exists securitygroup id=sg-1234 {\ndelete securitygroup id=sg-1234\n}", exp: "delete securitygroup id=sg-1234"},
+		{tpl: "if exists instance name=@web state=running {\nstop instance id=i-1234\n}", exp: "stop instance id=i-1234"},
+		{tpl: "if exists instance name=web state=stopped {\nstart instance id=i-1234\n}", exp: ""},
+		{tpl: "if exists instance {\nfor i in 1-2 {\ncreate tag key=k{i}\n}\n}\ncreate vpc", exp: "create tag key=k1\ncreate tag key=k2\ncreate vpc"},
+	}
+
+	for i, tcase := range tcases {
+		tpl := MustParse(tcase.tpl)
+		if _, _, err := expandBlocksPass(tpl, env); err != nil {
+			t.Fatalf("%d: %s", i+1, err)
+		}
+		if got, want := tpl.String(), tcase.exp; got != want {
+			t.Fatalf("%d: got\n%s\nwant\n%s", i+1, got, want)
+		}
+	}
+
+	tpl := MustParse("if exists instance name=web {\nstop instance id=i-1234\n}")
+	if _, _, err := expandBlocksPass(tpl, NewEnv()); err == nil {
+		t.Fatal("expected error when no graph available")
+	}
+}
+
+func TestExpandIncludes(t *testing.T) {
+	templates := map[string]string{
+		"lib/network.awls": "vpc = create vpc cidr={vpc.cidr}\nsubnet = create subnet vpc=$vpc cidr={subnet.cidr} name=\"{name}-subnet\"\ninclude gateway.awls vpc=$vpc",
+		"lib/gateway.awls": "gw = create internetgateway\nattach internetgateway id=$gw vpc={vpc}",
+		"repo:cycle":       "create vpc\ninclude repo:cycle2",
+		"repo:cycle2":      "include repo:cycle",
+		"lib/a.awls":       "create vpc\ninclude b.awls",
+		"lib/b.awls":       "include a.awls",
+	}
+	env := NewEnv()
+	env.IncludeFunc = func(source string) ([]byte, error) {
+		if text, ok := templates[source]; ok {
+			return []byte(text), nil
+		}
+		return nil, fmt.Errorf("not found")
+	}
+
+	tpl := MustParse(`
+	net = include lib/network.awls vpc.cidr=10.0.0.0/16 subnet.cidr=10.0.0.0/24 name={app.name}
+	create instance subnet=$net.subnet`)
+
+	if _, _, err := expandBlocksPass(tpl, env); err != nil {
+		t.Fatal(err)
+	}
+
+	exp := `net.vpc = create vpc cidr=10.0.0.0/16
+net.subnet = create subnet cidr=10.0.0.0/24 name="{app.name}-subnet" vpc=$net.vpc
+net.gw = create internetgateway
+attach internetgateway id=$net.gw vpc=$net.vpc
+create instance subnet=$net.subnet`
+	if got, want := tpl.String(), exp; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+	if _, _, err := checkReferencesDeclaration(tpl, env); err != nil {
+		t.Fatal(err)
+	}
+
+	tpl = MustParse("include lib/network.awls vpc.cidr=10.0.0.0/16 subnet.cidr=10.0.0.0/24 name=app")
+	if _, _, err := expandBlocksPass(tpl, env); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := checkReferencesDeclaration(tpl, env); err != nil {
+		t.Fatalf("expected unused included references to be discarded, got %s", err)
+	}
+	if got, want := tpl.Statements[1].String(), "create subnet cidr=10.0.0.0/24 name=app-subnet vpc=$vpc"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	tcases := []struct {
+		tpl, expErr string
+	}{
+		{tpl: "include repo:cycle", expErr: "cyclic include: repo:cycle -> repo:cycle2 -> repo:cycle"},
+		{tpl: "include lib/gateway.awls unknown=1", expErr: "unexpected param 'unknown'"},
+		{tpl: "include lib/missing.awls", expErr: "include lib/missing.awls: not found"},
+	}
+	for i, tcase := range tcases {
+		_, _, err := expandBlocksPass(MustParse(tcase.tpl), env)
+		if err == nil || !strings.Contains(err.Error(), tcase.expErr) {
+			t.Fatalf("%d: expected error containing '%s', got %v", i+1, tcase.expErr, err)
+		}
+	}
+
+	rootEnv := NewEnv()
+	rootEnv.IncludeFunc = env.IncludeFunc
+	rootEnv.Source = "./lib/main.awls"
+	tpl = MustParse("include gateway.awls vpc=vpc-1")
+	if _, _, err := expandBlocksPass(tpl, rootEnv); err != nil {
+		t.Fatalf("expected top-level include relative to the root template, got %s", err)
+	}
+	if got, want := tpl.Statements[1].String(), "attach internetgateway id=$gw vpc=vpc-1"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	rootEnv.Source = "lib/a.awls"
+	_, _, err := expandBlocksPass(MustParse(templates["lib/a.awls"]), rootEnv)
+	if got, want := fmt.Sprint(err), "cyclic include: lib/a.awls -> lib/b.awls -> lib/a.awls"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	if _, _, err := expandBlocksPass(MustParse("include lib/gateway.awls"), NewEnv()); err == nil {
+		t.Fatal("expected error when no template loader available")
+	}
+}
+
+func TestResolveEnsurePass(t *testing.T) {
+	g := graph.NewGraph()
+	g.AddResource(
+		resourcetest.Instance("i-1234").Prop("Name", "web").Prop("Type", "t2.micro").Build(),
+		resourcetest.Instance("i-5678").Prop("Name", "db").Prop("Type", "t2.micro").Build(),
+		resourcetest.SecGroup("sg-1234").Prop("Name", "my-sg").Prop("Description", "old").Prop("Vpc", "vpc-1").Build(),
+		resourcetest.SecGroup("sg-5678").Prop("Name", "my-sg").Prop("Description", "old").Prop("Vpc", "vpc-2").Build(),
+	)
+	env := NewEnv()
+	env.LookupGraph = func(key string) (*graph.Graph, bool) { return g, true }
+	env.DefLookupFunc = func(in string) (Definition, bool) {
+		defs := map[string]Definition{
+			"updateinstance": {Action: "update", Entity: "instance", RequiredParams: []string{"id"}, ExtraParams: []string{"type"}},
+		}
+		d, ok := defs[in]
+		return d, ok
+	}
+
+	tpl := MustParse(`web = ensure instance name=web type=t2.small
+db = ensure instance name=db type=t2.micro
+other = ensure instance name=new type=t2.micro
+sg = ensure securitygroup name=my-sg vpc=vpc-1 description=new
+create tag resource=$web key=k value=v
+create tag resource=$db key=k value=v
+create tag resource=$other key=k value=v
+create tag resource=$sg key=k value="type ${db.Type}"`)
+
+	tpl, _, err := resolveEnsurePass(tpl, env)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exp := `update instance id=i-1234 type=t2.small
+other = create instance name=new type=t2.micro
+create tag key=k resource=i-1234 value=v
+create tag key=k resource=i-5678 value=v
+create tag key=k resource=$other value=v
+create tag key=k resource=sg-1234 value="type t2.micro"`
+	if got, want := tpl.String(), exp; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+
+	tpl, _, err = resolveEnsurePass(MustParse(`a = ensure securitygroup name=my-sg vpc=vpc-2 description=old
+b = ensure securitygroup name=my-sg vpc=vpc-3 description=old
+c = ensure securitygroup name=my-sg vpc=$newvpc description=old
+create tag resource=$a key=k value=v`), env)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp = `b = create securitygroup description=old name=my-sg vpc=vpc-3
+c = create securitygroup description=old name=my-sg vpc=$newvpc
+create tag key=k resource=sg-5678 value=v`
+	if got, want := tpl.String(), exp; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+
+	if _, _, err = resolveEnsurePass(MustParse("ensure instance type=t2.micro"), env); err == nil || !strings.Contains(err.Error(), "name") {
+		t.Fatalf("expected error on missing name, got %v", err)
+	}
+}
+
+func TestCapturePreviousValuesPass(t *testing.T) {
+	g := graph.NewGraph()
+	g.AddResource(
+		resourcetest.Instance("i-1234").Prop("Type", "t2.micro").Prop("SecurityGroups", []string{"sg-1", "sg-2"}).Build(),
+	)
+	env := NewEnv()
+	env.LookupGraph = func(key string) (*graph.Graph, bool) { return g, true }
+	env.DefLookupFunc = func(in string) (Definition, bool) {
+		defs := map[string]Definition{
+			"updateinstance": {Action: "update", Entity: "instance", RequiredParams: []string{"id"}, ExtraParams: []string{"group", "lock", "type"},
+				Revert: &RevertRule{Action: "update", Params: map[string]string{"id": "id"}, Previous: map[string]string{"type": "Type", "group": "SecurityGroups"}},
+			},
+		}
+		d, ok := defs[in]
+		return d, ok
+	}
+
+	tpl := MustParse("update instance id=i-1234 type=t2.small lock=true\nupdate instance id=i-5678 type=t2.small\ncreate instance type=t2.small")
+	tpl, _, err := capturePreviousValuesPass(tpl, env)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cmds := tpl.CommandNodesIterator()
+	if got, want := cmds[0].CmdPrevious, map[string]interface{}{"type": "t2.micro"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+	for _, cmd := range cmds[1:] {
+		if cmd.CmdPrevious != nil {
+			t.Fatalf("%s: expected no previous values, got %#v", cmd, cmd.CmdPrevious)
+		}
+	}
+
+	clone := tpl.Statements[0].Clone().Node.(*ast.CommandNode)
+	if got, want := clone.CmdPrevious, cmds[0].CmdPrevious; !reflect.DeepEqual(got, want) {
+		t.Fatalf("clone: got %#v, want %#v", got, want)
+	}
 }
 
 func TestResolveAgainstDefinitionsPass(t *testing.T) {
@@ -102,7 +371,8 @@ func TestResolveMissingHolesPass(t *testing.T) {
 	tpl := MustParse(`
 	create instance subnet={instance.subnet} type={instance.type} name={redis.prod}
 	create vpc cidr={vpc.cidr}
-	create instance name={redis.prod} id={redis.prod} count=3`)
+	create instance name={redis.prod} id={redis.prod} count=3
+	create tag key=Name value="{redis.prod} ({instance.type})"`)
 
 	var count int
 	env := NewEnv()
@@ -135,9 +405,92 @@ func TestResolveMissingHolesPass(t *testing.T) {
 		map[string]interface{}{"type": "t2.micro", "name": "redis-124.32.34.54", "subnet": "sub-98765"},
 		map[string]interface{}{"cidr": "10.0.0.0/24"},
 		map[string]interface{}{"id": "redis-124.32.34.54", "name": "redis-124.32.34.54", "count": 3},
+		map[string]interface{}{"key": "Name", "value": "redis-124.32.34.54 (t2.micro)"},
 	)
 }
 
+func TestResolveMissingHolesWithoutPrompt(t *testing.T) {
+	env := NewEnv()
+	env.MissingHolesFunc = nil
+	env.AddFillers(map[string]interface{}{"instance.type": "t2.micro"})
+
+	tpl := MustParse(`create instance subnet={instance.subnet} type={instance.type} name="web-{env}"`)
+
+	pass := newMultiPass(resolveHolesPass, resolveMissingHolesPass)
+	_, _, err := pass.compile(tpl, env)
+	if err == nil || err.Error() != "unfilled holes: env, instance.subnet" {
+		t.Fatalf("expected err listing unfilled holes, got %v", err)
+	}
+
+	env.AddFillers(map[string]interface{}{"instance.subnet": "sub-1234", "env": "prod"})
+	if _, _, err = pass.compile(MustParse(`create instance subnet={instance.subnet} type={instance.type} name="web-{env}"`), env); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestResolveHoleSpecs(t *testing.T) {
+	text := `create instance count={instance.count:int=2} subnet={instance.subnet:id(subnet)}
+	create vpc cidr={vpc.cidr:cidr="10.0.0.0/16" "cidr of the vpc"}`
+
+	t.Run("defaults used without prompt", func(t *testing.T) {
+		env := NewEnv()
+		env.MissingHolesFunc = nil
+		env.AddFillers(map[string]interface{}{"instance.subnet": "subnet-1234"})
+
+		pass := newMultiPass(resolveHoleSpecsPass, resolveHolesPass, resolveMissingHolesPass)
+		tpl, _, err := pass.compile(MustParse(text), env)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertCmdParams(t, tpl,
+			map[string]interface{}{"count": 2, "subnet": "subnet-1234"},
+			map[string]interface{}{"cidr": "10.0.0.0/16"},
+		)
+		if got, want := env.HoleSpecs["vpc.cidr"].Description, "cidr of the vpc"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	})
+
+	t.Run("values converted and validated", func(t *testing.T) {
+		env := NewEnv()
+		env.AddFillers(map[string]interface{}{"instance.count": "3", "instance.subnet": "subnet-1234"})
+		env.MissingHolesFunc = func(hole string) interface{} { return "" }
+
+		pass := newMultiPass(resolveHoleSpecsPass, resolveHolesPass, resolveMissingHolesPass)
+		tpl, _, err := pass.compile(MustParse(text), env)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertCmdParams(t, tpl,
+			map[string]interface{}{"count": 3, "subnet": "subnet-1234"},
+			map[string]interface{}{"cidr": "10.0.0.0/16"},
+		)
+
+		env = NewEnv()
+		env.AddFillers(map[string]interface{}{"instance.count": "many"})
+		if _, _, err = pass.compile(MustParse(text), env); err == nil || !strings.Contains(err.Error(), "invalid int value 'many'") {
+			t.Fatalf("expected invalid value error, got %v", err)
+		}
+
+		env = NewEnv()
+		env.MissingHolesFunc = func(hole string) interface{} { return "10.0.0.0" }
+		if _, _, err = pass.compile(MustParse(`create vpc cidr={vpc.cidr:cidr}`), env); err == nil || !strings.Contains(err.Error(), "invalid cidr value") {
+			t.Fatalf("expected invalid value error, got %v", err)
+		}
+	})
+
+	t.Run("invalid declarations", func(t *testing.T) {
+		for _, text := range []string{
+			"create vpc cidr={vpc.cidr:cidr=10.0.0.0}",
+			"create vpc cidr={vpc.cidr:cidr}\ncreate subnet cidr={vpc.cidr:ip}",
+		} {
+			if _, _, err := resolveHoleSpecsPass(MustParse(text), NewEnv()); err == nil {
+				t.Fatalf("expected error for %s", text)
+			}
+		}
+	})
+}
+
 func TestResolveAliasPass(t *testing.T) {
 	tpl := MustParse("create instance subnet=@my-subnet ami={instance.ami} count=3")
 
@@ -179,6 +532,41 @@ func TestResolveHolesPass(t *testing.T) {
 	assertCmdParams(t, tpl, map[string]interface{}{"type": "t2.micro", "count": 3})
 }
 
+func TestCheckRetryParamPass(t *testing.T) {
+	tcases := []struct {
+		tpl    string
+		fills  map[string]interface{}
+		expErr string
+	}{
+		{tpl: "create instance name=web retry=5"},
+		{tpl: "create instance name=web retry=$attempts"},
+		{tpl: "create instance name=web retry={instance.retry}", fills: map[string]interface{}{"instance.retry": 3}},
+		{tpl: "create instance name=web\ncreate keypair name=key retry=many", expErr: "create keypair: retry param is not a positive int: many"},
+		{tpl: "create instance name=web retry=0", expErr: "create instance: retry param is not a positive int: 0"},
+		{tpl: "create instance name=web retry={instance.retry}", fills: map[string]interface{}{"instance.retry": "few"}, expErr: "create instance: retry param is not a positive int: few"},
+	}
+
+	for i, tcase := range tcases {
+		env := NewEnv()
+		env.AddFillers(tcase.fills)
+		pass := newMultiPass(resolveHolesPass, checkRetryParamPass)
+
+		_, _, err := pass.compile(MustParse(tcase.tpl), env)
+		if tcase.expErr == "" {
+			if err != nil {
+				t.Fatalf("%d: %s", i+1, err)
+			}
+			continue
+		}
+		if err == nil {
+			t.Fatalf("%d: expected error", i+1)
+		}
+		if got, want := err.Error(), tcase.expErr; got != want {
+			t.Fatalf("%d: got %s, want %s", i+1, got, want)
+		}
+	}
+}
+
 type params map[string]interface{}
 type paramsPerCommand []params
 
@@ -35,6 +35,37 @@ func (defs Definitions) Map(fn func(Definition) string) (reduced []string) {
 type Definition struct {
 	Action, Entity, Api         string
 	RequiredParams, ExtraParams []string
+	// Revert is the rule to revert a successful run of the command, nil if
+	// the command cannot be reverted
+	Revert *RevertRule
+	// DeprecatedParams are the params still accepted but superseded by
+	// another param (ex: {"oldparam": "newparam"})
+	DeprecatedParams map[string]string
+	// PlannedProperties are the properties of the resource predicted
+	// once the command has run (ex: State=stopped)
+	PlannedProperties map[string]interface{}
+}
+
+// RevertRule declares how to revert a command with its inverse command
+type RevertRule struct {
+	Action string
+	// ResultParam is the inverse command param set to the command result (ex: id)
+	ResultParam string
+	// Params maps the inverse command params to the command params they copy
+	Params map[string]string
+	// Constants are inverse command params with a fixed value (ex: skipsnapshot=true)
+	Constants map[string]string
+	// Inverted maps, per param, a command param value to its inverse value
+	// (ex: inbound=authorize is reverted with inbound=revoke)
+	Inverted map[string]map[string]string
+	// Previous maps the params of an update to the properties of the resource
+	// holding their values, captured from the local graph before the run
+	// to be restored when reverting
+	Previous map[string]string
+	// CheckState and CheckTimeout declare the check waiting for the inverse
+	// command to complete on the resource (ex: state=terminated timeout=180)
+	CheckState   string
+	CheckTimeout int
 }
 
 func (def Definition) Name() string {
@@ -60,3 +91,18 @@ func (def Definition) Required() []string {
 func (def Definition) Extra() []string {
 	return def.ExtraParams
 }
+
+// HasParam returns true if the key is a required or an extra param of the definition
+func (def Definition) HasParam(key string) bool {
+	for _, k := range def.Required() {
+		if k == key {
+			return true
+		}
+	}
+	for _, k := range def.Extra() {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+package template
+
+import (
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+// statementsDependencies returns for each statement the indexes of the
+// previous statements that must be completed before it can run.
+//
+// A statement depends on:
+//   - the declarations of the references it uses (or whose outputs it uses)
+//   - any previous statement whose 'id' or 'name' param value it uses
+//     (ex: 'create user name=bob' then 'attach policy user=bob')
+//   - the previous 'check' statement, as checks act as barriers:
+//     a check waits for all the previous statements to complete
+//   - the previous 'delete' statement, as deletions run in the written order
+//     (ex: teardown of an instance, then its subnet, then its vpc)
+func statementsDependencies(statements []*ast.Statement) [][]int {
+	deps := make([][]int, len(statements))
+
+	declared := make(map[string]int)
+	identifiers := make(map[string][]int)
+	lastCheck, lastDelete := -1, -1
+
+	for i, sts := range statements {
+		unique := make(map[int]struct{})
+		addDep := func(j int) {
+			if _, done := unique[j]; !done {
+				unique[j] = struct{}{}
+				deps[i] = append(deps[i], j)
+			}
+		}
+
+		cmd := commandNode(sts)
+		if cmd != nil {
+			if cmd.Action == "check" {
+				for j := 0; j < i; j++ {
+					addDep(j)
+				}
+			} else if lastCheck > -1 {
+				addDep(lastCheck)
+			}
+			if cmd.Action == "delete" && lastDelete > lastCheck {
+				addDep(lastDelete)
+			}
+
+			for _, ref := range cmd.UsedRefs() {
+				decl, _ := splitRefProperty(ref, func(r string) bool { _, ok := declared[r]; return ok })
+				if j, ok := declared[decl]; ok {
+					addDep(j)
+				}
+			}
+
+			for _, v := range cmd.Params {
+				for _, val := range stringValues(v) {
+					for _, j := range identifiers[val] {
+						addDep(j)
+					}
+				}
+			}
+
+			for _, key := range []string{"id", "name"} {
+				for _, val := range stringValues(cmd.Params[key]) {
+					identifiers[val] = append(identifiers[val], i)
+				}
+			}
+
+			if cmd.Action == "check" {
+				lastCheck = i
+			}
+			if cmd.Action == "delete" {
+				lastDelete = i
+			}
+		}
+
+		if decl, ok := sts.Node.(*ast.DeclarationNode); ok {
+			declared[decl.Ident] = i
+		}
+	}
+
+	return deps
+}
+
+func commandNode(sts *ast.Statement) *ast.CommandNode {
+	switch n := sts.Node.(type) {
+	case *ast.CommandNode:
+		return n
+	case *ast.DeclarationNode:
+		if cmd, ok := n.Expr.(*ast.CommandNode); ok {
+			return cmd
+		}
+	}
+	return nil
+}
+
+func stringValues(v interface{}) []string {
+	switch vv := v.(type) {
+	case string:
+		return []string{vv}
+	case []string:
+		return vv
+	}
+	return nil
+}
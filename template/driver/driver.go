@@ -17,8 +17,10 @@ limitations under the License.
 package driver
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/wallix/awless/logger"
 )
@@ -31,10 +33,28 @@ type Driver interface {
 	SetLogger(*logger.Logger)
 }
 
-type DriverFn func(map[string]interface{}) (interface{}, error)
+// DriverFn runs a template command given its params. Long running functions
+// (ex: check, uploads) stop when the context is cancelled or its deadline expires.
+type DriverFn func(context.Context, map[string]interface{}) (interface{}, error)
+
+// Output is a structured driver function result: the resource ID is the
+// command result while named properties (ex: PrivateIP) can be referenced
+// in templates as $ref.Property
+type Output struct {
+	ID         interface{}
+	Properties map[string]interface{}
+	// Sensitive properties (ex: secrets) are referenced as properties but
+	// never stored with the template, printed or sent to hooks
+	Sensitive map[string]interface{}
+	// Attempts is the number of calls made when the function has been retried
+	Attempts int
+}
 
 type MultiDriver struct {
 	drivers []Driver
+	retry   *RetryPolicy
+	logger  *logger.Logger
+	dryRun  bool
 }
 
 func NewMultiDriver(drivers ...Driver) Driver {
@@ -42,17 +62,25 @@ func NewMultiDriver(drivers ...Driver) Driver {
 }
 
 func (d *MultiDriver) SetDryRun(dry bool) {
+	d.dryRun = dry
 	for _, dr := range d.drivers {
 		dr.SetDryRun(dry)
 	}
 }
 
 func (d *MultiDriver) SetLogger(l *logger.Logger) {
+	d.logger = l
 	for _, dr := range d.drivers {
 		dr.SetLogger(l)
 	}
 }
 
+// SetRetryPolicy makes the functions looked up retry on retryable errors.
+// Functions looked up in dry run are not retried.
+func (d *MultiDriver) SetRetryPolicy(p *RetryPolicy) {
+	d.retry = p
+}
+
 func (d *MultiDriver) Lookup(lookups ...string) (driverFn DriverFn, err error) {
 	var funcs []DriverFn
 	for _, dr := range d.drivers {
@@ -69,7 +97,14 @@ func (d *MultiDriver) Lookup(lookups ...string) (driverFn DriverFn, err error) {
 	case 0:
 		return nil, fmt.Errorf("function corresponding to '%v' not found in drivers", lookups)
 	case 1:
-		return funcs[0], nil
+		if d.retry == nil || d.dryRun {
+			return funcs[0], nil
+		}
+		log := d.logger
+		if log == nil {
+			log = logger.DiscardLogger
+		}
+		return d.retry.Wrap(strings.Join(lookups, " "), funcs[0], log), nil
 	default:
 		return nil, fmt.Errorf("%d functions corresponding to '%v' found in drivers", len(funcs), lookups)
 	}
@@ -1,6 +1,7 @@
 package driver_test
 
 import (
+	"context"
 	"errors"
 	"reflect"
 	"testing"
@@ -10,10 +11,10 @@ import (
 )
 
 func TestMultiDriver(t *testing.T) {
-	ab := func(map[string]interface{}) (interface{}, error) { return "ab", nil }
-	bc := func(map[string]interface{}) (interface{}, error) { return "bc", nil }
-	de := func(map[string]interface{}) (interface{}, error) { return "de", nil }
-	ef := func(map[string]interface{}) (interface{}, error) { return "ef", nil }
+	ab := func(context.Context, map[string]interface{}) (interface{}, error) { return "ab", nil }
+	bc := func(context.Context, map[string]interface{}) (interface{}, error) { return "bc", nil }
+	de := func(context.Context, map[string]interface{}) (interface{}, error) { return "de", nil }
+	ef := func(context.Context, map[string]interface{}) (interface{}, error) { return "ef", nil }
 	mock1 := &mockDriver{
 		lookupFn: func(lookups ...string) (driverFn driver.DriverFn, err error) {
 			if len(lookups) != 1 {
@@ -91,6 +92,21 @@ func TestMultiDriver(t *testing.T) {
 		}
 	}
 
+	d.(*driver.MultiDriver).SetRetryPolicy(&driver.RetryPolicy{MaxAttempts: 3})
+	fn, err := d.Lookup("ab")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := reflect.ValueOf(fn).Pointer(), reflect.ValueOf(ab).Pointer(); got != want {
+		t.Fatal("expected dry run functions not to be retried")
+	}
+	d.SetDryRun(false)
+	if fn, err = d.Lookup("ab"); err != nil {
+		t.Fatal(err)
+	}
+	if got, notwant := reflect.ValueOf(fn).Pointer(), reflect.ValueOf(ab).Pointer(); got == notwant {
+		t.Fatal("expected functions to be retried")
+	}
 }
 
 type mockDriver struct {
@@ -0,0 +1,166 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/wallix/awless/logger"
+)
+
+// RetryParam is the statement param overriding the maximum number of
+// attempts of the retry policy (ex: create instance ... retry=5)
+const RetryParam = "retry"
+
+// ErrorKind classifies the errors of driver functions
+type ErrorKind int
+
+const (
+	FatalError ErrorKind = iota
+	ThrottlingError
+	NotFoundYetError
+	ConflictError
+)
+
+func (k ErrorKind) String() string {
+	switch k {
+	case ThrottlingError:
+		return "throttling"
+	case NotFoundYetError:
+		return "not-found-yet"
+	case ConflictError:
+		return "conflict"
+	default:
+		return "fatal"
+	}
+}
+
+// Retryable returns true for transient errors that may succeed when retried
+func (k ErrorKind) Retryable() bool {
+	return k != FatalError
+}
+
+// RetryPolicy retries driver functions failing with retryable errors,
+// waiting between attempts with an exponential backoff and a random jitter
+type RetryPolicy struct {
+	MaxAttempts         int
+	BaseDelay, MaxDelay time.Duration
+	Classify            func(error) ErrorKind
+}
+
+// RetryError is returned by retried driver functions failing after several attempts
+type RetryError struct {
+	Attempts int
+	Kind     ErrorKind
+	Err      error
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("%s (%s error, after %d attempts)", e.Err, e.Kind, e.Attempts)
+}
+
+// Wrap returns the driver function retrying the given one according to the policy.
+// When retried, a successful result is returned as an Output with its attempts.
+// The context cancellation stops waiting for the next attempt.
+func (p *RetryPolicy) Wrap(name string, fn DriverFn, log *logger.Logger) DriverFn {
+	return func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+		maxAttempts := p.MaxAttempts
+		if v, ok := params[RetryParam]; ok {
+			n, err := RetryAttempts(v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s", name, err)
+			}
+			maxAttempts = n
+			params = withoutParam(params, RetryParam)
+		}
+
+		for attempt := 1; ; attempt++ {
+			result, err := fn(ctx, params)
+			if err == nil {
+				if attempt == 1 {
+					return result, nil
+				}
+				log.Verbosef("%s: succeeded after %d attempts", name, attempt)
+				return withAttempts(result, attempt), nil
+			}
+
+			kind := FatalError
+			if p.Classify != nil {
+				kind = p.Classify(err)
+			}
+			if !kind.Retryable() || attempt >= maxAttempts {
+				if attempt == 1 {
+					return nil, err
+				}
+				return nil, &RetryError{Attempts: attempt, Kind: kind, Err: err}
+			}
+
+			delay := p.backoff(attempt)
+			log.Verbosef("%s: %s error on attempt %d/%d, retry in %s: %s", name, kind, attempt, maxAttempts, delay, err)
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return nil, &RetryError{Attempts: attempt, Kind: kind, Err: err}
+			}
+		}
+	}
+}
+
+// RetryAttempts returns the maximum number of attempts given as value of
+// the retry param, failing if it is not a positive int
+func RetryAttempts(v interface{}) (int, error) {
+	n, ok := v.(int)
+	if !ok || n < 1 {
+		return 0, fmt.Errorf("%s param is not a positive int: %v", RetryParam, v)
+	}
+	return n, nil
+}
+
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < attempt && (p.MaxDelay <= 0 || delay < p.MaxDelay); i++ {
+		delay *= 2
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+func withAttempts(result interface{}, attempts int) *Output {
+	if out, ok := result.(*Output); ok {
+		out.Attempts = attempts
+		return out
+	}
+	return &Output{ID: result, Attempts: attempts}
+}
+
+func withoutParam(params map[string]interface{}, key string) map[string]interface{} {
+	copied := make(map[string]interface{})
+	for k, v := range params {
+		if k != key {
+			copied[k] = v
+		}
+	}
+	return copied
+}
@@ -0,0 +1,122 @@
+package driver_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/driver"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	errThrottling, errFatal := errors.New("throttling"), errors.New("fatal")
+	policy := &driver.RetryPolicy{MaxAttempts: 3, Classify: func(err error) driver.ErrorKind {
+		if err == errThrottling {
+			return driver.ThrottlingError
+		}
+		return driver.FatalError
+	}}
+
+	failingFn := func(errs ...error) (driver.DriverFn, *[]map[string]interface{}) {
+		var calls []map[string]interface{}
+		return func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+			calls = append(calls, params)
+			if len(calls) <= len(errs) {
+				return nil, errs[len(calls)-1]
+			}
+			return "id-1", nil
+		}, &calls
+	}
+
+	t.Run("succeed after retries", func(t *testing.T) {
+		fn, calls := failingFn(errThrottling, errThrottling)
+		res, err := policy.Wrap("create instance", fn, logger.DiscardLogger)(context.Background(), map[string]interface{}{"name": "web"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := res, (&driver.Output{ID: "id-1", Attempts: 3}); !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %#v, want %#v", got, want)
+		}
+		if got, want := len(*calls), 3; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+	})
+
+	t.Run("succeed at first attempt", func(t *testing.T) {
+		fn, _ := failingFn()
+		res, err := policy.Wrap("create instance", fn, logger.DiscardLogger)(context.Background(), map[string]interface{}{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := res, "id-1"; got != want {
+			t.Fatalf("got %#v, want %#v", got, want)
+		}
+	})
+
+	t.Run("no retry on fatal error", func(t *testing.T) {
+		fn, calls := failingFn(errFatal)
+		_, err := policy.Wrap("create instance", fn, logger.DiscardLogger)(context.Background(), map[string]interface{}{})
+		if got, want := err, errFatal; got != want {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		if got, want := len(*calls), 1; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+	})
+
+	t.Run("attempts exhausted", func(t *testing.T) {
+		fn, calls := failingFn(errThrottling, errThrottling, errThrottling, errThrottling)
+		_, err := policy.Wrap("create instance", fn, logger.DiscardLogger)(context.Background(), map[string]interface{}{})
+		retryErr, ok := err.(*driver.RetryError)
+		if !ok {
+			t.Fatalf("expected retry error, got %#v", err)
+		}
+		if got, want := retryErr.Attempts, 3; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		if got, want := retryErr.Kind, driver.ThrottlingError; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got, want := len(*calls), 3; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+	})
+
+	t.Run("statement retry param", func(t *testing.T) {
+		fn, calls := failingFn(errThrottling, errThrottling, errThrottling, errThrottling)
+		res, err := policy.Wrap("create instance", fn, logger.DiscardLogger)(context.Background(), map[string]interface{}{"name": "web", "retry": 5})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := res.(*driver.Output).Attempts, 5; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		if got, want := (*calls)[0], map[string]interface{}{"name": "web"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+
+		if _, err = policy.Wrap("create instance", fn, logger.DiscardLogger)(context.Background(), map[string]interface{}{"retry": "many"}); err == nil {
+			t.Fatal("expected error on invalid retry param")
+		}
+	})
+	t.Run("no wait once cancelled", func(t *testing.T) {
+		fn, calls := failingFn(errThrottling, errThrottling)
+		slow := &driver.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Hour, Classify: policy.Classify}
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := slow.Wrap("create instance", fn, logger.DiscardLogger)(ctx, map[string]interface{}{})
+		retryErr, ok := err.(*driver.RetryError)
+		if !ok {
+			t.Fatalf("expected retry error, got %#v", err)
+		}
+		if got, want := retryErr.Err, errThrottling; got != want {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		if got, want := len(*calls), 1; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+	})
+}
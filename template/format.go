@@ -0,0 +1,230 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+// Format returns the template text in its canonical form: statements are
+// re-emitted from the AST (with sorted params), block bodies are indented
+// with a tab, comments are kept and consecutive blank lines collapsed
+func Format(text string) (string, error) {
+	return formatText(text, nil)
+}
+
+// loopVariable is the variable of an enclosing for loop, replaced with its
+// first value for the body to parse (ex: cidr=10.0.{i}.0/24)
+type loopVariable struct {
+	name, value string
+}
+
+func formatText(text string, vars []loopVariable) (string, error) {
+	runes := []rune(text)
+	expanded, offsets := expandLoopVariables(runes, vars)
+	tpl, err := Parse(string(expanded))
+	if err != nil {
+		return "", err
+	}
+
+	var buff bytes.Buffer
+	writeBetween := func(chunk string) { // comments and blank lines
+		lines := strings.Split(chunk, "\n")
+		for i, line := range lines {
+			buff.WriteString(strings.TrimSpace(line))
+			if i < len(lines)-1 {
+				buff.WriteByte('\n')
+			}
+		}
+	}
+
+	var last int
+	for _, sts := range tpl.Statements {
+		pos, end := offsets[sts.Pos], offsets[sts.End]
+		writeBetween(string(runes[last:pos]))
+		formatted, err := formatStatement(string(runes[pos:end]), sts, vars)
+		if err != nil {
+			return "", err
+		}
+		buff.WriteString(formatted)
+		last = end
+	}
+	writeBetween(string(runes[last:]))
+
+	var lines []string
+	for _, line := range strings.Split(buff.String(), "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		if line == "" && (len(lines) == 0 || lines[len(lines)-1] == "") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return strings.Join(lines, "\n") + "\n", nil
+}
+
+// formatStatement formats the statement parsed from the source. Statements
+// using loop variables are formatted from their AST and their source values.
+func formatStatement(src string, sts *ast.Statement, vars []loopVariable) (string, error) {
+	header, body := splitBlock(src)
+	switch sts.Node.(type) {
+	case *ast.ForNode:
+		loop := sts.Node.(*ast.ForNode)
+		if len(loop.Values) > 0 {
+			vars = append(vars, loopVariable{name: loop.Variable, value: loop.Values[0]})
+		}
+	case *ast.IfNode:
+		if !usesLoopVariables(header, vars) {
+			header = sts.Node.(*ast.IfNode).Condition()
+		}
+	default:
+		if !usesLoopVariables(src, vars) {
+			return sts.String(), nil
+		}
+		return formatCommandSource(src, sts), nil
+	}
+
+	formatted, err := formatText(body, vars)
+	if err != nil {
+		return "", fmt.Errorf("%s: %s", header, err)
+	}
+
+	var buff bytes.Buffer
+	fmt.Fprintf(&buff, "%s {\n", header)
+	for _, line := range strings.Split(strings.TrimSuffix(formatted, "\n"), "\n") {
+		if line != "" {
+			fmt.Fprintf(&buff, "\t%s", line)
+		}
+		buff.WriteByte('\n')
+	}
+	buff.WriteString("}")
+
+	return buff.String(), nil
+}
+
+// formatCommandSource returns the command with the params of its AST sorted,
+// keeping their values as written in the source
+func formatCommandSource(src string, sts *ast.Statement) string {
+	var prefix string
+	var cmd *ast.CommandNode
+	switch sts.Node.(type) {
+	case *ast.CommandNode:
+		cmd = sts.Node.(*ast.CommandNode)
+	case *ast.DeclarationNode:
+		decl := sts.Node.(*ast.DeclarationNode)
+		if expr, ok := decl.Expr.(*ast.CommandNode); ok {
+			prefix, cmd = strings.TrimSpace(src[:strings.Index(src, "=")])+" = ", expr
+		}
+	}
+	if cmd == nil {
+		return strings.Join(strings.Fields(src), " ")
+	}
+
+	runes := []rune(src)
+	positions := paramKeysPositions(cmd, src)
+	var starts []int
+	for _, offsets := range positions {
+		starts = append(starts, offsets...)
+	}
+	sort.Ints(starts)
+
+	var params []string
+	for key, offsets := range positions {
+		start, end := offsets[len(offsets)-1], len(runes)
+		for _, next := range starts {
+			if next > start {
+				end = next
+				break
+			}
+		}
+		param := string(runes[start:end])
+		value := strings.TrimSpace(param[strings.Index(param, "=")+1:])
+		params = append(params, fmt.Sprintf("%s=%s", key, value))
+	}
+	sort.Strings(params)
+
+	return fmt.Sprintf("%s%s %s %s", prefix, cmd.Action, cmd.Entity, strings.Join(params, " "))
+}
+
+// splitBlock returns the header and the body of a block statement source,
+// the body being between the brace ending the first line and the last brace
+func splitBlock(src string) (header, body string) {
+	open := strings.Index(src, "\n")
+	if open < 0 {
+		open = len(src)
+	}
+	open = strings.LastIndex(src[:open], "{")
+	end := strings.LastIndex(src, "}")
+	if open < 0 || end < open {
+		return src, ""
+	}
+	return strings.Join(strings.Fields(src[:open]), " "), src[open+1 : end]
+}
+
+func usesLoopVariables(src string, vars []loopVariable) bool {
+	for _, v := range vars {
+		if strings.Contains(src, fmt.Sprintf("{%s}", v.name)) {
+			return true
+		}
+	}
+	return false
+}
+
+// expandLoopVariables returns the text with the loop variables replaced with
+// their value, and for each rune offset in it (and its end), the offset in text
+func expandLoopVariables(text []rune, vars []loopVariable) ([]rune, []int) {
+	var expanded []rune
+	var offsets []int
+	for i := 0; i < len(text); {
+		var replaced bool
+		for _, v := range vars {
+			variable := []rune(fmt.Sprintf("{%s}", v.name))
+			if hasRunesPrefix(text[i:], variable) {
+				for _, r := range v.value {
+					expanded, offsets = append(expanded, r), append(offsets, i)
+				}
+				i, replaced = i+len(variable), true
+				break
+			}
+		}
+		if !replaced {
+			expanded, offsets = append(expanded, text[i]), append(offsets, i)
+			i++
+		}
+	}
+	return expanded, append(offsets, len(text))
+}
+
+func hasRunesPrefix(runes, prefix []rune) bool {
+	if len(runes) < len(prefix) {
+		return false
+	}
+	for i, r := range prefix {
+		if runes[i] != r {
+			return false
+		}
+	}
+	return true
+}
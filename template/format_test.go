@@ -0,0 +1,51 @@
+package template
+
+import "testing"
+
+func TestFormatTemplate(t *testing.T) {
+	tcases := []struct {
+		in, exp string
+	}{
+		{
+			in:  "create vpc   name=main cidr=10.0.0.0/16",
+			exp: "create vpc cidr=10.0.0.0/16 name=main\n",
+		},
+		{
+			in:  "# my infra\n\n\n  vpc =   create vpc name=main cidr=10.0.0.0/16  \n// subnets\ncreate subnet vpc=$vpc cidr={subnet.cidr}\n\n",
+			exp: "# my infra\n\nvpc = create vpc cidr=10.0.0.0/16 name=main\n// subnets\ncreate subnet cidr={subnet.cidr} vpc=$vpc\n",
+		},
+		{
+			in:  "for z in a,b {\n  # zone\n create subnet vpc=vpc-1 cidr={cidr} zone={z}\n}\nif exists vpc name=main {\ncreate tag value=\"b c\" key=a resource=@main\n}",
+			exp: "for z in a,b {\n\t# zone\n\tcreate subnet cidr={cidr} vpc=vpc-1 zone={z}\n}\nif exists vpc name=main {\n\tcreate tag key=a resource=@main value=\"b c\"\n}\n",
+		},
+		{
+			in:  "for i in 1-2 {\nunless exists vpc name=main {\ncreate subnet name=sub-{i} cidr=10.0.{i}.0/24 vpc=vpc-1\n}\n}",
+			exp: "for i in 1-2 {\n\tunless exists vpc name=main {\n\t\tcreate subnet cidr=10.0.{i}.0/24 name=sub-{i} vpc=vpc-1\n\t}\n}\n",
+		},
+		{
+			in:  "for i in 1-2 {\n  sub{i} = create subnet   vpc=vpc-255 cidr=10.0.{i}.0/24 name=sub-255\nfor j in 3,4 {\ncreate instance  subnet=$sub{i} name=web-{i}-{j} count={j}\n}\n}",
+			exp: "for i in 1-2 {\n\tsub{i} = create subnet cidr=10.0.{i}.0/24 name=sub-255 vpc=vpc-255\n\tfor j in 3,4 {\n\t\tcreate instance count={j} name=web-{i}-{j} subnet=$sub{i}\n\t}\n}\n",
+		},
+	}
+
+	for i, tcase := range tcases {
+		got, err := Format(tcase.in)
+		if err != nil {
+			t.Fatalf("%d: %s", i+1, err)
+		}
+		if want := tcase.exp; got != want {
+			t.Fatalf("%d: got\n%q\nwant\n%q", i+1, got, want)
+		}
+		again, err := Format(got)
+		if err != nil {
+			t.Fatalf("%d: %s", i+1, err)
+		}
+		if again != got {
+			t.Fatalf("%d: formatting is not idempotent, got\n%q\nwant\n%q", i+1, again, got)
+		}
+	}
+
+	if _, err := Format("create vpc cidr="); err == nil {
+		t.Fatal("expected error got none")
+	}
+}
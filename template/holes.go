@@ -0,0 +1,112 @@
+package template
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+// HoleSpec describes a hole declared with a type, a default value and/or
+// a description (ex: {instance.count:int=2 "number of web nodes"})
+type HoleSpec struct {
+	Name, Type, Default, Description string
+}
+
+// Entity returns the entity of a resource id hole type (ex: id(subnet))
+func (h HoleSpec) Entity() string {
+	if strings.HasPrefix(h.Type, "id(") && strings.HasSuffix(h.Type, ")") {
+		return h.Type[3 : len(h.Type)-1]
+	}
+	return ""
+}
+
+// Convert validates the value against the hole type and returns it
+// as expected in a template param
+func (h HoleSpec) Convert(v interface{}) (interface{}, error) {
+	s := strings.TrimSpace(fmt.Sprint(v))
+	invalid := func() error {
+		return fmt.Errorf("hole %s: invalid %s value '%v'", h.Name, h.Type, v)
+	}
+
+	switch h.Type {
+	case "int":
+		if i, ok := v.(int); ok {
+			return i, nil
+		}
+		i, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, invalid()
+		}
+		return i, nil
+	case "bool":
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, invalid()
+		}
+		return strconv.FormatBool(b), nil
+	case "cidr":
+		_, ipnet, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, invalid()
+		}
+		return ipnet.String(), nil
+	case "ip":
+		ip := net.ParseIP(s)
+		if ip == nil {
+			return nil, invalid()
+		}
+		return ip.String(), nil
+	case "", "string":
+		return v, nil
+	default:
+		if h.Entity() == "" || s == "" || strings.ContainsAny(s, " \t") {
+			return nil, invalid()
+		}
+		return s, nil
+	}
+}
+
+func (h HoleSpec) String() string {
+	var details []string
+	if h.Type != "" {
+		details = append(details, h.Type)
+	}
+	if h.Default != "" {
+		details = append(details, fmt.Sprintf("default %s", h.Default))
+	}
+
+	var buff []string
+	buff = append(buff, h.Name)
+	if len(details) > 0 {
+		buff = append(buff, fmt.Sprintf("(%s)", strings.Join(details, ", ")))
+	}
+	if h.Description != "" {
+		buff = append(buff, h.Description)
+	}
+
+	return strings.Join(buff, " ")
+}
+
+func (s *Template) holeSpecs() (map[string]HoleSpec, error) {
+	specs := make(map[string]HoleSpec)
+	err := s.visitCommandNodesE(func(cmd *ast.CommandNode) error {
+		for name, spec := range cmd.HoleSpecs {
+			holeSpec := HoleSpec{Name: name, Type: spec.Type, Default: spec.Default, Description: spec.Description}
+			if existing, ok := specs[name]; ok && existing != holeSpec {
+				return fmt.Errorf("hole %s: conflicting declarations '%s' and '%s'", name, existing, holeSpec)
+			}
+			if holeSpec.Default != "" {
+				if _, err := holeSpec.Convert(holeSpec.Default); err != nil {
+					return fmt.Errorf("%s (default)", err)
+				}
+			}
+			specs[name] = holeSpec
+		}
+		return nil
+	})
+
+	return specs, err
+}
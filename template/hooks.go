@@ -0,0 +1,214 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+// Types of the events of a template run
+const (
+	BeforeRun       = "before-run"
+	BeforeStatement = "before-statement"
+	AfterStatement  = "after-statement"
+	AfterRun        = "after-run"
+)
+
+// Event is an event of a template run passed to the hooks
+type Event struct {
+	Type       string
+	TemplateID string
+
+	// Template is the template to run on BeforeRun and the run template on AfterRun
+	Template *Template
+
+	// Ident, Command and Result are set on statement events.
+	// On BeforeStatement, the command params have their references resolved.
+	Ident   string
+	Command *ast.CommandNode
+	Result  interface{}
+
+	// Err is the error of the statement or of the run on after events
+	Err error
+
+	Start    time.Time
+	Duration time.Duration
+}
+
+// IsBefore returns true for the events whose hooks can veto the run or the statement
+func (e *Event) IsBefore() bool {
+	return e.Type == BeforeRun || e.Type == BeforeStatement
+}
+
+type eventJSON struct {
+	Event      string                 `json:"event"`
+	TemplateID string                 `json:"templateID"`
+	Action     string                 `json:"action,omitempty"`
+	Entity     string                 `json:"entity,omitempty"`
+	Params     map[string]interface{} `json:"params,omitempty"`
+	Command    *command               `json:"command,omitempty"`
+	Template   *toJSON                `json:"template,omitempty"`
+	Error      string                 `json:"error,omitempty"`
+	Start      time.Time              `json:"start"`
+	DurationMs int64                  `json:"durationMs,omitempty"`
+}
+
+// MarshalJSON returns the event sent to hooks. The outputs of the commands
+// are left out as they may hold sensitive values (ex: passwords), and the
+// params set from sensitive outputs are masked.
+func (e *Event) MarshalJSON() ([]byte, error) {
+	out := &eventJSON{
+		Event:      e.Type,
+		TemplateID: e.TemplateID,
+		Start:      e.Start,
+		DurationMs: int64(e.Duration / time.Millisecond),
+	}
+	if e.Template != nil {
+		out.Template = marshalTemplate(e.Template)
+		withoutOutputs(out.Template.Commands)
+		withoutOutputs(out.Template.Pending)
+	}
+	if e.Command != nil {
+		cmd := marshalCommand(e.Ident, e.Command)
+		cmd.Outputs = nil
+		out.Action, out.Entity, out.Params, out.Command = e.Command.Action, e.Command.Entity, maskedParams(e.Command), &cmd
+	}
+	if e.Err != nil {
+		out.Error = e.Err.Error()
+	}
+	return json.Marshal(out)
+}
+
+func withoutOutputs(commands []command) {
+	for i := range commands {
+		commands[i].Outputs = nil
+	}
+}
+
+// Hook is called on the events of a template run. An error returned on
+// BeforeRun or BeforeStatement vetoes respectively the run or the statement.
+// Errors returned on after events are ignored. Hooks of statement events
+// are called concurrently when statements are run concurrently. The context
+// is the one of the run, except on AfterRun which is fired once it is over.
+type Hook func(context.Context, *Event) error
+
+// VetoError is the error of a run or of a statement vetoed by a hook
+type VetoError struct {
+	Event string
+	Err   error
+}
+
+func (e *VetoError) Error() string {
+	return fmt.Sprintf("vetoed by %s hook: %s", e.Event, e.Err)
+}
+
+// fireEvent calls the hooks in order. On before events, the first error
+// stops and vetoes. On after events, all the hooks are called.
+func fireEvent(ctx context.Context, hooks []Hook, e *Event) error {
+	for _, hook := range hooks {
+		if err := hook(ctx, e); err != nil && e.IsBefore() {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewHook returns a hook sending the events as JSON to an HTTP endpoint
+// (when the target is an http:// or https:// URL) or on the stdin of an
+// executable. A non-2xx response or a non-zero exit status is an error,
+// as is a hook not completing within HookTimeout.
+func NewHook(target string) Hook {
+	if strings.HasPrefix(target, "http://") || strings.HasPrefix(target, "https://") {
+		return httpHook(target)
+	}
+	return execHook(target)
+}
+
+// HookTimeout is the maximum duration of a hook call
+var HookTimeout = 30 * time.Second
+
+func execHook(path string) Hook {
+	return func(ctx context.Context, e *Event) error {
+		b, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		// output to a file rather than a pipe: waiting for a killed hook
+		// would block on its children still holding the pipe
+		out, err := ioutil.TempFile("", "awless-hook")
+		if err != nil {
+			return err
+		}
+		defer os.Remove(out.Name())
+		defer out.Close()
+
+		ctx, cancel := context.WithTimeout(ctx, HookTimeout)
+		defer cancel()
+		cmd := exec.CommandContext(ctx, path)
+		cmd.Stdin = bytes.NewReader(b)
+		cmd.Stdout, cmd.Stderr = out, out
+		if err := cmd.Run(); err != nil {
+			output, _ := ioutil.ReadFile(out.Name())
+			if msg := strings.TrimSpace(string(output)); msg != "" {
+				return fmt.Errorf("%s: %s: %s", path, err, msg)
+			}
+			return fmt.Errorf("%s: %s", path, err)
+		}
+		return nil
+	}
+}
+
+func httpHook(url string) Hook {
+	return func(ctx context.Context, e *Event) error {
+		b, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		req, err := http.NewRequest("POST", url, bytes.NewReader(b))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		ctx, cancel := context.WithTimeout(ctx, HookTimeout)
+		defer cancel()
+		resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			if msg := strings.TrimSpace(string(body)); msg != "" {
+				return fmt.Errorf("%s: %s: %s", url, resp.Status, msg)
+			}
+			return fmt.Errorf("%s: %s", url, resp.Status)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,285 @@
+package template
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/driver"
+)
+
+type nameDriver struct{ calls int }
+
+func (d *nameDriver) Lookup(lookups ...string) (driver.DriverFn, error) {
+	return func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+		d.calls++
+		return fmt.Sprintf("id-%s", params["name"]), nil
+	}, nil
+}
+func (d *nameDriver) SetLogger(*logger.Logger) {}
+func (d *nameDriver) SetDryRun(bool)           {}
+
+type eventsRecorder struct {
+	mu     sync.Mutex
+	events []*Event
+}
+
+func (r *eventsRecorder) hook(veto map[string]error) Hook {
+	return func(ctx context.Context, e *Event) error {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.events = append(r.events, e)
+		if e.Command != nil {
+			return veto[e.Type+" "+fmt.Sprint(e.Command.Params["name"])]
+		}
+		return veto[e.Type]
+	}
+}
+
+func (r *eventsRecorder) types() (types []string) {
+	for _, e := range r.events {
+		types = append(types, e.Type)
+	}
+	return
+}
+
+func TestRunHooks(t *testing.T) {
+	t.Run("events", func(t *testing.T) {
+		tpl := MustParse("vpc = create vpc name=main\ncreate subnet name=sub vpc=$vpc")
+		rec := &eventsRecorder{}
+		tpl.Hooks = []Hook{rec.hook(nil)}
+
+		ran, err := tpl.Run(context.Background(), &nameDriver{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := rec.types(), []string{BeforeRun, BeforeStatement, AfterStatement, BeforeStatement, AfterStatement, AfterRun}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		for _, e := range rec.events {
+			if got, want := e.TemplateID, ran.ID; got != want {
+				t.Fatalf("%s: got %s, want %s", e.Type, got, want)
+			}
+			if e.Start.IsZero() {
+				t.Fatalf("%s: expected start time", e.Type)
+			}
+		}
+		if got, want := rec.events[0].Template, tpl; got != want {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		if got, want := rec.events[2].Ident, "vpc"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got, want := rec.events[2].Result, "id-main"; got != want {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		if got, want := rec.events[3].Command.Params["vpc"], "id-main"; got != want {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		if got, want := rec.events[5].Template, ran; got != want {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("veto run", func(t *testing.T) {
+		tpl := MustParse("create vpc name=main\ncreate subnet name=sub")
+		rec := &eventsRecorder{}
+		tpl.Hooks = []Hook{rec.hook(map[string]error{BeforeRun: errors.New("forbidden")})}
+		d := &nameDriver{}
+
+		ran, err := tpl.Run(context.Background(), d)
+		if got, want := fmt.Sprint(err), "vetoed by before-run hook: forbidden"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got, want := d.calls, 0; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		if got, want := len(ran.Pending), 2; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		if got, want := rec.types(), []string{BeforeRun}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("veto statement", func(t *testing.T) {
+		tpl := MustParse("create vpc name=main\ncreate subnet name=sub")
+		rec := &eventsRecorder{}
+		tpl.Hooks = []Hook{rec.hook(map[string]error{BeforeStatement + " sub": errors.New("forbidden")})}
+		d := &nameDriver{}
+
+		ran, err := tpl.Run(context.Background(), d)
+		if _, ok := err.(*VetoError); !ok {
+			t.Fatalf("got %#v, want veto error", err)
+		}
+		if got, want := d.calls, 1; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		if got, want := commandNode(ran.Statements[1]).CmdErr.Error(), "vetoed by before-statement hook: forbidden"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got, want := rec.types(), []string{BeforeRun, BeforeStatement, AfterStatement, BeforeStatement, AfterRun}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		if got, want := rec.events[4].Err, err; got != want {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("ignore after events errors", func(t *testing.T) {
+		tpl := MustParse("create vpc name=main")
+		rec := &eventsRecorder{}
+		next := &eventsRecorder{}
+		tpl.Hooks = []Hook{rec.hook(map[string]error{AfterStatement + " main": errors.New("unreachable"), AfterRun: errors.New("unreachable")}), next.hook(nil)}
+
+		if _, err := tpl.Run(context.Background(), &nameDriver{}); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := next.types(), []string{BeforeRun, BeforeStatement, AfterStatement, AfterRun}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("no events on dry run", func(t *testing.T) {
+		tpl := MustParse("create vpc name=main")
+		rec := &eventsRecorder{}
+		tpl.Hooks = []Hook{rec.hook(nil)}
+
+		if err := tpl.DryRun(&nameDriver{}); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := len(rec.events), 0; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+	})
+}
+
+func TestEventJSON(t *testing.T) {
+	tpl := MustParse("vpc = create vpc name=main")
+	cmd := tpl.CommandNodesIterator()[0]
+	cmd.CmdResult, cmd.CmdErr = "vpc-1234", errors.New("failed")
+	cmd.CmdOutputs = map[string]interface{}{"Password": "s3cr3t"}
+	b, err := json.Marshal(&Event{Type: AfterStatement, TemplateID: "01BA", Ident: "vpc", Command: cmd, Template: tpl, Result: cmd.CmdResult, Err: cmd.CmdErr})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), "s3cr3t") {
+		t.Fatalf("outputs sent to hooks: %s", b)
+	}
+
+	var got map[string]interface{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	for k, want := range map[string]interface{}{"event": "after-statement", "templateID": "01BA", "action": "create", "entity": "vpc", "error": "failed"} {
+		if got[k] != want {
+			t.Fatalf("%s: got %v, want %v", k, got[k], want)
+		}
+	}
+	if got, want := got["params"], map[string]interface{}{"name": "main"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := got["command"], map[string]interface{}{"line": "create vpc name=main", "ident": "vpc", "errors": []interface{}{"failed"}, "results": []interface{}{"vpc-1234"}}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestNewHook(t *testing.T) {
+	event := &Event{Type: BeforeRun, TemplateID: "01BA", Template: MustParse("create vpc name=main")}
+
+	t.Run("http", func(t *testing.T) {
+		var received map[string]interface{}
+		status := http.StatusOK
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if got, want := r.Header.Get("Content-Type"), "application/json"; got != want {
+				t.Errorf("got %s, want %s", got, want)
+			}
+			json.NewDecoder(r.Body).Decode(&received)
+			w.WriteHeader(status)
+			fmt.Fprint(w, "run not allowed")
+		}))
+		defer server.Close()
+
+		hook := NewHook(server.URL)
+		if err := hook(context.Background(), event); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := received["event"], BeforeRun; got != want {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		if got, want := received["template"].(map[string]interface{})["commands"].([]interface{})[0].(map[string]interface{})["line"], "create vpc name=main"; got != want {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+
+		status = http.StatusForbidden
+		if err := hook(context.Background(), event); err == nil || !strings.Contains(err.Error(), "403 Forbidden: run not allowed") {
+			t.Fatalf("got %v, want forbidden error", err)
+		}
+	})
+
+	t.Run("executable", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("shell script hook")
+		}
+		dir, err := ioutil.TempDir("", "awless-hooks")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+
+		received := filepath.Join(dir, "event.json")
+		script := filepath.Join(dir, "hook.sh")
+		content := fmt.Sprintf("#!/bin/sh\ncat > %s\ngrep -q '\"templateID\":\"forbidden\"' %s && echo 'run not allowed' && exit 1\nexit 0\n", received, received)
+		if err := ioutil.WriteFile(script, []byte(content), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		hook := NewHook(script)
+		if err := hook(context.Background(), event); err != nil {
+			t.Fatal(err)
+		}
+		var got map[string]interface{}
+		b, err := ioutil.ReadFile(received)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := json.Unmarshal(b, &got); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := got["templateID"], "01BA"; got != want {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+
+		err = hook(context.Background(), &Event{Type: BeforeRun, TemplateID: "forbidden"})
+		if err == nil || !strings.HasSuffix(err.Error(), "exit status 1: run not allowed") {
+			t.Fatalf("got %v, want exit status error", err)
+		}
+
+		hung := filepath.Join(dir, "hung.sh")
+		if err := ioutil.WriteFile(hung, []byte("#!/bin/sh\nsleep 10\n"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		if err := NewHook(hung)(ctx, event); err == nil {
+			t.Fatal("expected error got none")
+		}
+		if elapsed := time.Since(start); elapsed > 5*time.Second {
+			t.Fatalf("hook not stopped with the context, after %s", elapsed)
+		}
+	})
+}
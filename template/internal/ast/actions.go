@@ -9,6 +9,7 @@ const (
 	Create Action = "create"
 	Delete Action = "delete"
 	Update Action = "update"
+	Ensure Action = "ensure"
 
 	Check Action = "check"
 
@@ -17,6 +18,8 @@ const (
 
 	Attach Action = "attach"
 	Detach Action = "detach"
+
+	Copy Action = "copy"
 )
 
 var actions = map[Action]struct{}{
@@ -24,11 +27,13 @@ var actions = map[Action]struct{}{
 	Create:     struct{}{},
 	Delete:     struct{}{},
 	Update:     struct{}{},
+	Ensure:     struct{}{},
 	Check:      struct{}{},
 	Start:      struct{}{},
 	Stop:       struct{}{},
 	Attach:     struct{}{},
 	Detach:     struct{}{},
+	Copy:       struct{}{},
 }
 
 func IsInvalidAction(s string) bool {
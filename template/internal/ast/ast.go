@@ -36,10 +36,14 @@ type AST struct {
 	// state to build the AST
 	currentStatement *Statement
 	currentKey       string
+	statementStart   int
+	statementsCount  int
 }
 
 type Statement struct {
 	Node
+	// Pos and End are the offsets (in runes) of the statement in the parsed text
+	Pos, End int
 }
 
 type DeclarationNode struct {
@@ -51,6 +55,76 @@ func (n *DeclarationNode) Equal(n2 Node) bool {
 	return reflect.DeepEqual(n, n2)
 }
 
+// ForNode is a loop block whose body is expanded once for each value,
+// replacing the loop variable placeholder '{variable}' with the value.
+// Expansion happens at compile time, so that the expanded statements are
+// the ones run, stored and reverted.
+type ForNode struct {
+	Variable string
+	Values   []string
+	Body     string
+}
+
+func (n *ForNode) Equal(n2 Node) bool {
+	return reflect.DeepEqual(n, n2)
+}
+
+func (n *ForNode) Expand(value string) string {
+	return strings.Replace(n.Body, fmt.Sprintf("{%s}", n.Variable), value, -1)
+}
+
+// IfNode is a conditional block whose body is only kept when a resource of
+// the given entity matching all the filters exists (or does not exist for
+// an 'unless' block). Conditions are evaluated at compile time.
+type IfNode struct {
+	Negate  bool
+	Entity  string
+	Filters map[string]string
+	Body    string
+}
+
+func (n *IfNode) Equal(n2 Node) bool {
+	return reflect.DeepEqual(n, n2)
+}
+
+func (n *IfNode) Condition() string {
+	var buff bytes.Buffer
+
+	if n.Negate {
+		buff.WriteString("unless")
+	} else {
+		buff.WriteString("if")
+	}
+	fmt.Fprintf(&buff, " exists %s", n.Entity)
+
+	var filters []string
+	for k, v := range n.Filters {
+		filters = append(filters, fmt.Sprintf("%s=%s", k, v))
+	}
+	sort.Strings(filters)
+	if len(filters) > 0 {
+		fmt.Fprintf(&buff, " %s", strings.Join(filters, " "))
+	}
+
+	return buff.String()
+}
+
+// IncludeNode pulls in the statements of another template (local path,
+// http URL or 'repo:' prefixed). Args are passed to the included template
+// holes. When Ident is set, the references declared in the included template
+// are exported prefixed with it (ex: $net.subnet). Includes are expanded at
+// compile time, so that the expanded statements are the ones run, stored and
+// reverted.
+type IncludeNode struct {
+	Ident  string
+	Source string
+	Args   *CommandNode
+}
+
+func (n *IncludeNode) Equal(n2 Node) bool {
+	return reflect.DeepEqual(n, n2)
+}
+
 type ExpressionNode interface {
 	Node
 	Result() interface{}
@@ -58,13 +132,49 @@ type ExpressionNode interface {
 }
 
 type CommandNode struct {
-	CmdResult interface{}
-	CmdErr    error
+	CmdResult   interface{}
+	CmdOutputs  map[string]interface{}
+	CmdErr      error
+	CmdAttempts int
+	// CmdPrevious holds the values of the updated params before the run
+	CmdPrevious map[string]interface{}
+	// CmdSensitive are the params set from sensitive outputs (ex: secrets)
+	CmdSensitive []string
 
 	Action, Entity string
 	Refs           map[string]string
 	Params         map[string]interface{}
 	Holes          map[string]string
+	HoleSpecs      map[string]HoleSpec
+}
+
+// HoleSpec is the optional declaration of the type, default value and
+// description of a hole (ex: {instance.count:int=2 "number of web nodes"})
+type HoleSpec struct {
+	Type, Default, Description string
+}
+
+var HoleTypes = []string{"string", "int", "bool", "cidr", "ip"}
+
+func (s HoleSpec) format(hole string) string {
+	var buff bytes.Buffer
+
+	buff.WriteString(hole)
+	if s.Type != "" {
+		fmt.Fprintf(&buff, ":%s", s.Type)
+	}
+	if s.Default != "" {
+		if strings.ContainsAny(s.Default, " \t\"}\\") {
+			fmt.Fprintf(&buff, `="%s"`, escapeQuoted(s.Default))
+		} else {
+			fmt.Fprintf(&buff, "=%s", s.Default)
+		}
+	}
+	if s.Description != "" {
+		fmt.Fprintf(&buff, ` "%s"`, escapeQuoted(s.Description))
+	}
+
+	return buff.String()
 }
 
 func (n *CommandNode) Result() interface{} { return n.CmdResult }
@@ -89,7 +199,7 @@ func (n *CommandNode) Equal(n2 Node) bool {
 }
 
 func (s *Statement) Clone() *Statement {
-	newStat := &Statement{}
+	newStat := &Statement{Pos: s.Pos, End: s.End}
 	newStat.Node = s.Node.clone()
 
 	return newStat
@@ -114,6 +224,74 @@ func (n *DeclarationNode) String() string {
 	return fmt.Sprintf("%s = %s", n.Ident, n.Expr)
 }
 
+func (n *ForNode) clone() Node {
+	return &ForNode{
+		Variable: n.Variable,
+		Values:   append([]string{}, n.Values...),
+		Body:     n.Body,
+	}
+}
+
+func (n *ForNode) String() string {
+	var buff bytes.Buffer
+
+	fmt.Fprintf(&buff, "for %s in %s {\n", n.Variable, strings.Join(n.Values, ","))
+	for _, line := range strings.Split(n.Body, "\n") {
+		fmt.Fprintf(&buff, "\t%s\n", line)
+	}
+	buff.WriteString("}")
+
+	return buff.String()
+}
+
+func (n *IfNode) clone() Node {
+	clone := &IfNode{
+		Negate:  n.Negate,
+		Entity:  n.Entity,
+		Filters: make(map[string]string),
+		Body:    n.Body,
+	}
+	for k, v := range n.Filters {
+		clone.Filters[k] = v
+	}
+
+	return clone
+}
+
+func (n *IfNode) String() string {
+	var buff bytes.Buffer
+
+	fmt.Fprintf(&buff, "%s {\n", n.Condition())
+	for _, line := range strings.Split(n.Body, "\n") {
+		fmt.Fprintf(&buff, "\t%s\n", line)
+	}
+	buff.WriteString("}")
+
+	return buff.String()
+}
+
+func (n *IncludeNode) clone() Node {
+	return &IncludeNode{
+		Ident:  n.Ident,
+		Source: n.Source,
+		Args:   n.Args.clone().(*CommandNode),
+	}
+}
+
+func (n *IncludeNode) String() string {
+	var buff bytes.Buffer
+
+	if n.Ident != "" {
+		fmt.Fprintf(&buff, "%s = ", n.Ident)
+	}
+	fmt.Fprintf(&buff, "include %s", QuoteValue(n.Source))
+	if args := strings.TrimSpace(n.Args.String()); args != "" {
+		fmt.Fprintf(&buff, " %s", args)
+	}
+
+	return buff.String()
+}
+
 func (n *CommandNode) clone() Node {
 	cmd := &CommandNode{
 		Action: n.Action, Entity: n.Entity,
@@ -131,10 +309,59 @@ func (n *CommandNode) clone() Node {
 	for k, v := range n.Holes {
 		cmd.Holes[k] = v
 	}
+	if n.HoleSpecs != nil {
+		cmd.HoleSpecs = make(map[string]HoleSpec)
+		for k, v := range n.HoleSpecs {
+			cmd.HoleSpecs[k] = v
+		}
+	}
+	if n.CmdPrevious != nil {
+		cmd.CmdPrevious = make(map[string]interface{})
+		for k, v := range n.CmdPrevious {
+			cmd.CmdPrevious[k] = v
+		}
+	}
+	cmd.CmdSensitive = append(cmd.CmdSensitive, n.CmdSensitive...)
 
 	return cmd
 }
 
+// AddQuotedParam sets the param from the content of a quoted value, either
+// as a value, a hole, a reference or an interpolation of them
+func (n *CommandNode) AddQuotedParam(key, content string) error {
+	interp, err := parseInterpolation(content)
+	if err != nil {
+		return err
+	}
+	if n.Params == nil {
+		n.Params = make(map[string]interface{})
+	}
+	if n.Holes == nil {
+		n.Holes = make(map[string]string)
+	}
+	if n.Refs == nil {
+		n.Refs = make(map[string]string)
+	}
+	switch {
+	case interp.IsResolved():
+		n.Params[key] = interp.Value()
+	case len(interp.Parts) == 1 && interp.Parts[0].Hole != "":
+		n.Holes[key] = interp.Parts[0].Hole
+	case len(interp.Parts) == 1 && interp.Parts[0].Ref != "":
+		n.Refs[key] = interp.Parts[0].Ref
+	default:
+		n.Params[key] = interp
+	}
+	return nil
+}
+
+// HoleString returns the hole of the param with its spec, as written between
+// braces in templates (ex: 'instance.count:int=2')
+func (n *CommandNode) HoleString(key string) string {
+	hole := n.Holes[key]
+	return n.HoleSpecs[hole].format(hole)
+}
+
 func (n *CommandNode) String() string {
 	var all []string
 	for k, v := range n.Refs {
@@ -146,13 +373,15 @@ func (n *CommandNode) String() string {
 			continue
 		case []string:
 			all = append(all, fmt.Sprintf("%s=%s", k, strings.Join(vv, ",")))
+		case string:
+			all = append(all, fmt.Sprintf("%s=%s", k, QuoteValue(vv)))
 		default:
 			all = append(all, fmt.Sprintf("%s=%v", k, v))
 		}
 
 	}
 	for k, v := range n.Holes {
-		all = append(all, fmt.Sprintf("%s={%s}", k, v))
+		all = append(all, fmt.Sprintf("%s={%s}", k, n.HoleSpecs[v].format(v)))
 	}
 
 	sort.Strings(all)
@@ -181,6 +410,16 @@ func (n *CommandNode) ProcessHoles(fills map[string]interface{}) map[string]inte
 			delete(n.Holes, key)
 		}
 	}
+	for key, v := range n.Params {
+		if interp, ok := v.(Interpolation); ok {
+			interp = interp.processHoles(fills)
+			n.Params[key] = interp
+			if interp.IsResolved() {
+				n.Params[key] = interp.Value()
+				processed[key] = interp.Value()
+			}
+		}
+	}
 	return processed
 }
 
@@ -194,6 +433,134 @@ func (n *CommandNode) ProcessRefs(fills map[string]interface{}) {
 			delete(n.Refs, key)
 		}
 	}
+	for key, v := range n.Params {
+		if interp, ok := v.(Interpolation); ok {
+			interp = interp.processRefs(fills)
+			n.Params[key] = interp
+			if interp.IsResolved() {
+				n.Params[key] = interp.Value()
+			}
+		}
+	}
+}
+
+// RenameRefs renames the references used by the command, either as params
+// values or interpolated in quoted params values
+func (n *CommandNode) RenameRefs(names map[string]string) {
+	for key, ref := range n.Refs {
+		if name, ok := names[ref]; ok {
+			n.Refs[key] = name
+		}
+	}
+	n.mapInterpolations(func(part InterpolationPart) InterpolationPart {
+		if name, ok := names[part.Ref]; ok && part.Ref != "" {
+			part.Ref = name
+		}
+		return part
+	})
+}
+
+// ProcessHolesWithRefs replaces the given holes by references
+func (n *CommandNode) ProcessHolesWithRefs(refs map[string]string) {
+	if n.Refs == nil {
+		n.Refs = make(map[string]string)
+	}
+	for key, hole := range n.Holes {
+		if ref, ok := refs[hole]; ok {
+			n.Refs[key] = ref
+			delete(n.Holes, key)
+		}
+	}
+	n.mapInterpolations(func(part InterpolationPart) InterpolationPart {
+		if ref, ok := refs[part.Hole]; ok && part.Hole != "" {
+			return InterpolationPart{Ref: ref}
+		}
+		return part
+	})
+}
+
+// RenameHoles renames the holes of the command, either as params values or
+// interpolated in quoted params values
+func (n *CommandNode) RenameHoles(names map[string]string) {
+	for key, hole := range n.Holes {
+		if name, ok := names[hole]; ok {
+			n.Holes[key] = name
+		}
+	}
+	for hole, spec := range n.HoleSpecs {
+		if name, ok := names[hole]; ok {
+			delete(n.HoleSpecs, hole)
+			n.HoleSpecs[name] = spec
+		}
+	}
+	n.mapInterpolations(func(part InterpolationPart) InterpolationPart {
+		if name, ok := names[part.Hole]; ok && part.Hole != "" {
+			part.Hole = name
+		}
+		return part
+	})
+}
+
+func (n *CommandNode) mapInterpolations(fn func(InterpolationPart) InterpolationPart) {
+	for key, v := range n.Params {
+		if interp, ok := v.(Interpolation); ok {
+			mapped := Interpolation{}
+			for _, part := range interp.Parts {
+				mapped.Parts = append(mapped.Parts, fn(part))
+			}
+			n.Params[key] = mapped
+		}
+	}
+}
+
+// UsedRefs returns the references used by the command, either as params
+// values or interpolated in quoted params values
+func (n *CommandNode) UsedRefs() (refs []string) {
+	for _, ref := range n.Refs {
+		refs = append(refs, ref)
+	}
+	for _, v := range n.Params {
+		if interp, ok := v.(Interpolation); ok {
+			refs = append(refs, interp.Refs()...)
+		}
+	}
+	return
+}
+
+// KeysUsingRefs returns the keys of the params set with one of the matching
+// references, either as values or interpolated in quoted values
+func (n *CommandNode) KeysUsingRefs(match func(ref string) bool) (keys []string) {
+	for k, ref := range n.Refs {
+		if match(ref) {
+			keys = append(keys, k)
+		}
+	}
+	for k, v := range n.Params {
+		if interp, ok := v.(Interpolation); ok {
+			for _, ref := range interp.Refs() {
+				if match(ref) {
+					keys = append(keys, k)
+					break
+				}
+			}
+		}
+	}
+	sort.Strings(keys)
+	return
+}
+
+// UsedHoles returns the holes of the command, either as params values or
+// interpolated in quoted params values
+func (n *CommandNode) UsedHoles() (holes []string) {
+	for _, hole := range n.Holes {
+		holes = append(holes, hole)
+	}
+	for _, v := range n.Params {
+		if interp, ok := v.(Interpolation); ok {
+			holes = append(holes, interp.Holes()...)
+		}
+	}
+	return
 }
 
 func (a *AST) Clone() *AST {
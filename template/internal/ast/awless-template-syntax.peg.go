@@ -20,11 +20,20 @@ const (
 	ruleEntity
 	ruleDeclaration
 	ruleExpr
+	ruleForLoop
+	ruleLoopValues
+	ruleIfBlock
+	ruleCondition
+	ruleInclude
+	ruleIncludeSource
+	ruleBlockBody
 	ruleParams
 	ruleParam
 	ruleIdentifier
 	ruleValue
 	ruleStringValue
+	ruleQuotedValue
+	ruleQuotedContent
 	ruleCSVValue
 	ruleCidrValue
 	ruleIpValue
@@ -33,6 +42,9 @@ const (
 	ruleRefValue
 	ruleAliasValue
 	ruleHoleValue
+	ruleHoleSpec
+	ruleHoleType
+	ruleHoleDefault
 	ruleComment
 	ruleWhiteSpacing
 	ruleMustWhiteSpacing
@@ -58,6 +70,27 @@ const (
 	ruleAction13
 	ruleAction14
 	ruleAction15
+	ruleAction16
+	ruleAction17
+	ruleAction18
+	ruleAction19
+	ruleAction20
+	ruleAction21
+	ruleAction22
+	ruleAction23
+	ruleAction24
+	ruleAction25
+	ruleAction26
+	ruleAction27
+	ruleAction28
+	ruleAction29
+	ruleAction30
+	ruleAction31
+	ruleAction32
+	ruleAction33
+	ruleAction34
+	ruleAction35
+	ruleAction36
 )
 
 var rul3s = [...]string{
@@ -68,11 +101,20 @@ var rul3s = [...]string{
 	"Entity",
 	"Declaration",
 	"Expr",
+	"ForLoop",
+	"LoopValues",
+	"IfBlock",
+	"Condition",
+	"Include",
+	"IncludeSource",
+	"BlockBody",
 	"Params",
 	"Param",
 	"Identifier",
 	"Value",
 	"StringValue",
+	"QuotedValue",
+	"QuotedContent",
 	"CSVValue",
 	"CidrValue",
 	"IpValue",
@@ -81,6 +123,9 @@ var rul3s = [...]string{
 	"RefValue",
 	"AliasValue",
 	"HoleValue",
+	"HoleSpec",
+	"HoleType",
+	"HoleDefault",
 	"Comment",
 	"WhiteSpacing",
 	"MustWhiteSpacing",
@@ -106,6 +151,27 @@ var rul3s = [...]string{
 	"Action13",
 	"Action14",
 	"Action15",
+	"Action16",
+	"Action17",
+	"Action18",
+	"Action19",
+	"Action20",
+	"Action21",
+	"Action22",
+	"Action23",
+	"Action24",
+	"Action25",
+	"Action26",
+	"Action27",
+	"Action28",
+	"Action29",
+	"Action30",
+	"Action31",
+	"Action32",
+	"Action33",
+	"Action34",
+	"Action35",
+	"Action36",
 }
 
 type token32 struct {
@@ -222,7 +288,7 @@ type Peg struct {
 
 	Buffer string
 	buffer []rune
-	rules  [45]func() bool
+	rules  [78]func() bool
 	parse  func(rule ...int) error
 	reset  func()
 	Pretty bool
@@ -315,36 +381,78 @@ func (p *Peg) Execute() {
 			text = string(_buffer[begin:end])
 
 		case ruleAction0:
-			p.addDeclarationIdentifier(text)
+			p.markStatementStart(int(token.begin))
 		case ruleAction1:
-			p.addAction(text)
+			p.markStatementEnd(int(token.begin))
 		case ruleAction2:
-			p.addEntity(text)
+			p.addDeclarationIdentifier(text)
 		case ruleAction3:
-			p.LineDone()
+			p.addAction(text)
 		case ruleAction4:
-			p.addParamKey(text)
+			p.addEntity(text)
 		case ruleAction5:
-			p.addParamHoleValue(text)
+			p.LineDone()
 		case ruleAction6:
-			p.addParamValue(text)
+			p.addForLoopVariable(text)
 		case ruleAction7:
-			p.addParamRefValue(text)
+			p.addForLoopValues(text)
 		case ruleAction8:
-			p.addParamCidrValue(text)
+			p.addForLoopBody(text)
 		case ruleAction9:
-			p.addParamIpValue(text)
+			p.LineDone()
 		case ruleAction10:
-			p.addCsvValue(text)
+			p.addIfBlock(text)
 		case ruleAction11:
-			p.addParamValue(text)
+			p.addIfEntity(text)
 		case ruleAction12:
-			p.addParamIntValue(text)
+			p.addIfBody(text)
 		case ruleAction13:
-			p.addParamValue(text)
-		case ruleAction14:
 			p.LineDone()
+		case ruleAction14:
+			p.addIfConditionKey(text)
 		case ruleAction15:
+			p.addIfConditionValue(text)
+		case ruleAction16:
+			p.addInclude()
+		case ruleAction17:
+			p.addIncludeIdentifier(text)
+		case ruleAction18:
+			p.LineDone()
+		case ruleAction19:
+			p.addIncludeSource(text)
+		case ruleAction20:
+			p.addIncludeSource(text)
+		case ruleAction21:
+			p.addParamKey(text)
+		case ruleAction22:
+			p.addParamQuotedValue(text)
+		case ruleAction23:
+			p.addParamValue(text)
+		case ruleAction24:
+			p.addParamRefValue(text)
+		case ruleAction25:
+			p.addParamCidrValue(text)
+		case ruleAction26:
+			p.addParamIpValue(text)
+		case ruleAction27:
+			p.addCsvValue(text)
+		case ruleAction28:
+			p.addParamValue(text)
+		case ruleAction29:
+			p.addParamIntValue(text)
+		case ruleAction30:
+			p.addParamValue(text)
+		case ruleAction31:
+			p.addParamHoleValue(text)
+		case ruleAction32:
+			p.addHoleType(text)
+		case ruleAction33:
+			p.addHoleDefault(text)
+		case ruleAction34:
+			p.addHoleDescription(text)
+		case ruleAction35:
+			p.LineDone()
+		case ruleAction36:
 			p.LineDone()
 
 		}
@@ -417,14 +525,26 @@ func (p *Peg) Init() {
 		}
 		return false
 	}*/
-
 	_rules = [...]func() bool{
 		nil,
-		/* 0 Script <- <((BlankLine* Statement BlankLine*)+ WhiteSpacing EndOfFile)> */
+		/* 0 Script <- (BlankLine* Statement BlankLine*)+ WhiteSpacing EndOfFile */
 		func() bool {
 			position0, tokenIndex0 := position, tokenIndex
 			{
 				position1 := position
+			l2:
+				{
+					position3, tokenIndex3 := position, tokenIndex
+					if !_rules[ruleBlankLine]() {
+						goto l3
+					}
+					goto l2
+				l3:
+					position, tokenIndex = position3, tokenIndex3
+				}
+				if !_rules[ruleStatement]() {
+					goto l0
+				}
 			l4:
 				{
 					position5, tokenIndex5 := position, tokenIndex
@@ -435,292 +555,41 @@ func (p *Peg) Init() {
 				l5:
 					position, tokenIndex = position5, tokenIndex5
 				}
+			l6:
 				{
-					position6 := position
-					if !_rules[ruleWhiteSpacing]() {
-						goto l0
-					}
-					{
-						position7, tokenIndex7 := position, tokenIndex
-						if !_rules[ruleExpr]() {
-							goto l8
-						}
-						goto l7
-					l8:
-						position, tokenIndex = position7, tokenIndex7
-						{
-							position10 := position
-							{
-								position11 := position
-								if !_rules[ruleIdentifier]() {
-									goto l9
-								}
-								add(rulePegText, position11)
-							}
-							{
-								add(ruleAction0, position)
-							}
-							if !_rules[ruleEqual]() {
-								goto l9
-							}
-							if !_rules[ruleExpr]() {
-								goto l9
-							}
-							add(ruleDeclaration, position10)
-						}
-						goto l7
-					l9:
-						position, tokenIndex = position7, tokenIndex7
-						{
-							position13 := position
-							{
-								position14, tokenIndex14 := position, tokenIndex
-								if buffer[position] != rune('#') {
-									goto l15
-								}
-								position++
-							l16:
-								{
-									position17, tokenIndex17 := position, tokenIndex
-									{
-										position18, tokenIndex18 := position, tokenIndex
-										if !_rules[ruleEndOfLine]() {
-											goto l18
-										}
-										goto l17
-									l18:
-										position, tokenIndex = position18, tokenIndex18
-									}
-									if !matchDot() {
-										goto l17
-									}
-									goto l16
-								l17:
-									position, tokenIndex = position17, tokenIndex17
-								}
-								goto l14
-							l15:
-								position, tokenIndex = position14, tokenIndex14
-								if buffer[position] != rune('/') {
-									goto l0
-								}
-								position++
-								if buffer[position] != rune('/') {
-									goto l0
-								}
-								position++
-							l19:
-								{
-									position20, tokenIndex20 := position, tokenIndex
-									{
-										position21, tokenIndex21 := position, tokenIndex
-										if !_rules[ruleEndOfLine]() {
-											goto l21
-										}
-										goto l20
-									l21:
-										position, tokenIndex = position21, tokenIndex21
-									}
-									if !matchDot() {
-										goto l20
-									}
-									goto l19
-								l20:
-									position, tokenIndex = position20, tokenIndex20
-								}
-								{
-									add(ruleAction14, position)
-								}
-							}
-						l14:
-							add(ruleComment, position13)
-						}
-					}
-				l7:
-					if !_rules[ruleWhiteSpacing]() {
-						goto l0
-					}
-				l23:
-					{
-						position24, tokenIndex24 := position, tokenIndex
-						if !_rules[ruleEndOfLine]() {
-							goto l24
-						}
-						goto l23
-					l24:
-						position, tokenIndex = position24, tokenIndex24
-					}
-					add(ruleStatement, position6)
-				}
-			l25:
-				{
-					position26, tokenIndex26 := position, tokenIndex
-					if !_rules[ruleBlankLine]() {
-						goto l26
-					}
-					goto l25
-				l26:
-					position, tokenIndex = position26, tokenIndex26
-				}
-			l2:
-				{
-					position3, tokenIndex3 := position, tokenIndex
-				l27:
+					position7, tokenIndex7 := position, tokenIndex
+				l8:
 					{
-						position28, tokenIndex28 := position, tokenIndex
+						position9, tokenIndex9 := position, tokenIndex
 						if !_rules[ruleBlankLine]() {
-							goto l28
+							goto l9
 						}
-						goto l27
-					l28:
-						position, tokenIndex = position28, tokenIndex28
+						goto l8
+					l9:
+						position, tokenIndex = position9, tokenIndex9
 					}
-					{
-						position29 := position
-						if !_rules[ruleWhiteSpacing]() {
-							goto l3
-						}
-						{
-							position30, tokenIndex30 := position, tokenIndex
-							if !_rules[ruleExpr]() {
-								goto l31
-							}
-							goto l30
-						l31:
-							position, tokenIndex = position30, tokenIndex30
-							{
-								position33 := position
-								{
-									position34 := position
-									if !_rules[ruleIdentifier]() {
-										goto l32
-									}
-									add(rulePegText, position34)
-								}
-								{
-									add(ruleAction0, position)
-								}
-								if !_rules[ruleEqual]() {
-									goto l32
-								}
-								if !_rules[ruleExpr]() {
-									goto l32
-								}
-								add(ruleDeclaration, position33)
-							}
-							goto l30
-						l32:
-							position, tokenIndex = position30, tokenIndex30
-							{
-								position36 := position
-								{
-									position37, tokenIndex37 := position, tokenIndex
-									if buffer[position] != rune('#') {
-										goto l38
-									}
-									position++
-								l39:
-									{
-										position40, tokenIndex40 := position, tokenIndex
-										{
-											position41, tokenIndex41 := position, tokenIndex
-											if !_rules[ruleEndOfLine]() {
-												goto l41
-											}
-											goto l40
-										l41:
-											position, tokenIndex = position41, tokenIndex41
-										}
-										if !matchDot() {
-											goto l40
-										}
-										goto l39
-									l40:
-										position, tokenIndex = position40, tokenIndex40
-									}
-									goto l37
-								l38:
-									position, tokenIndex = position37, tokenIndex37
-									if buffer[position] != rune('/') {
-										goto l3
-									}
-									position++
-									if buffer[position] != rune('/') {
-										goto l3
-									}
-									position++
-								l42:
-									{
-										position43, tokenIndex43 := position, tokenIndex
-										{
-											position44, tokenIndex44 := position, tokenIndex
-											if !_rules[ruleEndOfLine]() {
-												goto l44
-											}
-											goto l43
-										l44:
-											position, tokenIndex = position44, tokenIndex44
-										}
-										if !matchDot() {
-											goto l43
-										}
-										goto l42
-									l43:
-										position, tokenIndex = position43, tokenIndex43
-									}
-									{
-										add(ruleAction14, position)
-									}
-								}
-							l37:
-								add(ruleComment, position36)
-							}
-						}
-					l30:
-						if !_rules[ruleWhiteSpacing]() {
-							goto l3
-						}
-					l46:
-						{
-							position47, tokenIndex47 := position, tokenIndex
-							if !_rules[ruleEndOfLine]() {
-								goto l47
-							}
-							goto l46
-						l47:
-							position, tokenIndex = position47, tokenIndex47
-						}
-						add(ruleStatement, position29)
+					if !_rules[ruleStatement]() {
+						goto l7
 					}
-				l48:
+				l10:
 					{
-						position49, tokenIndex49 := position, tokenIndex
+						position11, tokenIndex11 := position, tokenIndex
 						if !_rules[ruleBlankLine]() {
-							goto l49
+							goto l11
 						}
-						goto l48
-					l49:
-						position, tokenIndex = position49, tokenIndex49
+						goto l10
+					l11:
+						position, tokenIndex = position11, tokenIndex11
 					}
-					goto l2
-				l3:
-					position, tokenIndex = position3, tokenIndex3
+					goto l6
+				l7:
+					position, tokenIndex = position7, tokenIndex7
 				}
 				if !_rules[ruleWhiteSpacing]() {
 					goto l0
 				}
-				{
-					position50 := position
-					{
-						position51, tokenIndex51 := position, tokenIndex
-						if !matchDot() {
-							goto l51
-						}
-						goto l0
-					l51:
-						position, tokenIndex = position51, tokenIndex51
-					}
-					add(ruleEndOfFile, position50)
+				if !_rules[ruleEndOfFile]() {
+					goto l0
 				}
 				add(ruleScript, position1)
 			}
@@ -729,1394 +598,2015 @@ func (p *Peg) Init() {
 			position, tokenIndex = position0, tokenIndex0
 			return false
 		},
-		/* 1 Statement <- <(WhiteSpacing (Expr / Declaration / Comment) WhiteSpacing EndOfLine*)> */
-		nil,
-		/* 2 Action <- <[a-z]+> */
-		nil,
-		/* 3 Entity <- <[a-z]+> */
-		nil,
-		/* 4 Declaration <- <(<Identifier> Action0 Equal Expr)> */
-		nil,
-		/* 5 Expr <- <(<Action> Action1 MustWhiteSpacing <Entity> Action2 (MustWhiteSpacing Params)? Action3)> */
+		/* 1 Statement <- WhiteSpacing { p.markStatementStart(int(token.begin)) } (ForLoop / IfBlock / Include / Expr / Declaration / Comment) { p.markStatementEnd(int(token.begin)) } WhiteSpacing EndOfLine* */
 		func() bool {
-			position56, tokenIndex56 := position, tokenIndex
+			position12, tokenIndex12 := position, tokenIndex
 			{
-				position57 := position
+				position13 := position
+				if !_rules[ruleWhiteSpacing]() {
+					goto l12
+				}
 				{
-					position58 := position
-					{
-						position59 := position
-						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l56
-						}
-						position++
-					l60:
-						{
-							position61, tokenIndex61 := position, tokenIndex
-							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l61
-							}
-							position++
-							goto l60
-						l61:
-							position, tokenIndex = position61, tokenIndex61
-						}
-						add(ruleAction, position59)
+					add(ruleAction0, position)
+				}
+				{
+					position14, tokenIndex14 := position, tokenIndex
+					if !_rules[ruleForLoop]() {
+						goto l15
+					}
+					goto l14
+				l15:
+					position, tokenIndex = position14, tokenIndex14
+					if !_rules[ruleIfBlock]() {
+						goto l16
+					}
+					goto l14
+				l16:
+					position, tokenIndex = position14, tokenIndex14
+					if !_rules[ruleInclude]() {
+						goto l17
+					}
+					goto l14
+				l17:
+					position, tokenIndex = position14, tokenIndex14
+					if !_rules[ruleExpr]() {
+						goto l18
+					}
+					goto l14
+				l18:
+					position, tokenIndex = position14, tokenIndex14
+					if !_rules[ruleDeclaration]() {
+						goto l19
+					}
+					goto l14
+				l19:
+					position, tokenIndex = position14, tokenIndex14
+					if !_rules[ruleComment]() {
+						goto l12
 					}
-					add(rulePegText, position58)
 				}
+			l14:
 				{
 					add(ruleAction1, position)
 				}
-				if !_rules[ruleMustWhiteSpacing]() {
-					goto l56
+				if !_rules[ruleWhiteSpacing]() {
+					goto l12
 				}
+			l20:
 				{
-					position63 := position
-					{
-						position64 := position
-						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l56
-						}
-						position++
-					l65:
-						{
-							position66, tokenIndex66 := position, tokenIndex
-							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l66
-							}
-							position++
-							goto l65
-						l66:
-							position, tokenIndex = position66, tokenIndex66
-						}
-						add(ruleEntity, position64)
+					position21, tokenIndex21 := position, tokenIndex
+					if !_rules[ruleEndOfLine]() {
+						goto l21
 					}
-					add(rulePegText, position63)
+					goto l20
+				l21:
+					position, tokenIndex = position21, tokenIndex21
 				}
-				{
-					add(ruleAction2, position)
+				add(ruleStatement, position13)
+			}
+			return true
+		l12:
+			position, tokenIndex = position12, tokenIndex12
+			return false
+		},
+		/* 2 Action <- [a-z]+ */
+		func() bool {
+			position22, tokenIndex22 := position, tokenIndex
+			{
+				position23 := position
+				if c := buffer[position]; !(c >= rune('a') && c <= rune('z')) {
+					goto l22
 				}
+				position++
+			l24:
 				{
-					position68, tokenIndex68 := position, tokenIndex
-					if !_rules[ruleMustWhiteSpacing]() {
-						goto l68
-					}
-					{
-						position70 := position
-						{
-							position73 := position
-							{
-								position74 := position
-								if !_rules[ruleIdentifier]() {
-									goto l68
-								}
-								add(rulePegText, position74)
-							}
-							{
-								add(ruleAction4, position)
-							}
-							if !_rules[ruleEqual]() {
-								goto l68
-							}
-							{
-								position76 := position
-								{
-									position77, tokenIndex77 := position, tokenIndex
-									{
-										position79 := position
-										{
-											position80 := position
-											if c := buffer[position]; c < rune('0') || c > rune('9') {
-												goto l78
-											}
-											position++
-										l81:
-											{
-												position82, tokenIndex82 := position, tokenIndex
-												if c := buffer[position]; c < rune('0') || c > rune('9') {
-													goto l82
-												}
-												position++
-												goto l81
-											l82:
-												position, tokenIndex = position82, tokenIndex82
-											}
-											if !matchDot() {
-												goto l78
-											}
-											if c := buffer[position]; c < rune('0') || c > rune('9') {
-												goto l78
-											}
-											position++
-										l83:
-											{
-												position84, tokenIndex84 := position, tokenIndex
-												if c := buffer[position]; c < rune('0') || c > rune('9') {
-													goto l84
-												}
-												position++
-												goto l83
-											l84:
-												position, tokenIndex = position84, tokenIndex84
-											}
-											if !matchDot() {
-												goto l78
-											}
-											if c := buffer[position]; c < rune('0') || c > rune('9') {
-												goto l78
-											}
-											position++
-										l85:
-											{
-												position86, tokenIndex86 := position, tokenIndex
-												if c := buffer[position]; c < rune('0') || c > rune('9') {
-													goto l86
-												}
-												position++
-												goto l85
-											l86:
-												position, tokenIndex = position86, tokenIndex86
-											}
-											if !matchDot() {
-												goto l78
-											}
-											if c := buffer[position]; c < rune('0') || c > rune('9') {
-												goto l78
-											}
-											position++
-										l87:
-											{
-												position88, tokenIndex88 := position, tokenIndex
-												if c := buffer[position]; c < rune('0') || c > rune('9') {
-													goto l88
-												}
-												position++
-												goto l87
-											l88:
-												position, tokenIndex = position88, tokenIndex88
-											}
-											if buffer[position] != rune('/') {
-												goto l78
-											}
-											position++
-											if c := buffer[position]; c < rune('0') || c > rune('9') {
-												goto l78
-											}
-											position++
-										l89:
-											{
-												position90, tokenIndex90 := position, tokenIndex
-												if c := buffer[position]; c < rune('0') || c > rune('9') {
-													goto l90
-												}
-												position++
-												goto l89
-											l90:
-												position, tokenIndex = position90, tokenIndex90
-											}
-											add(ruleCidrValue, position80)
-										}
-										add(rulePegText, position79)
-									}
-									{
-										add(ruleAction8, position)
-									}
-									goto l77
-								l78:
-									position, tokenIndex = position77, tokenIndex77
-									{
-										position93 := position
-										{
-											position94 := position
-											if c := buffer[position]; c < rune('0') || c > rune('9') {
-												goto l92
-											}
-											position++
-										l95:
-											{
-												position96, tokenIndex96 := position, tokenIndex
-												if c := buffer[position]; c < rune('0') || c > rune('9') {
-													goto l96
-												}
-												position++
-												goto l95
-											l96:
-												position, tokenIndex = position96, tokenIndex96
-											}
-											if !matchDot() {
-												goto l92
-											}
-											if c := buffer[position]; c < rune('0') || c > rune('9') {
-												goto l92
-											}
-											position++
-										l97:
-											{
-												position98, tokenIndex98 := position, tokenIndex
-												if c := buffer[position]; c < rune('0') || c > rune('9') {
-													goto l98
-												}
-												position++
-												goto l97
-											l98:
-												position, tokenIndex = position98, tokenIndex98
-											}
-											if !matchDot() {
-												goto l92
-											}
-											if c := buffer[position]; c < rune('0') || c > rune('9') {
-												goto l92
-											}
-											position++
-										l99:
-											{
-												position100, tokenIndex100 := position, tokenIndex
-												if c := buffer[position]; c < rune('0') || c > rune('9') {
-													goto l100
-												}
-												position++
-												goto l99
-											l100:
-												position, tokenIndex = position100, tokenIndex100
-											}
-											if !matchDot() {
-												goto l92
-											}
-											if c := buffer[position]; c < rune('0') || c > rune('9') {
-												goto l92
-											}
-											position++
-										l101:
-											{
-												position102, tokenIndex102 := position, tokenIndex
-												if c := buffer[position]; c < rune('0') || c > rune('9') {
-													goto l102
-												}
-												position++
-												goto l101
-											l102:
-												position, tokenIndex = position102, tokenIndex102
-											}
-											add(ruleIpValue, position94)
-										}
-										add(rulePegText, position93)
-									}
-									{
-										add(ruleAction9, position)
-									}
-									goto l77
-								l92:
-									position, tokenIndex = position77, tokenIndex77
-									{
-										position105 := position
-										{
-											position106 := position
-											if !_rules[ruleStringValue]() {
-												goto l104
-											}
-											if !_rules[ruleWhiteSpacing]() {
-												goto l104
-											}
-											if buffer[position] != rune(',') {
-												goto l104
-											}
-											position++
-											if !_rules[ruleWhiteSpacing]() {
-												goto l104
-											}
-										l107:
-											{
-												position108, tokenIndex108 := position, tokenIndex
-												if !_rules[ruleStringValue]() {
-													goto l108
-												}
-												if !_rules[ruleWhiteSpacing]() {
-													goto l108
-												}
-												if buffer[position] != rune(',') {
-													goto l108
-												}
-												position++
-												if !_rules[ruleWhiteSpacing]() {
-													goto l108
-												}
-												goto l107
-											l108:
-												position, tokenIndex = position108, tokenIndex108
-											}
-											if !_rules[ruleStringValue]() {
-												goto l104
-											}
-											add(ruleCSVValue, position106)
-										}
-										add(rulePegText, position105)
-									}
-									{
-										add(ruleAction10, position)
-									}
-									goto l77
-								l104:
-									position, tokenIndex = position77, tokenIndex77
-									{
-										position111 := position
-										{
-											position112 := position
-											if c := buffer[position]; c < rune('0') || c > rune('9') {
-												goto l110
-											}
-											position++
-										l113:
-											{
-												position114, tokenIndex114 := position, tokenIndex
-												if c := buffer[position]; c < rune('0') || c > rune('9') {
-													goto l114
-												}
-												position++
-												goto l113
-											l114:
-												position, tokenIndex = position114, tokenIndex114
-											}
-											if buffer[position] != rune('-') {
-												goto l110
-											}
-											position++
-											if c := buffer[position]; c < rune('0') || c > rune('9') {
-												goto l110
-											}
-											position++
-										l115:
-											{
-												position116, tokenIndex116 := position, tokenIndex
-												if c := buffer[position]; c < rune('0') || c > rune('9') {
-													goto l116
-												}
-												position++
-												goto l115
-											l116:
-												position, tokenIndex = position116, tokenIndex116
-											}
-											add(ruleIntRangeValue, position112)
-										}
-										add(rulePegText, position111)
-									}
-									{
-										add(ruleAction11, position)
-									}
-									goto l77
-								l110:
-									position, tokenIndex = position77, tokenIndex77
-									{
-										position119 := position
-										{
-											position120 := position
-											if c := buffer[position]; c < rune('0') || c > rune('9') {
-												goto l118
-											}
-											position++
-										l121:
-											{
-												position122, tokenIndex122 := position, tokenIndex
-												if c := buffer[position]; c < rune('0') || c > rune('9') {
-													goto l122
-												}
-												position++
-												goto l121
-											l122:
-												position, tokenIndex = position122, tokenIndex122
-											}
-											add(ruleIntValue, position120)
-										}
-										add(rulePegText, position119)
-									}
-									{
-										add(ruleAction12, position)
-									}
-									goto l77
-								l118:
-									position, tokenIndex = position77, tokenIndex77
-									{
-										switch buffer[position] {
-										case '$':
-											{
-												position125 := position
-												if buffer[position] != rune('$') {
-													goto l68
-												}
-												position++
-												{
-													position126 := position
-													if !_rules[ruleIdentifier]() {
-														goto l68
-													}
-													add(rulePegText, position126)
-												}
-												add(ruleRefValue, position125)
-											}
-											{
-												add(ruleAction7, position)
-											}
-											break
-										case '@':
-											{
-												position128 := position
-												{
-													position129 := position
-													if buffer[position] != rune('@') {
-														goto l68
-													}
-													position++
-													if !_rules[ruleStringValue]() {
-														goto l68
-													}
-													add(rulePegText, position129)
-												}
-												add(ruleAliasValue, position128)
-											}
-											{
-												add(ruleAction6, position)
-											}
-											break
-										case '{':
-											{
-												position131 := position
-												if buffer[position] != rune('{') {
-													goto l68
-												}
-												position++
-												if !_rules[ruleWhiteSpacing]() {
-													goto l68
-												}
-												{
-													position132 := position
-													if !_rules[ruleIdentifier]() {
-														goto l68
-													}
-													add(rulePegText, position132)
-												}
-												if !_rules[ruleWhiteSpacing]() {
-													goto l68
-												}
-												if buffer[position] != rune('}') {
-													goto l68
-												}
-												position++
-												add(ruleHoleValue, position131)
-											}
-											{
-												add(ruleAction5, position)
-											}
-											break
-										default:
-											{
-												position134 := position
-												if !_rules[ruleStringValue]() {
-													goto l68
-												}
-												add(rulePegText, position134)
-											}
-											{
-												add(ruleAction13, position)
-											}
-											break
-										}
-									}
-
-								}
-							l77:
-								add(ruleValue, position76)
-							}
-							if !_rules[ruleWhiteSpacing]() {
-								goto l68
-							}
-							add(ruleParam, position73)
-						}
-					l71:
-						{
-							position72, tokenIndex72 := position, tokenIndex
-							{
-								position136 := position
-								{
-									position137 := position
-									if !_rules[ruleIdentifier]() {
-										goto l72
-									}
-									add(rulePegText, position137)
-								}
-								{
-									add(ruleAction4, position)
-								}
-								if !_rules[ruleEqual]() {
-									goto l72
-								}
-								{
-									position139 := position
-									{
-										position140, tokenIndex140 := position, tokenIndex
-										{
-											position142 := position
-											{
-												position143 := position
-												if c := buffer[position]; c < rune('0') || c > rune('9') {
-													goto l141
-												}
-												position++
-											l144:
-												{
-													position145, tokenIndex145 := position, tokenIndex
-													if c := buffer[position]; c < rune('0') || c > rune('9') {
-														goto l145
-													}
-													position++
-													goto l144
-												l145:
-													position, tokenIndex = position145, tokenIndex145
-												}
-												if !matchDot() {
-													goto l141
-												}
-												if c := buffer[position]; c < rune('0') || c > rune('9') {
-													goto l141
-												}
-												position++
-											l146:
-												{
-													position147, tokenIndex147 := position, tokenIndex
-													if c := buffer[position]; c < rune('0') || c > rune('9') {
-														goto l147
-													}
-													position++
-													goto l146
-												l147:
-													position, tokenIndex = position147, tokenIndex147
-												}
-												if !matchDot() {
-													goto l141
-												}
-												if c := buffer[position]; c < rune('0') || c > rune('9') {
-													goto l141
-												}
-												position++
-											l148:
-												{
-													position149, tokenIndex149 := position, tokenIndex
-													if c := buffer[position]; c < rune('0') || c > rune('9') {
-														goto l149
-													}
-													position++
-													goto l148
-												l149:
-													position, tokenIndex = position149, tokenIndex149
-												}
-												if !matchDot() {
-													goto l141
-												}
-												if c := buffer[position]; c < rune('0') || c > rune('9') {
-													goto l141
-												}
-												position++
-											l150:
-												{
-													position151, tokenIndex151 := position, tokenIndex
-													if c := buffer[position]; c < rune('0') || c > rune('9') {
-														goto l151
-													}
-													position++
-													goto l150
-												l151:
-													position, tokenIndex = position151, tokenIndex151
-												}
-												if buffer[position] != rune('/') {
-													goto l141
-												}
-												position++
-												if c := buffer[position]; c < rune('0') || c > rune('9') {
-													goto l141
-												}
-												position++
-											l152:
-												{
-													position153, tokenIndex153 := position, tokenIndex
-													if c := buffer[position]; c < rune('0') || c > rune('9') {
-														goto l153
-													}
-													position++
-													goto l152
-												l153:
-													position, tokenIndex = position153, tokenIndex153
-												}
-												add(ruleCidrValue, position143)
-											}
-											add(rulePegText, position142)
-										}
-										{
-											add(ruleAction8, position)
-										}
-										goto l140
-									l141:
-										position, tokenIndex = position140, tokenIndex140
-										{
-											position156 := position
-											{
-												position157 := position
-												if c := buffer[position]; c < rune('0') || c > rune('9') {
-													goto l155
-												}
-												position++
-											l158:
-												{
-													position159, tokenIndex159 := position, tokenIndex
-													if c := buffer[position]; c < rune('0') || c > rune('9') {
-														goto l159
-													}
-													position++
-													goto l158
-												l159:
-													position, tokenIndex = position159, tokenIndex159
-												}
-												if !matchDot() {
-													goto l155
-												}
-												if c := buffer[position]; c < rune('0') || c > rune('9') {
-													goto l155
-												}
-												position++
-											l160:
-												{
-													position161, tokenIndex161 := position, tokenIndex
-													if c := buffer[position]; c < rune('0') || c > rune('9') {
-														goto l161
-													}
-													position++
-													goto l160
-												l161:
-													position, tokenIndex = position161, tokenIndex161
-												}
-												if !matchDot() {
-													goto l155
-												}
-												if c := buffer[position]; c < rune('0') || c > rune('9') {
-													goto l155
-												}
-												position++
-											l162:
-												{
-													position163, tokenIndex163 := position, tokenIndex
-													if c := buffer[position]; c < rune('0') || c > rune('9') {
-														goto l163
-													}
-													position++
-													goto l162
-												l163:
-													position, tokenIndex = position163, tokenIndex163
-												}
-												if !matchDot() {
-													goto l155
-												}
-												if c := buffer[position]; c < rune('0') || c > rune('9') {
-													goto l155
-												}
-												position++
-											l164:
-												{
-													position165, tokenIndex165 := position, tokenIndex
-													if c := buffer[position]; c < rune('0') || c > rune('9') {
-														goto l165
-													}
-													position++
-													goto l164
-												l165:
-													position, tokenIndex = position165, tokenIndex165
-												}
-												add(ruleIpValue, position157)
-											}
-											add(rulePegText, position156)
-										}
-										{
-											add(ruleAction9, position)
-										}
-										goto l140
-									l155:
-										position, tokenIndex = position140, tokenIndex140
-										{
-											position168 := position
-											{
-												position169 := position
-												if !_rules[ruleStringValue]() {
-													goto l167
-												}
-												if !_rules[ruleWhiteSpacing]() {
-													goto l167
-												}
-												if buffer[position] != rune(',') {
-													goto l167
-												}
-												position++
-												if !_rules[ruleWhiteSpacing]() {
-													goto l167
-												}
-											l170:
-												{
-													position171, tokenIndex171 := position, tokenIndex
-													if !_rules[ruleStringValue]() {
-														goto l171
-													}
-													if !_rules[ruleWhiteSpacing]() {
-														goto l171
-													}
-													if buffer[position] != rune(',') {
-														goto l171
-													}
-													position++
-													if !_rules[ruleWhiteSpacing]() {
-														goto l171
-													}
-													goto l170
-												l171:
-													position, tokenIndex = position171, tokenIndex171
-												}
-												if !_rules[ruleStringValue]() {
-													goto l167
-												}
-												add(ruleCSVValue, position169)
-											}
-											add(rulePegText, position168)
-										}
-										{
-											add(ruleAction10, position)
-										}
-										goto l140
-									l167:
-										position, tokenIndex = position140, tokenIndex140
-										{
-											position174 := position
-											{
-												position175 := position
-												if c := buffer[position]; c < rune('0') || c > rune('9') {
-													goto l173
-												}
-												position++
-											l176:
-												{
-													position177, tokenIndex177 := position, tokenIndex
-													if c := buffer[position]; c < rune('0') || c > rune('9') {
-														goto l177
-													}
-													position++
-													goto l176
-												l177:
-													position, tokenIndex = position177, tokenIndex177
-												}
-												if buffer[position] != rune('-') {
-													goto l173
-												}
-												position++
-												if c := buffer[position]; c < rune('0') || c > rune('9') {
-													goto l173
-												}
-												position++
-											l178:
-												{
-													position179, tokenIndex179 := position, tokenIndex
-													if c := buffer[position]; c < rune('0') || c > rune('9') {
-														goto l179
-													}
-													position++
-													goto l178
-												l179:
-													position, tokenIndex = position179, tokenIndex179
-												}
-												add(ruleIntRangeValue, position175)
-											}
-											add(rulePegText, position174)
-										}
-										{
-											add(ruleAction11, position)
-										}
-										goto l140
-									l173:
-										position, tokenIndex = position140, tokenIndex140
-										{
-											position182 := position
-											{
-												position183 := position
-												if c := buffer[position]; c < rune('0') || c > rune('9') {
-													goto l181
-												}
-												position++
-											l184:
-												{
-													position185, tokenIndex185 := position, tokenIndex
-													if c := buffer[position]; c < rune('0') || c > rune('9') {
-														goto l185
-													}
-													position++
-													goto l184
-												l185:
-													position, tokenIndex = position185, tokenIndex185
-												}
-												add(ruleIntValue, position183)
-											}
-											add(rulePegText, position182)
-										}
-										{
-											add(ruleAction12, position)
-										}
-										goto l140
-									l181:
-										position, tokenIndex = position140, tokenIndex140
-										{
-											switch buffer[position] {
-											case '$':
-												{
-													position188 := position
-													if buffer[position] != rune('$') {
-														goto l72
-													}
-													position++
-													{
-														position189 := position
-														if !_rules[ruleIdentifier]() {
-															goto l72
-														}
-														add(rulePegText, position189)
-													}
-													add(ruleRefValue, position188)
-												}
-												{
-													add(ruleAction7, position)
-												}
-												break
-											case '@':
-												{
-													position191 := position
-													{
-														position192 := position
-														if buffer[position] != rune('@') {
-															goto l72
-														}
-														position++
-														if !_rules[ruleStringValue]() {
-															goto l72
-														}
-														add(rulePegText, position192)
-													}
-													add(ruleAliasValue, position191)
-												}
-												{
-													add(ruleAction6, position)
-												}
-												break
-											case '{':
-												{
-													position194 := position
-													if buffer[position] != rune('{') {
-														goto l72
-													}
-													position++
-													if !_rules[ruleWhiteSpacing]() {
-														goto l72
-													}
-													{
-														position195 := position
-														if !_rules[ruleIdentifier]() {
-															goto l72
-														}
-														add(rulePegText, position195)
-													}
-													if !_rules[ruleWhiteSpacing]() {
-														goto l72
-													}
-													if buffer[position] != rune('}') {
-														goto l72
-													}
-													position++
-													add(ruleHoleValue, position194)
-												}
-												{
-													add(ruleAction5, position)
-												}
-												break
-											default:
-												{
-													position197 := position
-													if !_rules[ruleStringValue]() {
-														goto l72
-													}
-													add(rulePegText, position197)
-												}
-												{
-													add(ruleAction13, position)
-												}
-												break
-											}
-										}
-
-									}
-								l140:
-									add(ruleValue, position139)
-								}
-								if !_rules[ruleWhiteSpacing]() {
-									goto l72
-								}
-								add(ruleParam, position136)
-							}
-							goto l71
-						l72:
-							position, tokenIndex = position72, tokenIndex72
-						}
-						add(ruleParams, position70)
+					position25, tokenIndex25 := position, tokenIndex
+					if c := buffer[position]; !(c >= rune('a') && c <= rune('z')) {
+						goto l25
 					}
-					goto l69
-				l68:
-					position, tokenIndex = position68, tokenIndex68
+					position++
+					goto l24
+				l25:
+					position, tokenIndex = position25, tokenIndex25
 				}
-			l69:
+				add(ruleAction, position23)
+			}
+			return true
+		l22:
+			position, tokenIndex = position22, tokenIndex22
+			return false
+		},
+		/* 3 Entity <- [a-z]+ */
+		func() bool {
+			position26, tokenIndex26 := position, tokenIndex
+			{
+				position27 := position
+				if c := buffer[position]; !(c >= rune('a') && c <= rune('z')) {
+					goto l26
+				}
+				position++
+			l28:
 				{
-					add(ruleAction3, position)
+					position29, tokenIndex29 := position, tokenIndex
+					if c := buffer[position]; !(c >= rune('a') && c <= rune('z')) {
+						goto l29
+					}
+					position++
+					goto l28
+				l29:
+					position, tokenIndex = position29, tokenIndex29
 				}
-				add(ruleExpr, position57)
+				add(ruleEntity, position27)
 			}
 			return true
-		l56:
-			position, tokenIndex = position56, tokenIndex56
+		l26:
+			position, tokenIndex = position26, tokenIndex26
 			return false
 		},
-		/* 6 Params <- <Param+> */
-		nil,
-		/* 7 Param <- <(<Identifier> Action4 Equal Value WhiteSpacing)> */
-		nil,
-		/* 8 Identifier <- <((&('.') '.') | (&('_') '_') | (&('-') '-') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+> */
+		/* 4 Declaration <- <Identifier> { p.addDeclarationIdentifier(text) } Equal Expr */
 		func() bool {
-			position202, tokenIndex202 := position, tokenIndex
+			position30, tokenIndex30 := position, tokenIndex
 			{
-				position203 := position
+				position31 := position
 				{
-					switch buffer[position] {
-					case '.':
-						if buffer[position] != rune('.') {
-							goto l202
-						}
-						position++
-						break
-					case '_':
-						if buffer[position] != rune('_') {
-							goto l202
-						}
-						position++
-						break
-					case '-':
-						if buffer[position] != rune('-') {
-							goto l202
-						}
-						position++
-						break
-					case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l202
-						}
-						position++
-						break
-					case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
-						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l202
-						}
-						position++
-						break
-					default:
-						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l202
-						}
-						position++
-						break
+					position32 := position
+					if !_rules[ruleIdentifier]() {
+						goto l30
 					}
+					add(rulePegText, position32)
 				}
-
-			l204:
 				{
-					position205, tokenIndex205 := position, tokenIndex
-					{
-						switch buffer[position] {
-						case '.':
-							if buffer[position] != rune('.') {
-								goto l205
-							}
-							position++
-							break
-						case '_':
-							if buffer[position] != rune('_') {
-								goto l205
-							}
-							position++
-							break
-						case '-':
-							if buffer[position] != rune('-') {
-								goto l205
-							}
-							position++
-							break
-						case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
-							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l205
-							}
-							position++
-							break
-						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
-							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l205
-							}
-							position++
-							break
-						default:
-							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l205
-							}
-							position++
-							break
-						}
-					}
-
-					goto l204
-				l205:
-					position, tokenIndex = position205, tokenIndex205
+					add(ruleAction2, position)
 				}
-				add(ruleIdentifier, position203)
+				if !_rules[ruleEqual]() {
+					goto l30
+				}
+				if !_rules[ruleExpr]() {
+					goto l30
+				}
+				add(ruleDeclaration, position31)
 			}
 			return true
-		l202:
-			position, tokenIndex = position202, tokenIndex202
+		l30:
+			position, tokenIndex = position30, tokenIndex30
 			return false
 		},
-		/* 9 Value <- <((<CidrValue> Action8) / (<IpValue> Action9) / (<CSVValue> Action10) / (<IntRangeValue> Action11) / (<IntValue> Action12) / ((&('$') (RefValue Action7)) | (&('@') (AliasValue Action6)) | (&('{') (HoleValue Action5)) | (&('-' | '.' | '/' | '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9' | ':' | 'A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') (<StringValue> Action13))))> */
-		nil,
-		/* 10 StringValue <- <((&('/') '/') | (&(':') ':') | (&('_') '_') | (&('.') '.') | (&('-') '-') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+> */
+		/* 5 Expr <- <Action> { p.addAction(text) } MustWhiteSpacing <Entity> { p.addEntity(text) } (MustWhiteSpacing Params)? { p.LineDone() } */
 		func() bool {
-			position209, tokenIndex209 := position, tokenIndex
+			position33, tokenIndex33 := position, tokenIndex
 			{
-				position210 := position
+				position34 := position
 				{
-					switch buffer[position] {
-					case '/':
-						if buffer[position] != rune('/') {
-							goto l209
-						}
-						position++
-						break
-					case ':':
-						if buffer[position] != rune(':') {
-							goto l209
-						}
-						position++
-						break
-					case '_':
-						if buffer[position] != rune('_') {
-							goto l209
-						}
-						position++
-						break
-					case '.':
-						if buffer[position] != rune('.') {
-							goto l209
-						}
-						position++
-						break
-					case '-':
-						if buffer[position] != rune('-') {
-							goto l209
-						}
-						position++
-						break
-					case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l209
-						}
-						position++
-						break
-					case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
-						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l209
-						}
-						position++
-						break
-					default:
-						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l209
-						}
-						position++
-						break
+					position35 := position
+					if !_rules[ruleAction]() {
+						goto l33
 					}
+					add(rulePegText, position35)
 				}
-
-			l211:
 				{
-					position212, tokenIndex212 := position, tokenIndex
+					add(ruleAction3, position)
+				}
+				if !_rules[ruleMustWhiteSpacing]() {
+					goto l33
+				}
+				{
+					position36 := position
+					if !_rules[ruleEntity]() {
+						goto l33
+					}
+					add(rulePegText, position36)
+				}
+				{
+					add(ruleAction4, position)
+				}
+				{
+					position37, tokenIndex37 := position, tokenIndex
+					if !_rules[ruleMustWhiteSpacing]() {
+						goto l38
+					}
+					if !_rules[ruleParams]() {
+						goto l38
+					}
+					goto l37
+				l38:
+					position, tokenIndex = position37, tokenIndex37
+				}
+			l37:
+				{
+					add(ruleAction5, position)
+				}
+				add(ruleExpr, position34)
+			}
+			return true
+		l33:
+			position, tokenIndex = position33, tokenIndex33
+			return false
+		},
+		/* 6 ForLoop <- 'for' MustWhiteSpacing <Identifier> { p.addForLoopVariable(text) } MustWhiteSpacing 'in' MustWhiteSpacing <LoopValues> { p.addForLoopValues(text) } WhiteSpacing '{' <BlockBody> { p.addForLoopBody(text) } '}' { p.LineDone() } */
+		func() bool {
+			position39, tokenIndex39 := position, tokenIndex
+			{
+				position40 := position
+				if buffer[position] != rune('f') {
+					goto l39
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l39
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l39
+				}
+				position++
+				if !_rules[ruleMustWhiteSpacing]() {
+					goto l39
+				}
+				{
+					position41 := position
+					if !_rules[ruleIdentifier]() {
+						goto l39
+					}
+					add(rulePegText, position41)
+				}
+				{
+					add(ruleAction6, position)
+				}
+				if !_rules[ruleMustWhiteSpacing]() {
+					goto l39
+				}
+				if buffer[position] != rune('i') {
+					goto l39
+				}
+				position++
+				if buffer[position] != rune('n') {
+					goto l39
+				}
+				position++
+				if !_rules[ruleMustWhiteSpacing]() {
+					goto l39
+				}
+				{
+					position42 := position
+					if !_rules[ruleLoopValues]() {
+						goto l39
+					}
+					add(rulePegText, position42)
+				}
+				{
+					add(ruleAction7, position)
+				}
+				if !_rules[ruleWhiteSpacing]() {
+					goto l39
+				}
+				if buffer[position] != rune('{') {
+					goto l39
+				}
+				position++
+				{
+					position43 := position
+					if !_rules[ruleBlockBody]() {
+						goto l39
+					}
+					add(rulePegText, position43)
+				}
+				{
+					add(ruleAction8, position)
+				}
+				if buffer[position] != rune('}') {
+					goto l39
+				}
+				position++
+				{
+					add(ruleAction9, position)
+				}
+				add(ruleForLoop, position40)
+			}
+			return true
+		l39:
+			position, tokenIndex = position39, tokenIndex39
+			return false
+		},
+		/* 7 LoopValues <- IntRangeValue / CSVValue / StringValue */
+		func() bool {
+			position44, tokenIndex44 := position, tokenIndex
+			{
+				position45 := position
+				{
+					position46, tokenIndex46 := position, tokenIndex
+					if !_rules[ruleIntRangeValue]() {
+						goto l47
+					}
+					goto l46
+				l47:
+					position, tokenIndex = position46, tokenIndex46
+					if !_rules[ruleCSVValue]() {
+						goto l48
+					}
+					goto l46
+				l48:
+					position, tokenIndex = position46, tokenIndex46
+					if !_rules[ruleStringValue]() {
+						goto l44
+					}
+				}
+			l46:
+				add(ruleLoopValues, position45)
+			}
+			return true
+		l44:
+			position, tokenIndex = position44, tokenIndex44
+			return false
+		},
+		/* 8 IfBlock <- <('if' / 'unless')> { p.addIfBlock(text) } MustWhiteSpacing 'exists' MustWhiteSpacing <Entity> { p.addIfEntity(text) } (MustWhiteSpacing Condition)* WhiteSpacing '{' <BlockBody> { p.addIfBody(text) } '}' { p.LineDone() } */
+		func() bool {
+			position49, tokenIndex49 := position, tokenIndex
+			{
+				position50 := position
+				{
+					position51 := position
+					{
+						position52, tokenIndex52 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l53
+						}
+						position++
+						if buffer[position] != rune('f') {
+							goto l53
+						}
+						position++
+						goto l52
+					l53:
+						position, tokenIndex = position52, tokenIndex52
+						if buffer[position] != rune('u') {
+							goto l49
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l49
+						}
+						position++
+						if buffer[position] != rune('l') {
+							goto l49
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l49
+						}
+						position++
+						if buffer[position] != rune('s') {
+							goto l49
+						}
+						position++
+						if buffer[position] != rune('s') {
+							goto l49
+						}
+						position++
+					}
+				l52:
+					add(rulePegText, position51)
+				}
+				{
+					add(ruleAction10, position)
+				}
+				if !_rules[ruleMustWhiteSpacing]() {
+					goto l49
+				}
+				if buffer[position] != rune('e') {
+					goto l49
+				}
+				position++
+				if buffer[position] != rune('x') {
+					goto l49
+				}
+				position++
+				if buffer[position] != rune('i') {
+					goto l49
+				}
+				position++
+				if buffer[position] != rune('s') {
+					goto l49
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l49
+				}
+				position++
+				if buffer[position] != rune('s') {
+					goto l49
+				}
+				position++
+				if !_rules[ruleMustWhiteSpacing]() {
+					goto l49
+				}
+				{
+					position54 := position
+					if !_rules[ruleEntity]() {
+						goto l49
+					}
+					add(rulePegText, position54)
+				}
+				{
+					add(ruleAction11, position)
+				}
+			l55:
+				{
+					position56, tokenIndex56 := position, tokenIndex
+					if !_rules[ruleMustWhiteSpacing]() {
+						goto l56
+					}
+					if !_rules[ruleCondition]() {
+						goto l56
+					}
+					goto l55
+				l56:
+					position, tokenIndex = position56, tokenIndex56
+				}
+				if !_rules[ruleWhiteSpacing]() {
+					goto l49
+				}
+				if buffer[position] != rune('{') {
+					goto l49
+				}
+				position++
+				{
+					position57 := position
+					if !_rules[ruleBlockBody]() {
+						goto l49
+					}
+					add(rulePegText, position57)
+				}
+				{
+					add(ruleAction12, position)
+				}
+				if buffer[position] != rune('}') {
+					goto l49
+				}
+				position++
+				{
+					add(ruleAction13, position)
+				}
+				add(ruleIfBlock, position50)
+			}
+			return true
+		l49:
+			position, tokenIndex = position49, tokenIndex49
+			return false
+		},
+		/* 9 Condition <- <Identifier> { p.addIfConditionKey(text) } Equal <(AliasValue / StringValue)> { p.addIfConditionValue(text) } */
+		func() bool {
+			position58, tokenIndex58 := position, tokenIndex
+			{
+				position59 := position
+				{
+					position60 := position
+					if !_rules[ruleIdentifier]() {
+						goto l58
+					}
+					add(rulePegText, position60)
+				}
+				{
+					add(ruleAction14, position)
+				}
+				if !_rules[ruleEqual]() {
+					goto l58
+				}
+				{
+					position61 := position
+					{
+						position62, tokenIndex62 := position, tokenIndex
+						if !_rules[ruleAliasValue]() {
+							goto l63
+						}
+						goto l62
+					l63:
+						position, tokenIndex = position62, tokenIndex62
+						if !_rules[ruleStringValue]() {
+							goto l58
+						}
+					}
+				l62:
+					add(rulePegText, position61)
+				}
+				{
+					add(ruleAction15, position)
+				}
+				add(ruleCondition, position59)
+			}
+			return true
+		l58:
+			position, tokenIndex = position58, tokenIndex58
+			return false
+		},
+		/* 10 Include <- { p.addInclude() } (<Identifier> { p.addIncludeIdentifier(text) } Equal)? ('include' / 'use') MustWhiteSpacing IncludeSource (MustWhiteSpacing Params)? { p.LineDone() } */
+		func() bool {
+			position64, tokenIndex64 := position, tokenIndex
+			{
+				position65 := position
+				{
+					add(ruleAction16, position)
+				}
+				{
+					position66, tokenIndex66 := position, tokenIndex
+					{
+						position68 := position
+						if !_rules[ruleIdentifier]() {
+							goto l67
+						}
+						add(rulePegText, position68)
+					}
+					{
+						add(ruleAction17, position)
+					}
+					if !_rules[ruleEqual]() {
+						goto l67
+					}
+					goto l66
+				l67:
+					position, tokenIndex = position66, tokenIndex66
+				}
+			l66:
+				{
+					position69, tokenIndex69 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l70
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l70
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l70
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l70
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l70
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l70
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l70
+					}
+					position++
+					goto l69
+				l70:
+					position, tokenIndex = position69, tokenIndex69
+					if buffer[position] != rune('u') {
+						goto l64
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l64
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l64
+					}
+					position++
+				}
+			l69:
+				if !_rules[ruleMustWhiteSpacing]() {
+					goto l64
+				}
+				if !_rules[ruleIncludeSource]() {
+					goto l64
+				}
+				{
+					position71, tokenIndex71 := position, tokenIndex
+					if !_rules[ruleMustWhiteSpacing]() {
+						goto l72
+					}
+					if !_rules[ruleParams]() {
+						goto l72
+					}
+					goto l71
+				l72:
+					position, tokenIndex = position71, tokenIndex71
+				}
+			l71:
+				{
+					add(ruleAction18, position)
+				}
+				add(ruleInclude, position65)
+			}
+			return true
+		l64:
+			position, tokenIndex = position64, tokenIndex64
+			return false
+		},
+		/* 11 IncludeSource <- '"' <QuotedContent> '"' { p.addIncludeSource(text) } / <(!Whitespace !EndOfLine .)+> { p.addIncludeSource(text) } */
+		func() bool {
+			position73, tokenIndex73 := position, tokenIndex
+			{
+				position74 := position
+				{
+					position75, tokenIndex75 := position, tokenIndex
+					if buffer[position] != rune('"') {
+						goto l76
+					}
+					position++
+					{
+						position77 := position
+						if !_rules[ruleQuotedContent]() {
+							goto l76
+						}
+						add(rulePegText, position77)
+					}
+					if buffer[position] != rune('"') {
+						goto l76
+					}
+					position++
+					{
+						add(ruleAction19, position)
+					}
+					goto l75
+				l76:
+					position, tokenIndex = position75, tokenIndex75
+					{
+						position78 := position
+						{
+							position79, tokenIndex79 := position, tokenIndex
+							if !_rules[ruleWhitespace]() {
+								goto l79
+							}
+							goto l73
+						l79:
+							position, tokenIndex = position79, tokenIndex79
+						}
+						{
+							position80, tokenIndex80 := position, tokenIndex
+							if !_rules[ruleEndOfLine]() {
+								goto l80
+							}
+							goto l73
+						l80:
+							position, tokenIndex = position80, tokenIndex80
+						}
+						if !matchDot() {
+							goto l73
+						}
+					l81:
+						{
+							position82, tokenIndex82 := position, tokenIndex
+							{
+								position83, tokenIndex83 := position, tokenIndex
+								if !_rules[ruleWhitespace]() {
+									goto l83
+								}
+								goto l82
+							l83:
+								position, tokenIndex = position83, tokenIndex83
+							}
+							{
+								position84, tokenIndex84 := position, tokenIndex
+								if !_rules[ruleEndOfLine]() {
+									goto l84
+								}
+								goto l82
+							l84:
+								position, tokenIndex = position84, tokenIndex84
+							}
+							if !matchDot() {
+								goto l82
+							}
+							goto l81
+						l82:
+							position, tokenIndex = position82, tokenIndex82
+						}
+						add(rulePegText, position78)
+					}
+					{
+						add(ruleAction20, position)
+					}
+				}
+			l75:
+				add(ruleIncludeSource, position74)
+			}
+			return true
+		l73:
+			position, tokenIndex = position73, tokenIndex73
+			return false
+		},
+		/* 12 BlockBody <- (('"' QuotedContent '"') / ('{' BlockBody '}') / (!'}' .))* */
+		func() bool {
+			{
+				position85 := position
+			l86:
+				{
+					position87, tokenIndex87 := position, tokenIndex
+					{
+						position88, tokenIndex88 := position, tokenIndex
+						if buffer[position] != rune('"') {
+							goto l89
+						}
+						position++
+						if !_rules[ruleQuotedContent]() {
+							goto l89
+						}
+						if buffer[position] != rune('"') {
+							goto l89
+						}
+						position++
+						goto l88
+					l89:
+						position, tokenIndex = position88, tokenIndex88
+						if buffer[position] != rune('{') {
+							goto l90
+						}
+						position++
+						if !_rules[ruleBlockBody]() {
+							goto l90
+						}
+						if buffer[position] != rune('}') {
+							goto l90
+						}
+						position++
+						goto l88
+					l90:
+						position, tokenIndex = position88, tokenIndex88
+						{
+							position91, tokenIndex91 := position, tokenIndex
+							if buffer[position] != rune('}') {
+								goto l91
+							}
+							position++
+							goto l87
+						l91:
+							position, tokenIndex = position91, tokenIndex91
+						}
+						if !matchDot() {
+							goto l87
+						}
+					}
+				l88:
+					goto l86
+				l87:
+					position, tokenIndex = position87, tokenIndex87
+				}
+				add(ruleBlockBody, position85)
+			}
+			return true
+		},
+		/* 13 Params <- Param+ */
+		func() bool {
+			position92, tokenIndex92 := position, tokenIndex
+			{
+				position93 := position
+				if !_rules[ruleParam]() {
+					goto l92
+				}
+			l94:
+				{
+					position95, tokenIndex95 := position, tokenIndex
+					if !_rules[ruleParam]() {
+						goto l95
+					}
+					goto l94
+				l95:
+					position, tokenIndex = position95, tokenIndex95
+				}
+				add(ruleParams, position93)
+			}
+			return true
+		l92:
+			position, tokenIndex = position92, tokenIndex92
+			return false
+		},
+		/* 14 Param <- <Identifier> { p.addParamKey(text) } Equal Value WhiteSpacing */
+		func() bool {
+			position96, tokenIndex96 := position, tokenIndex
+			{
+				position97 := position
+				{
+					position98 := position
+					if !_rules[ruleIdentifier]() {
+						goto l96
+					}
+					add(rulePegText, position98)
+				}
+				{
+					add(ruleAction21, position)
+				}
+				if !_rules[ruleEqual]() {
+					goto l96
+				}
+				if !_rules[ruleValue]() {
+					goto l96
+				}
+				if !_rules[ruleWhiteSpacing]() {
+					goto l96
+				}
+				add(ruleParam, position97)
+			}
+			return true
+		l96:
+			position, tokenIndex = position96, tokenIndex96
+			return false
+		},
+		/* 15 Identifier <- [a-zA-Z0-9-_.]+ */
+		func() bool {
+			position99, tokenIndex99 := position, tokenIndex
+			{
+				position100 := position
+				if c := buffer[position]; !(c >= rune('a') && c <= rune('z') || c >= rune('A') && c <= rune('Z') || c >= rune('0') && c <= rune('9') || c == rune('-') || c == rune('_') || c == rune('.')) {
+					goto l99
+				}
+				position++
+			l101:
+				{
+					position102, tokenIndex102 := position, tokenIndex
+					if c := buffer[position]; !(c >= rune('a') && c <= rune('z') || c >= rune('A') && c <= rune('Z') || c >= rune('0') && c <= rune('9') || c == rune('-') || c == rune('_') || c == rune('.')) {
+						goto l102
+					}
+					position++
+					goto l101
+				l102:
+					position, tokenIndex = position102, tokenIndex102
+				}
+				add(ruleIdentifier, position100)
+			}
+			return true
+		l99:
+			position, tokenIndex = position99, tokenIndex99
+			return false
+		},
+		/* 16 Value <- QuotedValue { p.addParamQuotedValue(text) } / HoleValue / AliasValue { p.addParamValue(text) } / RefValue { p.addParamRefValue(text) } / <CidrValue> { p.addParamCidrValue(text) } / <IpValue> { p.addParamIpValue(text) } / <CSVValue> {p.addCsvValue(text)} / <IntRangeValue> { p.addParamValue(text) } / <IntValue> { p.addParamIntValue(text) } / <StringValue> { p.addParamValue(text) } */
+		func() bool {
+			position103, tokenIndex103 := position, tokenIndex
+			{
+				position104 := position
+				{
+					position105, tokenIndex105 := position, tokenIndex
+					if !_rules[ruleQuotedValue]() {
+						goto l106
+					}
+					{
+						add(ruleAction22, position)
+					}
+					goto l105
+				l106:
+					position, tokenIndex = position105, tokenIndex105
+					if !_rules[ruleHoleValue]() {
+						goto l107
+					}
+					goto l105
+				l107:
+					position, tokenIndex = position105, tokenIndex105
+					if !_rules[ruleAliasValue]() {
+						goto l108
+					}
+					{
+						add(ruleAction23, position)
+					}
+					goto l105
+				l108:
+					position, tokenIndex = position105, tokenIndex105
+					if !_rules[ruleRefValue]() {
+						goto l109
+					}
+					{
+						add(ruleAction24, position)
+					}
+					goto l105
+				l109:
+					position, tokenIndex = position105, tokenIndex105
+					{
+						position111 := position
+						if !_rules[ruleCidrValue]() {
+							goto l110
+						}
+						add(rulePegText, position111)
+					}
+					{
+						add(ruleAction25, position)
+					}
+					goto l105
+				l110:
+					position, tokenIndex = position105, tokenIndex105
+					{
+						position113 := position
+						if !_rules[ruleIpValue]() {
+							goto l112
+						}
+						add(rulePegText, position113)
+					}
+					{
+						add(ruleAction26, position)
+					}
+					goto l105
+				l112:
+					position, tokenIndex = position105, tokenIndex105
+					{
+						position115 := position
+						if !_rules[ruleCSVValue]() {
+							goto l114
+						}
+						add(rulePegText, position115)
+					}
+					{
+						add(ruleAction27, position)
+					}
+					goto l105
+				l114:
+					position, tokenIndex = position105, tokenIndex105
+					{
+						position117 := position
+						if !_rules[ruleIntRangeValue]() {
+							goto l116
+						}
+						add(rulePegText, position117)
+					}
+					{
+						add(ruleAction28, position)
+					}
+					goto l105
+				l116:
+					position, tokenIndex = position105, tokenIndex105
+					{
+						position119 := position
+						if !_rules[ruleIntValue]() {
+							goto l118
+						}
+						add(rulePegText, position119)
+					}
+					{
+						add(ruleAction29, position)
+					}
+					goto l105
+				l118:
+					position, tokenIndex = position105, tokenIndex105
+					{
+						position120 := position
+						if !_rules[ruleStringValue]() {
+							goto l103
+						}
+						add(rulePegText, position120)
+					}
+					{
+						add(ruleAction30, position)
+					}
+				}
+			l105:
+				add(ruleValue, position104)
+			}
+			return true
+		l103:
+			position, tokenIndex = position103, tokenIndex103
+			return false
+		},
+		/* 17 StringValue <- [a-zA-Z0-9-._:/]+ */
+		func() bool {
+			position121, tokenIndex121 := position, tokenIndex
+			{
+				position122 := position
+				if c := buffer[position]; !(c >= rune('a') && c <= rune('z') || c >= rune('A') && c <= rune('Z') || c >= rune('0') && c <= rune('9') || c == rune('-') || c == rune('.') || c == rune('_') || c == rune(':') || c == rune('/')) {
+					goto l121
+				}
+				position++
+			l123:
+				{
+					position124, tokenIndex124 := position, tokenIndex
+					if c := buffer[position]; !(c >= rune('a') && c <= rune('z') || c >= rune('A') && c <= rune('Z') || c >= rune('0') && c <= rune('9') || c == rune('-') || c == rune('.') || c == rune('_') || c == rune(':') || c == rune('/')) {
+						goto l124
+					}
+					position++
+					goto l123
+				l124:
+					position, tokenIndex = position124, tokenIndex124
+				}
+				add(ruleStringValue, position122)
+			}
+			return true
+		l121:
+			position, tokenIndex = position121, tokenIndex121
+			return false
+		},
+		/* 18 QuotedValue <- '"' <QuotedContent> '"' */
+		func() bool {
+			position125, tokenIndex125 := position, tokenIndex
+			{
+				position126 := position
+				if buffer[position] != rune('"') {
+					goto l125
+				}
+				position++
+				{
+					position127 := position
+					if !_rules[ruleQuotedContent]() {
+						goto l125
+					}
+					add(rulePegText, position127)
+				}
+				if buffer[position] != rune('"') {
+					goto l125
+				}
+				position++
+				add(ruleQuotedValue, position126)
+			}
+			return true
+		l125:
+			position, tokenIndex = position125, tokenIndex125
+			return false
+		},
+		/* 19 QuotedContent <- (('\\' .) / (!'"' .))* */
+		func() bool {
+			{
+				position128 := position
+			l129:
+				{
+					position130, tokenIndex130 := position, tokenIndex
+					{
+						position131, tokenIndex131 := position, tokenIndex
+						if buffer[position] != rune('\\') {
+							goto l132
+						}
+						position++
+						if !matchDot() {
+							goto l132
+						}
+						goto l131
+					l132:
+						position, tokenIndex = position131, tokenIndex131
+						{
+							position133, tokenIndex133 := position, tokenIndex
+							if buffer[position] != rune('"') {
+								goto l133
+							}
+							position++
+							goto l130
+						l133:
+							position, tokenIndex = position133, tokenIndex133
+						}
+						if !matchDot() {
+							goto l130
+						}
+					}
+				l131:
+					goto l129
+				l130:
+					position, tokenIndex = position130, tokenIndex130
+				}
+				add(ruleQuotedContent, position128)
+			}
+			return true
+		},
+		/* 20 CSVValue <- (StringValue WhiteSpacing ',' WhiteSpacing)+ StringValue */
+		func() bool {
+			position134, tokenIndex134 := position, tokenIndex
+			{
+				position135 := position
+				if !_rules[ruleStringValue]() {
+					goto l134
+				}
+				if !_rules[ruleWhiteSpacing]() {
+					goto l134
+				}
+				if buffer[position] != rune(',') {
+					goto l134
+				}
+				position++
+				if !_rules[ruleWhiteSpacing]() {
+					goto l134
+				}
+			l136:
+				{
+					position137, tokenIndex137 := position, tokenIndex
+					if !_rules[ruleStringValue]() {
+						goto l137
+					}
+					if !_rules[ruleWhiteSpacing]() {
+						goto l137
+					}
+					if buffer[position] != rune(',') {
+						goto l137
+					}
+					position++
+					if !_rules[ruleWhiteSpacing]() {
+						goto l137
+					}
+					goto l136
+				l137:
+					position, tokenIndex = position137, tokenIndex137
+				}
+				if !_rules[ruleStringValue]() {
+					goto l134
+				}
+				add(ruleCSVValue, position135)
+			}
+			return true
+		l134:
+			position, tokenIndex = position134, tokenIndex134
+			return false
+		},
+		/* 21 CidrValue <- [0-9]+.[0-9]+.[0-9]+.[0-9]+'/'[0-9]+ */
+		func() bool {
+			position138, tokenIndex138 := position, tokenIndex
+			{
+				position139 := position
+				if c := buffer[position]; !(c >= rune('0') && c <= rune('9')) {
+					goto l138
+				}
+				position++
+			l140:
+				{
+					position141, tokenIndex141 := position, tokenIndex
+					if c := buffer[position]; !(c >= rune('0') && c <= rune('9')) {
+						goto l141
+					}
+					position++
+					goto l140
+				l141:
+					position, tokenIndex = position141, tokenIndex141
+				}
+				if !matchDot() {
+					goto l138
+				}
+				if c := buffer[position]; !(c >= rune('0') && c <= rune('9')) {
+					goto l138
+				}
+				position++
+			l142:
+				{
+					position143, tokenIndex143 := position, tokenIndex
+					if c := buffer[position]; !(c >= rune('0') && c <= rune('9')) {
+						goto l143
+					}
+					position++
+					goto l142
+				l143:
+					position, tokenIndex = position143, tokenIndex143
+				}
+				if !matchDot() {
+					goto l138
+				}
+				if c := buffer[position]; !(c >= rune('0') && c <= rune('9')) {
+					goto l138
+				}
+				position++
+			l144:
+				{
+					position145, tokenIndex145 := position, tokenIndex
+					if c := buffer[position]; !(c >= rune('0') && c <= rune('9')) {
+						goto l145
+					}
+					position++
+					goto l144
+				l145:
+					position, tokenIndex = position145, tokenIndex145
+				}
+				if !matchDot() {
+					goto l138
+				}
+				if c := buffer[position]; !(c >= rune('0') && c <= rune('9')) {
+					goto l138
+				}
+				position++
+			l146:
+				{
+					position147, tokenIndex147 := position, tokenIndex
+					if c := buffer[position]; !(c >= rune('0') && c <= rune('9')) {
+						goto l147
+					}
+					position++
+					goto l146
+				l147:
+					position, tokenIndex = position147, tokenIndex147
+				}
+				if buffer[position] != rune('/') {
+					goto l138
+				}
+				position++
+				if c := buffer[position]; !(c >= rune('0') && c <= rune('9')) {
+					goto l138
+				}
+				position++
+			l148:
+				{
+					position149, tokenIndex149 := position, tokenIndex
+					if c := buffer[position]; !(c >= rune('0') && c <= rune('9')) {
+						goto l149
+					}
+					position++
+					goto l148
+				l149:
+					position, tokenIndex = position149, tokenIndex149
+				}
+				add(ruleCidrValue, position139)
+			}
+			return true
+		l138:
+			position, tokenIndex = position138, tokenIndex138
+			return false
+		},
+		/* 22 IpValue <- [0-9]+.[0-9]+.[0-9]+.[0-9]+ */
+		func() bool {
+			position150, tokenIndex150 := position, tokenIndex
+			{
+				position151 := position
+				if c := buffer[position]; !(c >= rune('0') && c <= rune('9')) {
+					goto l150
+				}
+				position++
+			l152:
+				{
+					position153, tokenIndex153 := position, tokenIndex
+					if c := buffer[position]; !(c >= rune('0') && c <= rune('9')) {
+						goto l153
+					}
+					position++
+					goto l152
+				l153:
+					position, tokenIndex = position153, tokenIndex153
+				}
+				if !matchDot() {
+					goto l150
+				}
+				if c := buffer[position]; !(c >= rune('0') && c <= rune('9')) {
+					goto l150
+				}
+				position++
+			l154:
+				{
+					position155, tokenIndex155 := position, tokenIndex
+					if c := buffer[position]; !(c >= rune('0') && c <= rune('9')) {
+						goto l155
+					}
+					position++
+					goto l154
+				l155:
+					position, tokenIndex = position155, tokenIndex155
+				}
+				if !matchDot() {
+					goto l150
+				}
+				if c := buffer[position]; !(c >= rune('0') && c <= rune('9')) {
+					goto l150
+				}
+				position++
+			l156:
+				{
+					position157, tokenIndex157 := position, tokenIndex
+					if c := buffer[position]; !(c >= rune('0') && c <= rune('9')) {
+						goto l157
+					}
+					position++
+					goto l156
+				l157:
+					position, tokenIndex = position157, tokenIndex157
+				}
+				if !matchDot() {
+					goto l150
+				}
+				if c := buffer[position]; !(c >= rune('0') && c <= rune('9')) {
+					goto l150
+				}
+				position++
+			l158:
+				{
+					position159, tokenIndex159 := position, tokenIndex
+					if c := buffer[position]; !(c >= rune('0') && c <= rune('9')) {
+						goto l159
+					}
+					position++
+					goto l158
+				l159:
+					position, tokenIndex = position159, tokenIndex159
+				}
+				add(ruleIpValue, position151)
+			}
+			return true
+		l150:
+			position, tokenIndex = position150, tokenIndex150
+			return false
+		},
+		/* 23 IntValue <- [0-9]+ */
+		func() bool {
+			position160, tokenIndex160 := position, tokenIndex
+			{
+				position161 := position
+				if c := buffer[position]; !(c >= rune('0') && c <= rune('9')) {
+					goto l160
+				}
+				position++
+			l162:
+				{
+					position163, tokenIndex163 := position, tokenIndex
+					if c := buffer[position]; !(c >= rune('0') && c <= rune('9')) {
+						goto l163
+					}
+					position++
+					goto l162
+				l163:
+					position, tokenIndex = position163, tokenIndex163
+				}
+				add(ruleIntValue, position161)
+			}
+			return true
+		l160:
+			position, tokenIndex = position160, tokenIndex160
+			return false
+		},
+		/* 24 IntRangeValue <- [0-9]+'-'[0-9]+ */
+		func() bool {
+			position164, tokenIndex164 := position, tokenIndex
+			{
+				position165 := position
+				if c := buffer[position]; !(c >= rune('0') && c <= rune('9')) {
+					goto l164
+				}
+				position++
+			l166:
+				{
+					position167, tokenIndex167 := position, tokenIndex
+					if c := buffer[position]; !(c >= rune('0') && c <= rune('9')) {
+						goto l167
+					}
+					position++
+					goto l166
+				l167:
+					position, tokenIndex = position167, tokenIndex167
+				}
+				if buffer[position] != rune('-') {
+					goto l164
+				}
+				position++
+				if c := buffer[position]; !(c >= rune('0') && c <= rune('9')) {
+					goto l164
+				}
+				position++
+			l168:
+				{
+					position169, tokenIndex169 := position, tokenIndex
+					if c := buffer[position]; !(c >= rune('0') && c <= rune('9')) {
+						goto l169
+					}
+					position++
+					goto l168
+				l169:
+					position, tokenIndex = position169, tokenIndex169
+				}
+				add(ruleIntRangeValue, position165)
+			}
+			return true
+		l164:
+			position, tokenIndex = position164, tokenIndex164
+			return false
+		},
+		/* 25 RefValue <- '$'<Identifier> */
+		func() bool {
+			position170, tokenIndex170 := position, tokenIndex
+			{
+				position171 := position
+				if buffer[position] != rune('$') {
+					goto l170
+				}
+				position++
+				{
+					position172 := position
+					if !_rules[ruleIdentifier]() {
+						goto l170
+					}
+					add(rulePegText, position172)
+				}
+				add(ruleRefValue, position171)
+			}
+			return true
+		l170:
+			position, tokenIndex = position170, tokenIndex170
+			return false
+		},
+		/* 26 AliasValue <- <'@'StringValue> */
+		func() bool {
+			position173, tokenIndex173 := position, tokenIndex
+			{
+				position174 := position
+				{
+					position175 := position
+					if buffer[position] != rune('@') {
+						goto l173
+					}
+					position++
+					if !_rules[ruleStringValue]() {
+						goto l173
+					}
+					add(rulePegText, position175)
+				}
+				add(ruleAliasValue, position174)
+			}
+			return true
+		l173:
+			position, tokenIndex = position173, tokenIndex173
+			return false
+		},
+		/* 27 HoleValue <- '{' WhiteSpacing <Identifier> { p.addParamHoleValue(text) } WhiteSpacing HoleSpec WhiteSpacing '}' */
+		func() bool {
+			position176, tokenIndex176 := position, tokenIndex
+			{
+				position177 := position
+				if buffer[position] != rune('{') {
+					goto l176
+				}
+				position++
+				if !_rules[ruleWhiteSpacing]() {
+					goto l176
+				}
+				{
+					position178 := position
+					if !_rules[ruleIdentifier]() {
+						goto l176
+					}
+					add(rulePegText, position178)
+				}
+				{
+					add(ruleAction31, position)
+				}
+				if !_rules[ruleWhiteSpacing]() {
+					goto l176
+				}
+				if !_rules[ruleHoleSpec]() {
+					goto l176
+				}
+				if !_rules[ruleWhiteSpacing]() {
+					goto l176
+				}
+				if buffer[position] != rune('}') {
+					goto l176
+				}
+				position++
+				add(ruleHoleValue, position177)
+			}
+			return true
+		l176:
+			position, tokenIndex = position176, tokenIndex176
+			return false
+		},
+		/* 28 HoleSpec <- (':' WhiteSpacing <HoleType> { p.addHoleType(text) } WhiteSpacing)? ('=' WhiteSpacing <HoleDefault> { p.addHoleDefault(text) } WhiteSpacing)? ('"' <QuotedContent> '"' { p.addHoleDescription(text) })? */
+		func() bool {
+			{
+				position179 := position
+				{
+					position180, tokenIndex180 := position, tokenIndex
+					if buffer[position] != rune(':') {
+						goto l181
+					}
+					position++
+					if !_rules[ruleWhiteSpacing]() {
+						goto l181
+					}
 					{
-						switch buffer[position] {
-						case '/':
-							if buffer[position] != rune('/') {
-								goto l212
-							}
-							position++
-							break
-						case ':':
-							if buffer[position] != rune(':') {
-								goto l212
-							}
-							position++
-							break
-						case '_':
-							if buffer[position] != rune('_') {
-								goto l212
-							}
-							position++
-							break
-						case '.':
-							if buffer[position] != rune('.') {
-								goto l212
+						position182 := position
+						if !_rules[ruleHoleType]() {
+							goto l181
+						}
+						add(rulePegText, position182)
+					}
+					{
+						add(ruleAction32, position)
+					}
+					if !_rules[ruleWhiteSpacing]() {
+						goto l181
+					}
+					goto l180
+				l181:
+					position, tokenIndex = position180, tokenIndex180
+				}
+			l180:
+				{
+					position183, tokenIndex183 := position, tokenIndex
+					if buffer[position] != rune('=') {
+						goto l184
+					}
+					position++
+					if !_rules[ruleWhiteSpacing]() {
+						goto l184
+					}
+					{
+						position185 := position
+						if !_rules[ruleHoleDefault]() {
+							goto l184
+						}
+						add(rulePegText, position185)
+					}
+					{
+						add(ruleAction33, position)
+					}
+					if !_rules[ruleWhiteSpacing]() {
+						goto l184
+					}
+					goto l183
+				l184:
+					position, tokenIndex = position183, tokenIndex183
+				}
+			l183:
+				{
+					position186, tokenIndex186 := position, tokenIndex
+					if buffer[position] != rune('"') {
+						goto l187
+					}
+					position++
+					{
+						position188 := position
+						if !_rules[ruleQuotedContent]() {
+							goto l187
+						}
+						add(rulePegText, position188)
+					}
+					if buffer[position] != rune('"') {
+						goto l187
+					}
+					position++
+					{
+						add(ruleAction34, position)
+					}
+					goto l186
+				l187:
+					position, tokenIndex = position186, tokenIndex186
+				}
+			l186:
+				add(ruleHoleSpec, position179)
+			}
+			return true
+		},
+		/* 29 HoleType <- 'id(' Entity ')' / [a-z]+ */
+		func() bool {
+			position189, tokenIndex189 := position, tokenIndex
+			{
+				position190 := position
+				{
+					position191, tokenIndex191 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l192
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l192
+					}
+					position++
+					if buffer[position] != rune('(') {
+						goto l192
+					}
+					position++
+					if !_rules[ruleEntity]() {
+						goto l192
+					}
+					if buffer[position] != rune(')') {
+						goto l192
+					}
+					position++
+					goto l191
+				l192:
+					position, tokenIndex = position191, tokenIndex191
+					if c := buffer[position]; !(c >= rune('a') && c <= rune('z')) {
+						goto l189
+					}
+					position++
+				l193:
+					{
+						position194, tokenIndex194 := position, tokenIndex
+						if c := buffer[position]; !(c >= rune('a') && c <= rune('z')) {
+							goto l194
+						}
+						position++
+						goto l193
+					l194:
+						position, tokenIndex = position194, tokenIndex194
+					}
+				}
+			l191:
+				add(ruleHoleType, position190)
+			}
+			return true
+		l189:
+			position, tokenIndex = position189, tokenIndex189
+			return false
+		},
+		/* 30 HoleDefault <- ('"' QuotedContent '"') / (!Whitespace !'}' !'"' .)+ */
+		func() bool {
+			position195, tokenIndex195 := position, tokenIndex
+			{
+				position196 := position
+				{
+					position197, tokenIndex197 := position, tokenIndex
+					if buffer[position] != rune('"') {
+						goto l198
+					}
+					position++
+					if !_rules[ruleQuotedContent]() {
+						goto l198
+					}
+					if buffer[position] != rune('"') {
+						goto l198
+					}
+					position++
+					goto l197
+				l198:
+					position, tokenIndex = position197, tokenIndex197
+					{
+						position199, tokenIndex199 := position, tokenIndex
+						if !_rules[ruleWhitespace]() {
+							goto l199
+						}
+						goto l195
+					l199:
+						position, tokenIndex = position199, tokenIndex199
+					}
+					{
+						position200, tokenIndex200 := position, tokenIndex
+						if buffer[position] != rune('}') {
+							goto l200
+						}
+						position++
+						goto l195
+					l200:
+						position, tokenIndex = position200, tokenIndex200
+					}
+					{
+						position201, tokenIndex201 := position, tokenIndex
+						if buffer[position] != rune('"') {
+							goto l201
+						}
+						position++
+						goto l195
+					l201:
+						position, tokenIndex = position201, tokenIndex201
+					}
+					if !matchDot() {
+						goto l195
+					}
+				l202:
+					{
+						position203, tokenIndex203 := position, tokenIndex
+						{
+							position204, tokenIndex204 := position, tokenIndex
+							if !_rules[ruleWhitespace]() {
+								goto l204
 							}
-							position++
-							break
-						case '-':
-							if buffer[position] != rune('-') {
-								goto l212
+							goto l203
+						l204:
+							position, tokenIndex = position204, tokenIndex204
+						}
+						{
+							position205, tokenIndex205 := position, tokenIndex
+							if buffer[position] != rune('}') {
+								goto l205
 							}
 							position++
-							break
-						case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
-							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l212
+							goto l203
+						l205:
+							position, tokenIndex = position205, tokenIndex205
+						}
+						{
+							position206, tokenIndex206 := position, tokenIndex
+							if buffer[position] != rune('"') {
+								goto l206
 							}
 							position++
-							break
-						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
-							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l212
+							goto l203
+						l206:
+							position, tokenIndex = position206, tokenIndex206
+						}
+						if !matchDot() {
+							goto l203
+						}
+						goto l202
+					l203:
+						position, tokenIndex = position203, tokenIndex203
+					}
+				}
+			l197:
+				add(ruleHoleDefault, position196)
+			}
+			return true
+		l195:
+			position, tokenIndex = position195, tokenIndex195
+			return false
+		},
+		/* 31 Comment <- '#'(!EndOfLine .)* / '//'(!EndOfLine .)* { p.LineDone() } */
+		func() bool {
+			position207, tokenIndex207 := position, tokenIndex
+			{
+				position208 := position
+				{
+					position209, tokenIndex209 := position, tokenIndex
+					if buffer[position] != rune('#') {
+						goto l210
+					}
+					position++
+				l211:
+					{
+						position212, tokenIndex212 := position, tokenIndex
+						{
+							position213, tokenIndex213 := position, tokenIndex
+							if !_rules[ruleEndOfLine]() {
+								goto l213
 							}
-							position++
-							break
-						default:
-							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l212
+							goto l212
+						l213:
+							position, tokenIndex = position213, tokenIndex213
+						}
+						if !matchDot() {
+							goto l212
+						}
+						goto l211
+					l212:
+						position, tokenIndex = position212, tokenIndex212
+					}
+					goto l209
+				l210:
+					position, tokenIndex = position209, tokenIndex209
+					if buffer[position] != rune('/') {
+						goto l207
+					}
+					position++
+					if buffer[position] != rune('/') {
+						goto l207
+					}
+					position++
+				l214:
+					{
+						position215, tokenIndex215 := position, tokenIndex
+						{
+							position216, tokenIndex216 := position, tokenIndex
+							if !_rules[ruleEndOfLine]() {
+								goto l216
 							}
-							position++
-							break
+							goto l215
+						l216:
+							position, tokenIndex = position216, tokenIndex216
+						}
+						if !matchDot() {
+							goto l215
 						}
+						goto l214
+					l215:
+						position, tokenIndex = position215, tokenIndex215
+					}
+					{
+						add(ruleAction35, position)
 					}
-
-					goto l211
-				l212:
-					position, tokenIndex = position212, tokenIndex212
 				}
-				add(ruleStringValue, position210)
+			l209:
+				add(ruleComment, position208)
 			}
 			return true
-		l209:
-			position, tokenIndex = position209, tokenIndex209
+		l207:
+			position, tokenIndex = position207, tokenIndex207
 			return false
 		},
-		/* 11 CSVValue <- <((StringValue WhiteSpacing ',' WhiteSpacing)+ StringValue)> */
-		nil,
-		/* 12 CidrValue <- <([0-9]+ . [0-9]+ . [0-9]+ . [0-9]+ '/' [0-9]+)> */
-		nil,
-		/* 13 IpValue <- <([0-9]+ . [0-9]+ . [0-9]+ . [0-9]+)> */
-		nil,
-		/* 14 IntValue <- <[0-9]+> */
-		nil,
-		/* 15 IntRangeValue <- <([0-9]+ '-' [0-9]+)> */
-		nil,
-		/* 16 RefValue <- <('$' <Identifier>)> */
-		nil,
-		/* 17 AliasValue <- <<('@' StringValue)>> */
-		nil,
-		/* 18 HoleValue <- <('{' WhiteSpacing <Identifier> WhiteSpacing '}')> */
-		nil,
-		/* 19 Comment <- <(('#' (!EndOfLine .)*) / ('/' '/' (!EndOfLine .)* Action14))> */
-		nil,
-		/* 20 WhiteSpacing <- <Whitespace*> */
+		/* 32 WhiteSpacing <- Whitespace* */
 		func() bool {
 			{
-				position225 := position
-			l226:
+				position217 := position
+			l218:
 				{
-					position227, tokenIndex227 := position, tokenIndex
+					position219, tokenIndex219 := position, tokenIndex
 					if !_rules[ruleWhitespace]() {
-						goto l227
+						goto l219
 					}
-					goto l226
-				l227:
-					position, tokenIndex = position227, tokenIndex227
+					goto l218
+				l219:
+					position, tokenIndex = position219, tokenIndex219
 				}
-				add(ruleWhiteSpacing, position225)
+				add(ruleWhiteSpacing, position217)
 			}
 			return true
 		},
-		/* 21 MustWhiteSpacing <- <Whitespace+> */
+		/* 33 MustWhiteSpacing <- Whitespace+ */
 		func() bool {
-			position228, tokenIndex228 := position, tokenIndex
+			position220, tokenIndex220 := position, tokenIndex
 			{
-				position229 := position
+				position221 := position
 				if !_rules[ruleWhitespace]() {
-					goto l228
+					goto l220
 				}
-			l230:
+			l222:
 				{
-					position231, tokenIndex231 := position, tokenIndex
+					position223, tokenIndex223 := position, tokenIndex
 					if !_rules[ruleWhitespace]() {
-						goto l231
+						goto l223
 					}
-					goto l230
-				l231:
-					position, tokenIndex = position231, tokenIndex231
+					goto l222
+				l223:
+					position, tokenIndex = position223, tokenIndex223
 				}
-				add(ruleMustWhiteSpacing, position229)
+				add(ruleMustWhiteSpacing, position221)
 			}
 			return true
-		l228:
-			position, tokenIndex = position228, tokenIndex228
+		l220:
+			position, tokenIndex = position220, tokenIndex220
 			return false
 		},
-		/* 22 Equal <- <(WhiteSpacing '=' WhiteSpacing)> */
+		/* 34 Equal <- WhiteSpacing '=' WhiteSpacing */
 		func() bool {
-			position232, tokenIndex232 := position, tokenIndex
+			position224, tokenIndex224 := position, tokenIndex
 			{
-				position233 := position
+				position225 := position
 				if !_rules[ruleWhiteSpacing]() {
-					goto l232
+					goto l224
 				}
 				if buffer[position] != rune('=') {
-					goto l232
+					goto l224
 				}
 				position++
 				if !_rules[ruleWhiteSpacing]() {
-					goto l232
+					goto l224
 				}
-				add(ruleEqual, position233)
+				add(ruleEqual, position225)
 			}
 			return true
-		l232:
-			position, tokenIndex = position232, tokenIndex232
+		l224:
+			position, tokenIndex = position224, tokenIndex224
 			return false
 		},
-		/* 23 BlankLine <- <(WhiteSpacing EndOfLine Action15)> */
+		/* 35 BlankLine <- WhiteSpacing EndOfLine { p.LineDone() } */
 		func() bool {
-			position234, tokenIndex234 := position, tokenIndex
+			position226, tokenIndex226 := position, tokenIndex
 			{
-				position235 := position
+				position227 := position
 				if !_rules[ruleWhiteSpacing]() {
-					goto l234
+					goto l226
 				}
 				if !_rules[ruleEndOfLine]() {
-					goto l234
+					goto l226
 				}
 				{
-					add(ruleAction15, position)
+					add(ruleAction36, position)
 				}
-				add(ruleBlankLine, position235)
+				add(ruleBlankLine, position227)
 			}
 			return true
-		l234:
-			position, tokenIndex = position234, tokenIndex234
+		l226:
+			position, tokenIndex = position226, tokenIndex226
 			return false
 		},
-		/* 24 Whitespace <- <(' ' / '\t')> */
+		/* 36 Whitespace <- ' ' / '\t' */
 		func() bool {
-			position237, tokenIndex237 := position, tokenIndex
+			position228, tokenIndex228 := position, tokenIndex
 			{
-				position238 := position
+				position229 := position
 				{
-					position239, tokenIndex239 := position, tokenIndex
+					position230, tokenIndex230 := position, tokenIndex
 					if buffer[position] != rune(' ') {
-						goto l240
+						goto l231
 					}
 					position++
-					goto l239
-				l240:
-					position, tokenIndex = position239, tokenIndex239
+					goto l230
+				l231:
+					position, tokenIndex = position230, tokenIndex230
 					if buffer[position] != rune('\t') {
-						goto l237
+						goto l228
 					}
 					position++
 				}
-			l239:
-				add(ruleWhitespace, position238)
+			l230:
+				add(ruleWhitespace, position229)
 			}
 			return true
-		l237:
-			position, tokenIndex = position237, tokenIndex237
+		l228:
+			position, tokenIndex = position228, tokenIndex228
 			return false
 		},
-		/* 25 EndOfLine <- <(('\r' '\n') / '\n' / '\r')> */
+		/* 37 EndOfLine <- '\r\n' / '\n' / '\r' */
 		func() bool {
-			position241, tokenIndex241 := position, tokenIndex
+			position232, tokenIndex232 := position, tokenIndex
 			{
-				position242 := position
+				position233 := position
 				{
-					position243, tokenIndex243 := position, tokenIndex
+					position234, tokenIndex234 := position, tokenIndex
 					if buffer[position] != rune('\r') {
-						goto l244
+						goto l235
 					}
 					position++
 					if buffer[position] != rune('\n') {
-						goto l244
+						goto l235
 					}
 					position++
-					goto l243
-				l244:
-					position, tokenIndex = position243, tokenIndex243
+					goto l234
+				l235:
+					position, tokenIndex = position234, tokenIndex234
 					if buffer[position] != rune('\n') {
-						goto l245
+						goto l236
 					}
 					position++
-					goto l243
-				l245:
-					position, tokenIndex = position243, tokenIndex243
+					goto l234
+				l236:
+					position, tokenIndex = position234, tokenIndex234
 					if buffer[position] != rune('\r') {
-						goto l241
+						goto l232
 					}
 					position++
 				}
-			l243:
-				add(ruleEndOfLine, position242)
+			l234:
+				add(ruleEndOfLine, position233)
+			}
+			return true
+		l232:
+			position, tokenIndex = position232, tokenIndex232
+			return false
+		},
+		/* 38 EndOfFile <- !. */
+		func() bool {
+			position237, tokenIndex237 := position, tokenIndex
+			{
+				position238 := position
+				{
+					position239, tokenIndex239 := position, tokenIndex
+					if !matchDot() {
+						goto l239
+					}
+					goto l237
+				l239:
+					position, tokenIndex = position239, tokenIndex239
+				}
+				add(ruleEndOfFile, position238)
 			}
 			return true
-		l241:
-			position, tokenIndex = position241, tokenIndex241
+		l237:
+			position, tokenIndex = position237, tokenIndex237
 			return false
 		},
-		/* 26 EndOfFile <- <!.> */
 		nil,
+		/* 40 Action0 <- <{ p.markStatementStart(int(token.begin)) }> */
+		nil,
+		/* 41 Action1 <- <{ p.markStatementEnd(int(token.begin)) }> */
+		nil,
+		/* 42 Action2 <- <{ p.addDeclarationIdentifier(text) }> */
+		nil,
+		/* 43 Action3 <- <{ p.addAction(text) }> */
+		nil,
+		/* 44 Action4 <- <{ p.addEntity(text) }> */
+		nil,
+		/* 45 Action5 <- <{ p.LineDone() }> */
+		nil,
+		/* 46 Action6 <- <{ p.addForLoopVariable(text) }> */
+		nil,
+		/* 47 Action7 <- <{ p.addForLoopValues(text) }> */
+		nil,
+		/* 48 Action8 <- <{ p.addForLoopBody(text) }> */
+		nil,
+		/* 49 Action9 <- <{ p.LineDone() }> */
+		nil,
+		/* 50 Action10 <- <{ p.addIfBlock(text) }> */
+		nil,
+		/* 51 Action11 <- <{ p.addIfEntity(text) }> */
+		nil,
+		/* 52 Action12 <- <{ p.addIfBody(text) }> */
+		nil,
+		/* 53 Action13 <- <{ p.LineDone() }> */
+		nil,
+		/* 54 Action14 <- <{ p.addIfConditionKey(text) }> */
+		nil,
+		/* 55 Action15 <- <{ p.addIfConditionValue(text) }> */
+		nil,
+		/* 56 Action16 <- <{ p.addInclude() }> */
+		nil,
+		/* 57 Action17 <- <{ p.addIncludeIdentifier(text) }> */
+		nil,
+		/* 58 Action18 <- <{ p.LineDone() }> */
+		nil,
+		/* 59 Action19 <- <{ p.addIncludeSource(text) }> */
+		nil,
+		/* 60 Action20 <- <{ p.addIncludeSource(text) }> */
 		nil,
-		/* 29 Action0 <- <{ p.addDeclarationIdentifier(text) }> */
+		/* 61 Action21 <- <{ p.addParamKey(text) }> */
 		nil,
-		/* 30 Action1 <- <{ p.addAction(text) }> */
+		/* 62 Action22 <- <{ p.addParamQuotedValue(text) }> */
 		nil,
-		/* 31 Action2 <- <{ p.addEntity(text) }> */
+		/* 63 Action23 <- <{ p.addParamValue(text) }> */
 		nil,
-		/* 32 Action3 <- <{ p.LineDone() }> */
+		/* 64 Action24 <- <{ p.addParamRefValue(text) }> */
 		nil,
-		/* 33 Action4 <- <{ p.addParamKey(text) }> */
+		/* 65 Action25 <- <{ p.addParamCidrValue(text) }> */
 		nil,
-		/* 34 Action5 <- <{  p.addParamHoleValue(text) }> */
+		/* 66 Action26 <- <{ p.addParamIpValue(text) }> */
 		nil,
-		/* 35 Action6 <- <{  p.addParamValue(text) }> */
+		/* 67 Action27 <- <{ p.addCsvValue(text) }> */
 		nil,
-		/* 36 Action7 <- <{  p.addParamRefValue(text) }> */
+		/* 68 Action28 <- <{ p.addParamValue(text) }> */
 		nil,
-		/* 37 Action8 <- <{ p.addParamCidrValue(text) }> */
+		/* 69 Action29 <- <{ p.addParamIntValue(text) }> */
 		nil,
-		/* 38 Action9 <- <{ p.addParamIpValue(text) }> */
+		/* 70 Action30 <- <{ p.addParamValue(text) }> */
 		nil,
-		/* 39 Action10 <- <{p.addCsvValue(text)}> */
+		/* 71 Action31 <- <{ p.addParamHoleValue(text) }> */
 		nil,
-		/* 40 Action11 <- <{ p.addParamValue(text) }> */
+		/* 72 Action32 <- <{ p.addHoleType(text) }> */
 		nil,
-		/* 41 Action12 <- <{ p.addParamIntValue(text) }> */
+		/* 73 Action33 <- <{ p.addHoleDefault(text) }> */
 		nil,
-		/* 42 Action13 <- <{ p.addParamValue(text) }> */
+		/* 74 Action34 <- <{ p.addHoleDescription(text) }> */
 		nil,
-		/* 43 Action14 <- <{ p.LineDone() }> */
+		/* 75 Action35 <- <{ p.LineDone() }> */
 		nil,
-		/* 44 Action15 <- <{ p.LineDone() }> */
+		/* 76 Action36 <- <{ p.LineDone() }> */
 		nil,
 	}
 	p.rules = _rules
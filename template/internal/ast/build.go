@@ -39,6 +39,106 @@ func (a *AST) addDeclarationIdentifier(text string) {
 	a.addStatement(&DeclarationNode{Ident: text})
 }
 
+func (a *AST) addForLoopVariable(text string) {
+	a.addStatement(&ForNode{Variable: text})
+}
+
+func (a *AST) addForLoopValues(text string) {
+	loop := a.currentForLoop()
+	if bounds := strings.Split(text, "-"); len(bounds) == 2 && isInt(bounds[0]) && isInt(bounds[1]) {
+		start, _ := strconv.Atoi(bounds[0])
+		end, _ := strconv.Atoi(bounds[1])
+		if start > end {
+			panic(fmt.Errorf("invalid for loop range '%s'", text))
+		}
+		for i := start; i <= end; i++ {
+			loop.Values = append(loop.Values, strconv.Itoa(i))
+		}
+		return
+	}
+	for _, val := range strings.Split(text, ",") {
+		loop.Values = append(loop.Values, strings.TrimSpace(val))
+	}
+}
+
+func (a *AST) addForLoopBody(text string) {
+	body := blockBody(text)
+	if body == "" {
+		panic(fmt.Errorf("empty for loop body on variable '%s'", a.currentForLoop().Variable))
+	}
+	a.currentForLoop().Body = body
+}
+
+func (a *AST) addIfBlock(text string) {
+	a.addStatement(&IfNode{Negate: text == "unless", Filters: make(map[string]string)})
+}
+
+func (a *AST) addIfEntity(text string) {
+	if IsInvalidEntity(text) {
+		panic(fmt.Errorf("unknown entity '%s'", text))
+	}
+	a.currentIf().Entity = text
+}
+
+func (a *AST) addIfConditionKey(text string) {
+	a.currentKey = text
+}
+
+func (a *AST) addIfConditionValue(text string) {
+	a.currentIf().Filters[a.currentKey] = text
+}
+
+func (a *AST) addIfBody(text string) {
+	body := blockBody(text)
+	if body == "" {
+		panic(fmt.Errorf("empty block body for '%s'", a.currentIf().Condition()))
+	}
+	a.currentIf().Body = body
+}
+
+func (a *AST) addInclude() {
+	a.addStatement(&IncludeNode{Args: &CommandNode{
+		Refs:   make(map[string]string),
+		Params: make(map[string]interface{}),
+		Holes:  make(map[string]string),
+	}})
+}
+
+func (a *AST) addIncludeIdentifier(text string) {
+	a.currentInclude().Ident = text
+}
+
+func (a *AST) addIncludeSource(text string) {
+	source, err := parseInterpolation(text)
+	if err != nil {
+		panic(err)
+	}
+	a.currentInclude().Source = source.Value()
+}
+
+func blockBody(text string) string {
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		if clean := strings.TrimSpace(line); clean != "" {
+			lines = append(lines, clean)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (a *AST) markStatementStart(pos int) {
+	a.statementStart = pos
+	a.statementsCount = len(a.Statements)
+}
+
+// markStatementEnd sets the offsets of the statement just parsed, if any (ex: not a comment)
+func (a *AST) markStatementEnd(pos int) {
+	if len(a.Statements) > a.statementsCount {
+		stat := a.Statements[len(a.Statements)-1]
+		stat.Pos, stat.End = a.statementStart, pos
+	}
+}
+
 func (a *AST) LineDone() {
 	a.currentStatement = nil
 	a.currentKey = ""
@@ -59,6 +159,13 @@ func (a *AST) addParamValue(text string) {
 	node.Params[a.currentKey] = text
 }
 
+func (a *AST) addParamQuotedValue(text string) {
+	node := a.currentCommand()
+	if err := node.AddQuotedParam(a.currentKey, text); err != nil {
+		panic(err)
+	}
+}
+
 func (a *AST) addCsvValue(text string) {
 	var csv []string
 	for _, val := range strings.Split(text, ",") {
@@ -72,7 +179,7 @@ func (a *AST) addParamIntValue(text string) {
 	node := a.currentCommand()
 	num, err := strconv.Atoi(text)
 	if err != nil {
-		panic(fmt.Sprintf("cannot convert '%s' to int", text))
+		panic(fmt.Errorf("cannot convert '%s' to int", text))
 	}
 	node.Params[a.currentKey] = num
 }
@@ -81,7 +188,7 @@ func (a *AST) addParamCidrValue(text string) {
 	node := a.currentCommand()
 	_, ipnet, err := net.ParseCIDR(text)
 	if err != nil {
-		panic(fmt.Sprintf("cannot convert '%s' to net cidr", text))
+		panic(fmt.Errorf("cannot convert '%s' to net cidr", text))
 	}
 	node.Params[a.currentKey] = ipnet.String()
 }
@@ -90,7 +197,7 @@ func (a *AST) addParamIpValue(text string) {
 	node := a.currentCommand()
 	ip := net.ParseIP(text)
 	if ip == nil {
-		panic(fmt.Sprintf("cannot convert '%s' to net ip", text))
+		panic(fmt.Errorf("cannot convert '%s' to net ip", text))
 	}
 	node.Params[a.currentKey] = ip.String()
 }
@@ -105,6 +212,103 @@ func (a *AST) addParamHoleValue(text string) {
 	node.Holes[a.currentKey] = text
 }
 
+func (a *AST) addHoleType(text string) {
+	if entity := strings.TrimSuffix(strings.TrimPrefix(text, "id("), ")"); entity != text {
+		if IsInvalidEntity(entity) {
+			panic(fmt.Errorf("unknown entity '%s' in hole type '%s'", entity, text))
+		}
+	} else if !contains(HoleTypes, text) {
+		panic(fmt.Errorf("unknown hole type '%s' (expecting %s or id(entity))", text, strings.Join(HoleTypes, ", ")))
+	}
+	a.updateHoleSpec(func(spec *HoleSpec) { spec.Type = text })
+}
+
+func (a *AST) addHoleDefault(text string) {
+	if strings.HasPrefix(text, `"`) {
+		interp, err := parseInterpolation(strings.TrimSuffix(strings.TrimPrefix(text, `"`), `"`))
+		if err != nil {
+			panic(err)
+		}
+		text = interp.Value()
+	}
+	a.updateHoleSpec(func(spec *HoleSpec) { spec.Default = text })
+}
+
+func (a *AST) addHoleDescription(text string) {
+	interp, err := parseInterpolation(text)
+	if err != nil {
+		panic(err)
+	}
+	a.updateHoleSpec(func(spec *HoleSpec) { spec.Description = interp.Value() })
+}
+
+func (a *AST) updateHoleSpec(update func(*HoleSpec)) {
+	node := a.currentCommand()
+	if node.HoleSpecs == nil {
+		node.HoleSpecs = make(map[string]HoleSpec)
+	}
+	hole := node.Holes[a.currentKey]
+	spec := node.HoleSpecs[hole]
+	update(&spec)
+	node.HoleSpecs[hole] = spec
+}
+
+func contains(arr []string, s string) bool {
+	for _, a := range arr {
+		if a == s {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *AST) currentForLoop() *ForNode {
+	st := a.currentStatement
+	if st == nil {
+		return nil
+	}
+
+	switch st.Node.(type) {
+	case *ForNode:
+		return st.Node.(*ForNode)
+	}
+
+	return nil
+}
+
+func (a *AST) currentIf() *IfNode {
+	st := a.currentStatement
+	if st == nil {
+		return nil
+	}
+
+	switch st.Node.(type) {
+	case *IfNode:
+		return st.Node.(*IfNode)
+	}
+
+	return nil
+}
+
+func (a *AST) currentInclude() *IncludeNode {
+	st := a.currentStatement
+	if st == nil {
+		return nil
+	}
+
+	switch st.Node.(type) {
+	case *IncludeNode:
+		return st.Node.(*IncludeNode)
+	}
+
+	return nil
+}
+
+func isInt(s string) bool {
+	_, err := strconv.Atoi(s)
+	return err == nil
+}
+
 func (a *AST) currentDeclaration() *DeclarationNode {
 	st := a.currentStatement
 	if st == nil {
@@ -135,6 +339,8 @@ func (a *AST) currentCommand() *CommandNode {
 			return expr.(*CommandNode)
 		}
 		return nil
+	case *IncludeNode:
+		return st.Node.(*IncludeNode).Args
 	default:
 		panic("last expression: unexpected node type")
 	}
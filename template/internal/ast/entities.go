@@ -6,21 +6,30 @@ const (
 	UnknownEntity Entity = "unknown"
 	NoneEntity    Entity = "none"
 
-	Vpc             Entity = "vpc"
-	Subnet          Entity = "subnet"
-	Instance        Entity = "instance"
-	Volume          Entity = "volume"
-	Tag             Entity = "tag"
-	Securitygroup   Entity = "securitygroup"
-	Keypair         Entity = "keypair"
-	Internetgateway Entity = "internetgateway"
-	Routetable      Entity = "routetable"
-	Route           Entity = "route"
-	Loadbalancer    Entity = "loadbalancer"
-	Listener        Entity = "listener"
-	Targetgroup     Entity = "targetgroup"
-	Database        Entity = "database"
-	Dbsubnetgroup   Entity = "dbsubnetgroup"
+	Vpc              Entity = "vpc"
+	Subnet           Entity = "subnet"
+	Instance         Entity = "instance"
+	Volume           Entity = "volume"
+	Snapshot         Entity = "snapshot"
+	Image            Entity = "image"
+	Tag              Entity = "tag"
+	Securitygroup    Entity = "securitygroup"
+	Keypair          Entity = "keypair"
+	Internetgateway  Entity = "internetgateway"
+	Routetable       Entity = "routetable"
+	Route            Entity = "route"
+	Elasticip        Entity = "elasticip"
+	Natgateway       Entity = "natgateway"
+	Networkinterface Entity = "networkinterface"
+	Loadbalancer     Entity = "loadbalancer"
+	Listener         Entity = "listener"
+	Targetgroup      Entity = "targetgroup"
+	Database         Entity = "database"
+	Dbsubnetgroup    Entity = "dbsubnetgroup"
+
+	Launchconfiguration Entity = "launchconfiguration"
+	Scalinggroup        Entity = "scalinggroup"
+	Scalingpolicy       Entity = "scalingpolicy"
 
 	Zone   Entity = "zone"
 	Record Entity = "record"
@@ -37,37 +46,51 @@ const (
 	Subscription Entity = "subscription"
 	Topic        Entity = "topic"
 	Queue        Entity = "queue"
+
+	Function Entity = "function"
+
+	Alarm Entity = "alarm"
 )
 
 var entities = map[Entity]struct{}{
-	NoneEntity:      struct{}{},
-	Vpc:             struct{}{},
-	Subnet:          struct{}{},
-	Instance:        struct{}{},
-	Volume:          struct{}{},
-	Tag:             struct{}{},
-	Securitygroup:   struct{}{},
-	Keypair:         struct{}{},
-	Internetgateway: struct{}{},
-	Routetable:      struct{}{},
-	Route:           struct{}{},
-	Loadbalancer:    struct{}{},
-	Listener:        struct{}{},
-	Targetgroup:     struct{}{},
-	Database:        struct{}{},
-	Dbsubnetgroup:   struct{}{},
-	Zone:            struct{}{},
-	Record:          struct{}{},
-	User:            struct{}{},
-	Group:           struct{}{},
-	Role:            struct{}{},
-	Policy:          struct{}{},
-	Accesskey:       struct{}{},
-	Bucket:          struct{}{},
-	Storageobject:   struct{}{},
-	Subscription:    struct{}{},
-	Topic:           struct{}{},
-	Queue:           struct{}{},
+	NoneEntity:          struct{}{},
+	Vpc:                 struct{}{},
+	Subnet:              struct{}{},
+	Instance:            struct{}{},
+	Volume:              struct{}{},
+	Snapshot:            struct{}{},
+	Image:               struct{}{},
+	Tag:                 struct{}{},
+	Securitygroup:       struct{}{},
+	Keypair:             struct{}{},
+	Internetgateway:     struct{}{},
+	Routetable:          struct{}{},
+	Route:               struct{}{},
+	Elasticip:           struct{}{},
+	Natgateway:          struct{}{},
+	Networkinterface:    struct{}{},
+	Loadbalancer:        struct{}{},
+	Listener:            struct{}{},
+	Targetgroup:         struct{}{},
+	Database:            struct{}{},
+	Dbsubnetgroup:       struct{}{},
+	Launchconfiguration: struct{}{},
+	Scalinggroup:        struct{}{},
+	Scalingpolicy:       struct{}{},
+	Zone:                struct{}{},
+	Record:              struct{}{},
+	User:                struct{}{},
+	Group:               struct{}{},
+	Role:                struct{}{},
+	Policy:              struct{}{},
+	Accesskey:           struct{}{},
+	Bucket:              struct{}{},
+	Storageobject:       struct{}{},
+	Subscription:        struct{}{},
+	Topic:               struct{}{},
+	Queue:               struct{}{},
+	Function:            struct{}{},
+	Alarm:               struct{}{},
 }
 
 func IsInvalidEntity(s string) bool {
@@ -0,0 +1,257 @@
+package ast
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Interpolation is a quoted string value embedding holes and references,
+// ex: "web-{env}-$subnet". A reference identifier is made of letters, digits
+// and underscores, use "${my-ref}" to delimit any other identifier.
+// Literal '{' and '$' can be escaped with '\'.
+type Interpolation struct {
+	Parts []InterpolationPart
+}
+
+type InterpolationPart struct {
+	Text, Hole, Ref string
+}
+
+var (
+	bareStringRegex = regexp.MustCompile(`^@?[a-zA-Z0-9-._:/]+$`)
+	intRegex        = regexp.MustCompile(`^[0-9]+$`)
+)
+
+// QuoteValue returns the string value as it should be written in a template
+// so that it is parsed back identically: unchanged when it can be parsed
+// unquoted, quoted and escaped otherwise.
+func QuoteValue(s string) string {
+	if bareStringRegex.MatchString(s) && !intRegex.MatchString(s) {
+		return s
+	}
+	return fmt.Sprintf(`"%s"`, escapeQuoted(s))
+}
+
+func (i Interpolation) String() string {
+	var buff bytes.Buffer
+	buff.WriteByte('"')
+	for _, part := range i.Parts {
+		switch {
+		case part.Hole != "":
+			fmt.Fprintf(&buff, "{%s}", part.Hole)
+		case part.Ref != "":
+			fmt.Fprintf(&buff, "${%s}", part.Ref)
+		default:
+			buff.WriteString(escapeQuoted(part.Text))
+		}
+	}
+	buff.WriteByte('"')
+	return buff.String()
+}
+
+func (i Interpolation) Holes() (holes []string) {
+	for _, part := range i.Parts {
+		if part.Hole != "" {
+			holes = append(holes, part.Hole)
+		}
+	}
+	return
+}
+
+func (i Interpolation) Refs() (refs []string) {
+	for _, part := range i.Parts {
+		if part.Ref != "" {
+			refs = append(refs, part.Ref)
+		}
+	}
+	return
+}
+
+// IsResolved returns true when the interpolation has no remaining holes or references
+func (i Interpolation) IsResolved() bool {
+	return len(i.Holes()) == 0 && len(i.Refs()) == 0
+}
+
+// Value returns the interpolated string, with remaining holes and references left as is
+func (i Interpolation) Value() string {
+	var buff bytes.Buffer
+	for _, part := range i.Parts {
+		switch {
+		case part.Hole != "":
+			fmt.Fprintf(&buff, "{%s}", part.Hole)
+		case part.Ref != "":
+			fmt.Fprintf(&buff, "$%s", part.Ref)
+		default:
+			buff.WriteString(part.Text)
+		}
+	}
+	return buff.String()
+}
+
+func (i Interpolation) processHoles(fills map[string]interface{}) Interpolation {
+	return i.process(func(p InterpolationPart) (interface{}, bool) {
+		if p.Hole == "" {
+			return nil, false
+		}
+		v, ok := fills[p.Hole]
+		return v, ok
+	})
+}
+
+func (i Interpolation) processRefs(fills map[string]interface{}) Interpolation {
+	return i.process(func(p InterpolationPart) (interface{}, bool) {
+		if p.Ref == "" {
+			return nil, false
+		}
+		v, ok := fills[p.Ref]
+		return v, ok
+	})
+}
+
+func (i Interpolation) process(lookup func(InterpolationPart) (interface{}, bool)) Interpolation {
+	processed := Interpolation{}
+	for _, part := range i.Parts {
+		if v, ok := lookup(part); ok {
+			var text string
+			switch vv := v.(type) {
+			case nil:
+			case []string:
+				text = strings.Join(vv, ",")
+			default:
+				text = fmt.Sprint(vv)
+			}
+			processed.addText(text)
+		} else if part.Text != "" {
+			processed.addText(part.Text)
+		} else {
+			processed.Parts = append(processed.Parts, part)
+		}
+	}
+	return processed
+}
+
+func (i *Interpolation) addText(text string) {
+	if l := len(i.Parts); l > 0 && i.Parts[l-1].Hole == "" && i.Parts[l-1].Ref == "" {
+		i.Parts[l-1].Text += text
+		return
+	}
+	i.Parts = append(i.Parts, InterpolationPart{Text: text})
+}
+
+// parseInterpolation parses the content of a quoted value, unescaping
+// characters and extracting holes and references
+func parseInterpolation(content string) (Interpolation, error) {
+	var result Interpolation
+	var text bytes.Buffer
+	flush := func() {
+		if text.Len() > 0 {
+			result.addText(text.String())
+			text.Reset()
+		}
+	}
+
+	runes := []rune(content)
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+		switch c {
+		case '\\':
+			if i+1 >= len(runes) {
+				return result, fmt.Errorf("invalid escape at end of \"%s\"", content)
+			}
+			i++
+			switch runes[i] {
+			case 'n':
+				text.WriteRune('\n')
+			case 't':
+				text.WriteRune('\t')
+			case 'r':
+				text.WriteRune('\r')
+			default:
+				text.WriteRune(runes[i])
+			}
+		case '{':
+			end := indexRune(runes[i+1:], '}')
+			if end < 0 || !isIdentifier(string(runes[i+1 : i+1+end])) {
+				text.WriteRune(c)
+				continue
+			}
+			flush()
+			result.Parts = append(result.Parts, InterpolationPart{Hole: strings.TrimSpace(string(runes[i+1 : i+1+end]))})
+			i += end + 1
+		case '$':
+			var ident string
+			if i+1 < len(runes) && runes[i+1] == '{' {
+				end := indexRune(runes[i+2:], '}')
+				if end < 0 || !isIdentifier(string(runes[i+2 : i+2+end])) {
+					text.WriteRune(c)
+					continue
+				}
+				ident = strings.TrimSpace(string(runes[i+2 : i+2+end]))
+				i += end + 2
+			} else {
+				j := i + 1
+				for j < len(runes) && isRefRune(runes[j]) {
+					j++
+				}
+				if j == i+1 {
+					text.WriteRune(c)
+					continue
+				}
+				ident = string(runes[i+1 : j])
+				i = j - 1
+			}
+			flush()
+			result.Parts = append(result.Parts, InterpolationPart{Ref: ident})
+		default:
+			text.WriteRune(c)
+		}
+	}
+	flush()
+
+	return result, nil
+}
+
+// QuotedContent returns the content of the quoted value of the string (ex: the
+// escaped string, or the interpolation without its quotes)
+func QuotedContent(v interface{}) string {
+	switch v.(type) {
+	case Interpolation:
+		str := v.(Interpolation).String()
+		return str[1 : len(str)-1]
+	default:
+		return escapeQuoted(fmt.Sprint(v))
+	}
+}
+
+func escapeQuoted(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\t", `\t`, "\r", `\r`, "{", `\{`, "$", `\$`)
+	return r.Replace(s)
+}
+
+func indexRune(runes []rune, r rune) int {
+	for i, c := range runes {
+		if c == r {
+			return i
+		}
+	}
+	return -1
+}
+
+func isRefRune(r rune) bool {
+	return r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+}
+
+func isIdentifier(s string) bool {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if !isRefRune(r) && r != '-' && r != '.' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,371 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+const (
+	LintError   = "error"
+	LintWarning = "warning"
+)
+
+// LintIssue is a problem found in a template text, at a 1-based line and column
+type LintIssue struct {
+	Line     int    `json:"line"`
+	Column   int    `json:"column"`
+	Severity string `json:"severity"`
+	Rule     string `json:"rule"`
+	Message  string `json:"message"`
+}
+
+func (i *LintIssue) String() string {
+	return fmt.Sprintf("%d:%d: %s: %s (%s)", i.Line, i.Column, i.Severity, i.Message, i.Rule)
+}
+
+var (
+	unknownNodeRegex = regexp.MustCompile(`^unknown (action|entity) '([^']*)'`)
+	paramKeyRegex    = regexp.MustCompile(`(?:^|\s)([a-zA-Z0-9-_.]+)\s*=`)
+)
+
+// locator maps a position in a parsed text to a position in the linted text
+type locator func(line, col int) (int, int)
+
+// offsetLocator returns the position in the linted text of an offset in a statement
+type offsetLocator func(offset int) (line, col int)
+
+type linterRef struct {
+	name      string
+	line, col int
+}
+
+type linter struct {
+	lookup   DefinitionLookupFunc
+	issues   []*LintIssue
+	declared []linterRef
+	used     []linterRef
+	deleted  map[string]bool
+}
+
+// Lint returns the issues found in the template text, ordered by position:
+// syntax errors, unknown commands, unexpected or deprecated params,
+// undefined or unused references and likely mistakes. Lint does not
+// need any access to the cloud or to the local synced resources.
+func Lint(text string, lookup DefinitionLookupFunc) []*LintIssue {
+	l := &linter{lookup: lookup, deleted: make(map[string]bool)}
+	l.lintText(text, func(line, col int) (int, int) { return line, col })
+	l.lintReferences()
+
+	sort.SliceStable(l.issues, func(i, j int) bool {
+		if l.issues[i].Line == l.issues[j].Line {
+			return l.issues[i].Column < l.issues[j].Column
+		}
+		return l.issues[i].Line < l.issues[j].Line
+	})
+	return l.issues
+}
+
+func (l *linter) add(line, col int, severity, rule, msg string, a ...interface{}) {
+	l.issues = append(l.issues, &LintIssue{Line: line, Column: col, Severity: severity, Rule: rule, Message: fmt.Sprintf(msg, a...)})
+}
+
+func (l *linter) lintText(text string, locate locator) {
+	tpl, err := Parse(text)
+	if err != nil {
+		l.lintParseError(text, err, locate)
+		return
+	}
+
+	runes := []rune(text)
+	for _, sts := range tpl.Statements {
+		pos := sts.Pos
+		at := func(offset int) (int, int) { return locate(textPosition(runes, pos+offset)) }
+		src := string(runes[sts.Pos:sts.End])
+
+		switch sts.Node.(type) {
+		case *ast.CommandNode:
+			l.lintCommand(sts.Node.(*ast.CommandNode), src, at)
+		case *ast.DeclarationNode:
+			decl := sts.Node.(*ast.DeclarationNode)
+			l.declare(decl.Ident, at)
+			if cmd, ok := decl.Expr.(*ast.CommandNode); ok {
+				l.lintCommand(cmd, src, at)
+			}
+		case *ast.IncludeNode:
+			n := sts.Node.(*ast.IncludeNode)
+			if n.Ident != "" {
+				l.declare(n.Ident, at)
+			}
+			l.useRefs(n.Args, src, at, true)
+		case *ast.ForNode:
+			loop := sts.Node.(*ast.ForNode)
+			if len(loop.Values) > 0 { // lint the body expanded with the first value
+				variable := fmt.Sprintf("{%s}", loop.Variable)
+				l.lintText(loop.Expand(loop.Values[0]), blockLocator(runes, sts, locate, loop.Body, variable, loop.Values[0]))
+			}
+		case *ast.IfNode:
+			l.lintText(sts.Node.(*ast.IfNode).Body, blockLocator(runes, sts, locate, "", "", ""))
+		}
+	}
+}
+
+func (l *linter) lintParseError(text string, err error, locate locator) {
+	line, col := 1, 1
+	rule, msg := "syntax", strings.SplitN(err.Error(), "\n", 2)[0]
+	switch err.(type) {
+	case *parseError:
+		perr := err.(*parseError)
+		if !perr.invalidIndexes() {
+			line, col = perr.line, perr.start+1
+			msg = fmt.Sprintf("syntax error near '%s'", strings.TrimSpace(perr.lines[perr.line-1][perr.start:]))
+		}
+	default:
+		if matches := unknownNodeRegex.FindStringSubmatch(err.Error()); len(matches) == 3 {
+			rule = "unknown-" + matches[1]
+			if found := regexp.MustCompile(`\b` + regexp.QuoteMeta(matches[2]) + `\b`).FindStringIndex(text); found != nil {
+				line, col = textPosition([]rune(text), len([]rune(text[:found[0]])))
+			}
+		}
+	}
+	line, col = locate(line, col)
+	l.add(line, col, LintError, rule, "%s", msg)
+}
+
+func (l *linter) lintCommand(cmd *ast.CommandNode, src string, at offsetLocator) {
+	key := definitionKey(cmd)
+	keys := paramKeysPositions(cmd, src)
+	paramPosition := func(k string) (int, int) {
+		if offsets, ok := keys[k]; ok {
+			return at(offsets[0])
+		}
+		return at(0)
+	}
+	line, col := at(0)
+
+	l.useRefs(cmd, src, at, cmd.Action != "check")
+	if id, ok := cmd.Refs["id"]; ok && cmd.Action == "delete" {
+		l.deleted[id] = true
+	}
+
+	for k, offsets := range keys {
+		if len(offsets) > 1 {
+			pline, pcol := at(offsets[1])
+			l.add(pline, pcol, LintWarning, "duplicate-param", "%s %s: param '%s' is set several times, only the last value is used", cmd.Action, cmd.Entity, k)
+		}
+	}
+
+	if cmd.Action == "ensure" {
+		if _, ok := keys["name"]; !ok {
+			l.add(line, col, LintError, "missing-param", "ensure %s: missing param 'name' to look up an existing resource", cmd.Entity)
+		}
+	}
+
+	def, ok := l.lookup(key)
+	if !ok {
+		l.add(line, col, LintError, "unknown-command", "unknown command '%s %s'", cmd.Action, cmd.Entity)
+		return
+	}
+	for _, k := range unexpectedParams(cmd, def) {
+		pline, pcol := paramPosition(k)
+		l.add(pline, pcol, LintError, "unexpected-param", "%s %s: unexpected param '%s' (required: %s; extra: %s)", cmd.Action, cmd.Entity, k, strings.Join(def.Required(), ", "), strings.Join(def.Extra(), ", "))
+	}
+	for _, k := range cmd.Keys() {
+		if replacement, ok := def.DeprecatedParams[k]; ok {
+			pline, pcol := paramPosition(k)
+			l.add(pline, pcol, LintWarning, "deprecated-param", "%s %s: param '%s' is deprecated, use '%s'", cmd.Action, cmd.Entity, k, replacement)
+		}
+	}
+}
+
+func (l *linter) declare(ident string, at offsetLocator) {
+	line, col := at(0)
+	for _, decl := range l.declared {
+		if decl.name == ident {
+			l.add(line, col, LintError, "redeclared-reference", "reference '%s' already declared at line %d", ident, decl.line)
+			return
+		}
+	}
+	l.declared = append(l.declared, linterRef{name: ident, line: line, col: col})
+}
+
+func (l *linter) useRefs(cmd *ast.CommandNode, src string, at offsetLocator, warnDeleted bool) {
+	refs := cmd.UsedRefs()
+	sort.Strings(refs)
+	for _, ref := range refs {
+		var offset int
+		if i := strings.Index(src, "$"+ref); i > -1 {
+			offset = len([]rune(src[:i]))
+		}
+		line, refCol := at(offset)
+		if warnDeleted && l.deleted[ref] {
+			l.add(line, refCol, LintWarning, "deleted-reference", "using reference '$%s' after the deletion of its resource", ref)
+		}
+		l.used = append(l.used, linterRef{name: ref, line: line, col: refCol})
+	}
+}
+
+func (l *linter) lintReferences() {
+	var used, declared []string
+	for _, r := range l.used {
+		used = append(used, r.name)
+	}
+	for _, r := range l.declared {
+		declared = append(declared, r.name)
+	}
+
+	undefined, unused := checkReferences(used, declared)
+	for _, name := range undefined {
+		for _, r := range l.used {
+			if r.name == name {
+				l.add(r.line, r.col, LintError, "undefined-reference", "using reference '$%s' but '%s' is undefined in template", name, name)
+				break
+			}
+		}
+	}
+	for _, name := range unused {
+		for _, r := range l.declared {
+			if r.name == name {
+				l.add(r.line, r.col, LintWarning, "unused-reference", "unused reference '%s' in template", name)
+				break
+			}
+		}
+	}
+}
+
+// paramKeysPositions returns the rune offsets of the params keys in the
+// source of the command, ignoring the content of quoted values and holes
+func paramKeysPositions(cmd *ast.CommandNode, src string) map[string][]int {
+	masked := []rune(src)
+	var quoted, escaped bool
+	var holes int
+	for i, r := range masked {
+		switch {
+		case escaped:
+			escaped = false
+			masked[i] = '_'
+		case quoted && r == '\\':
+			escaped = true
+			masked[i] = '_'
+		case r == '"':
+			quoted = !quoted
+		case quoted:
+			masked[i] = '_'
+		case r == '{':
+			holes++
+		case r == '}' && holes > 0:
+			holes--
+		case holes > 0:
+			masked[i] = '_'
+		}
+	}
+
+	text := string(masked)
+	start := 0
+	if loc := regexp.MustCompile(`\b` + cmd.Action + `\s+` + cmd.Entity + `\b`).FindStringIndex(text); loc != nil {
+		start = loc[1]
+	}
+
+	positions := make(map[string][]int)
+	for _, match := range paramKeyRegex.FindAllStringSubmatchIndex(text[start:], -1) {
+		key := text[start+match[2] : start+match[3]]
+		positions[key] = append(positions[key], len([]rune(text[:start+match[2]])))
+	}
+	return positions
+}
+
+// blockLocator maps the positions in the body of a block statement (ex: a for
+// loop) to positions in the text of the block. The body is made of the
+// trimmed non blank lines between the braces of the block, in which the
+// variable may have been replaced with the value (ex: for loops bodies).
+func blockLocator(text []rune, sts *ast.Statement, locate locator, body, variable, value string) locator {
+	span := text[sts.Pos:sts.End]
+	open, end := 0, len(span)-1
+	for i, r := range span {
+		if r == '{' {
+			open = i
+			break
+		}
+	}
+	for end > open && span[end] != '}' {
+		end--
+	}
+
+	type bodyLine struct{ line, col int }
+	var lines []bodyLine
+	line, col := textPosition(text, sts.Pos+open+1)
+	for i, content := range strings.Split(string(span[open+1:end]), "\n") {
+		if i > 0 {
+			line, col = line+1, 1
+		}
+		if trimmed := strings.TrimSpace(content); trimmed != "" {
+			indent := len([]rune(content)) - len([]rune(strings.TrimLeft(content, " \t")))
+			lines = append(lines, bodyLine{line: line, col: col + indent})
+		}
+	}
+
+	bodyLines := strings.Split(body, "\n")
+	return func(l, c int) (int, int) {
+		if l < 1 || l > len(lines) {
+			return locate(textPosition(text, sts.Pos))
+		}
+		if variable != "" && l <= len(bodyLines) {
+			c = unexpandedColumn(bodyLines[l-1], variable, value, c)
+		}
+		return locate(lines[l-1].line, lines[l-1].col+c-1)
+	}
+}
+
+// unexpandedColumn returns the column in the line of the given column in the
+// line expanded with the value replacing the variable
+func unexpandedColumn(line, variable, value string, col int) int {
+	var columns []int // column in line of each rune of the expanded line
+	runes, variableRunes := []rune(line), []rune(variable)
+	for i := 0; i < len(runes); {
+		if strings.HasPrefix(string(runes[i:]), variable) {
+			for range value {
+				columns = append(columns, i+1)
+			}
+			i += len(variableRunes)
+			continue
+		}
+		columns = append(columns, i+1)
+		i++
+	}
+	if col >= 1 && col <= len(columns) {
+		return columns[col-1]
+	}
+	return col - len(columns) + len(runes)
+}
+
+// textPosition returns the 1-based line and column of the rune offset in the text
+func textPosition(text []rune, offset int) (line, col int) {
+	line, col = 1, 1
+	for i := 0; i < offset && i < len(text); i++ {
+		if text[i] == '\n' {
+			line, col = line+1, 1
+		} else {
+			col++
+		}
+	}
+	return
+}
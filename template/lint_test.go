@@ -0,0 +1,78 @@
+package template
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLintTemplate(t *testing.T) {
+	defs := map[string]Definition{
+		"createvpc":    {Action: "create", Entity: "vpc", RequiredParams: []string{"cidr"}, ExtraParams: []string{"name"}},
+		"createsubnet": {Action: "create", Entity: "subnet", RequiredParams: []string{"cidr", "vpc"}, ExtraParams: []string{"availabilityzone", "name", "zone"}, DeprecatedParams: map[string]string{"availabilityzone": "zone"}},
+		"deletevpc":    {Action: "delete", Entity: "vpc", RequiredParams: []string{"id"}},
+	}
+	lookup := func(key string) (Definition, bool) {
+		def, ok := defs[key]
+		return def, ok
+	}
+
+	tcases := []struct {
+		text string
+		exp  []string
+	}{
+		{text: "vpc = create vpc cidr=10.0.0.0/16\ncreate subnet vpc=$vpc cidr=10.0.0.0/24"},
+		{
+			text: "create vpc cidr=10.0.0.0/16 nme=main",
+			exp:  []string{"1:29: error: create vpc: unexpected param 'nme' (required: cidr; extra: name) (unexpected-param)"},
+		},
+		{
+			text: "create subnet vpc=vpc-1 cidr=10.0.0.0/24 availabilityzone=eu-west-1a",
+			exp:  []string{"1:42: warning: create subnet: param 'availabilityzone' is deprecated, use 'zone' (deprecated-param)"},
+		},
+		{
+			text: "create vpc cidr=10.0.0.0/16\nstart vpc id=vpc-1",
+			exp:  []string{"2:1: error: unknown command 'start vpc' (unknown-command)"},
+		},
+		{
+			text: "create vpc cidr=10.0.0.0/16\ncreate vpcc",
+			exp:  []string{"2:8: error: unknown entity 'vpcc' (unknown-entity)"},
+		},
+		{
+			text: "create vpc cidr=10.0.0.0/16 =",
+			exp:  []string{"1:29: error: syntax error near '=' (syntax)"},
+		},
+		{
+			text: "vpc = create vpc cidr=10.0.0.0/16\nunused = create vpc cidr=10.0.0.0/16\ncreate subnet vpc=$vpc cidr=10.0.0.0/24 name=\"a cidr=b\" cidr=10.0.1.0/24\ncreate subnet cidr=10.0.2.0/24 vpc=$other",
+			exp: []string{
+				"2:1: warning: unused reference 'unused' in template (unused-reference)",
+				"3:57: warning: create subnet: param 'cidr' is set several times, only the last value is used (duplicate-param)",
+				"4:36: error: using reference '$other' but 'other' is undefined in template (undefined-reference)",
+			},
+		},
+		{
+			text: "vpc = create vpc cidr=10.0.0.0/16\nfor zone in a,b {\n  create subnet vpc=$vpc cidr=10.0.0.0/24 zone={zone}\n  # misspelled\n  create subnet name=sub-{zone}-{zone} vpc=$vpc cidr=10.0.0.0/24 zne={zone}\n}",
+			exp:  []string{"5:66: error: create subnet: unexpected param 'zne' (required: cidr, vpc; extra: availabilityzone, name, zone) (unexpected-param)"},
+		},
+		{
+			text: "vpc = create vpc cidr=10.0.0.0/16\nvpc = create vpc cidr=10.0.1.0/16\ndelete vpc id=$vpc\ncreate subnet vpc=$vpc cidr=10.0.0.0/24",
+			exp: []string{
+				"2:1: error: reference 'vpc' already declared at line 1 (redeclared-reference)",
+				"4:19: warning: using reference '$vpc' after the deletion of its resource (deleted-reference)",
+			},
+		},
+		{
+			text: "ensure vpc cidr=10.0.0.0/16",
+			exp:  []string{"1:1: error: ensure vpc: missing param 'name' to look up an existing resource (missing-param)"},
+		},
+	}
+
+	for i, tcase := range tcases {
+		var got []string
+		for _, issue := range Lint(tcase.text, lookup) {
+			got = append(got, issue.String())
+		}
+		if g, w := strings.Join(got, "\n"), strings.Join(tcase.exp, "\n"); g != w {
+			t.Fatalf("%d: got\n%s\nwant\n%s", i+1, g, w)
+		}
+	}
+}
@@ -3,41 +3,105 @@ package template
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/wallix/awless/template/internal/ast"
 )
 
 type toJSON struct {
-	ID       string    `json:"id"`
-	Commands []command `json:"commands"`
+	ID         string    `json:"id"`
+	RollbackOf string    `json:"rollbackOf,omitempty"`
+	RollbackID string    `json:"rollbackID,omitempty"`
+	ResumeOf   string    `json:"resumeOf,omitempty"`
+	ResumedBy  string    `json:"resumedBy,omitempty"`
+	Commands   []command `json:"commands"`
+	Pending    []command `json:"pending,omitempty"`
 }
 
 type command struct {
-	Line    string   `json:"line"`
-	Errors  []string `json:"errors,omitempty"`
-	Results []string `json:"results,omitempty"`
+	Line     string                 `json:"line"`
+	Ident    string                 `json:"ident,omitempty"`
+	Errors   []string               `json:"errors,omitempty"`
+	Results  []string               `json:"results,omitempty"`
+	Outputs  map[string]interface{} `json:"outputs,omitempty"`
+	Attempts int                    `json:"attempts,omitempty"`
+	Previous map[string]interface{} `json:"previous,omitempty"`
 }
 
 func (t *Template) MarshalJSON() ([]byte, error) {
+	return json.Marshal(marshalTemplate(t))
+}
+
+func marshalTemplate(t *Template) *toJSON {
 	out := &toJSON{}
 	out.ID = t.ID
-	out.Commands = []command{}
+	out.RollbackOf = t.RollbackOf
+	out.RollbackID = t.RollbackID
+	out.ResumeOf = t.ResumeOf
+	out.ResumedBy = t.ResumedBy
+	out.Commands = marshalCommands(t.Statements)
+	if len(t.Pending) > 0 {
+		out.Pending = marshalCommands(t.Pending)
+	}
+
+	return out
+}
 
-	for _, cmd := range t.CommandNodesIterator() {
-		newCmd := command{}
-		newCmd.Line = cmd.String()
-		if cmd.CmdErr != nil {
-			newCmd.Errors = append(newCmd.Errors, cmd.CmdErr.Error())
+func marshalCommands(statements []*ast.Statement) []command {
+	commands := []command{}
+	for _, sts := range statements {
+		cmd := commandNode(sts)
+		if cmd == nil {
+			continue
 		}
-		if cmd.CmdResult != nil {
-			if s, ok := cmd.CmdResult.(string); ok {
-				newCmd.Results = append(newCmd.Results, s)
-			}
+		var ident string
+		if decl, ok := sts.Node.(*ast.DeclarationNode); ok {
+			ident = decl.Ident
 		}
-		out.Commands = append(out.Commands, newCmd)
+		commands = append(commands, marshalCommand(ident, cmd))
 	}
+	return commands
+}
 
-	return json.Marshal(out)
+func marshalCommand(ident string, cmd *ast.CommandNode) command {
+	newCmd := command{}
+	masked := *cmd
+	masked.Params = maskedParams(cmd)
+	newCmd.Line = masked.String()
+	newCmd.Ident = ident
+	if cmd.CmdErr != nil {
+		newCmd.Errors = append(newCmd.Errors, cmd.CmdErr.Error())
+	}
+	if cmd.CmdResult != nil {
+		if s, ok := cmd.CmdResult.(string); ok {
+			newCmd.Results = append(newCmd.Results, s)
+		}
+	}
+	newCmd.Outputs = cmd.CmdOutputs
+	newCmd.Attempts = cmd.CmdAttempts
+	newCmd.Previous = cmd.CmdPrevious
+	return newCmd
+}
+
+// sensitiveMask replaces the params set from sensitive outputs
+const sensitiveMask = "******"
+
+// maskedParams returns the params of the command with the values set from
+// sensitive outputs masked
+func maskedParams(cmd *ast.CommandNode) map[string]interface{} {
+	if len(cmd.CmdSensitive) == 0 {
+		return cmd.Params
+	}
+	params := make(map[string]interface{})
+	for k, v := range cmd.Params {
+		params[k] = v
+	}
+	for _, k := range cmd.CmdSensitive {
+		if _, ok := params[k]; ok {
+			params[k] = sensitiveMask
+		}
+	}
+	return params
 }
 
 func (t *Template) UnmarshalJSON(b []byte) error {
@@ -47,14 +111,29 @@ func (t *Template) UnmarshalJSON(b []byte) error {
 		return err
 	}
 
-	tt := &Template{ID: v.ID, AST: &ast.AST{
+	tt := &Template{ID: v.ID, RollbackOf: v.RollbackOf, RollbackID: v.RollbackID, ResumeOf: v.ResumeOf, ResumedBy: v.ResumedBy, AST: &ast.AST{
 		Statements: make([]*ast.Statement, 0),
 	}}
 
-	for _, c := range v.Commands {
+	var err error
+	if tt.Statements, err = unmarshalCommands(v.Commands); err != nil {
+		return err
+	}
+	if tt.Pending, err = unmarshalCommands(v.Pending); err != nil {
+		return err
+	}
+
+	*t = *tt
+
+	return nil
+}
+
+func unmarshalCommands(commands []command) ([]*ast.Statement, error) {
+	statements := make([]*ast.Statement, 0)
+	for _, c := range commands {
 		node, err := parseStatement(c.Line)
 		if err != nil {
-			return err
+			return statements, err
 		}
 
 		switch node.(type) {
@@ -66,11 +145,27 @@ func (t *Template) UnmarshalJSON(b []byte) error {
 			if len(c.Errors) > 0 {
 				n.CmdErr = errors.New(c.Errors[0])
 			}
-			tt.Statements = append(tt.Statements, &ast.Statement{n})
+			n.CmdOutputs = c.Outputs
+			n.CmdAttempts = c.Attempts
+			for k, v := range c.Previous {
+				if n.CmdPrevious == nil {
+					n.CmdPrevious = make(map[string]interface{})
+				}
+				if list, ok := v.([]interface{}); ok { // ex: previous security groups
+					var values []string
+					for _, e := range list {
+						values = append(values, fmt.Sprint(e))
+					}
+					v = values
+				}
+				n.CmdPrevious[k] = v
+			}
+			if c.Ident != "" {
+				statements = append(statements, &ast.Statement{Node: &ast.DeclarationNode{Ident: c.Ident, Expr: n}})
+			} else {
+				statements = append(statements, &ast.Statement{Node: n})
+			}
 		}
 	}
-
-	*t = *tt
-
-	return nil
+	return statements, nil
 }
@@ -11,7 +11,7 @@ import (
 
 func TestUnmarshalFromJSON(t *testing.T) {
 	tpl := &Template{}
-	err := tpl.UnmarshalJSON([]byte(`{"id": "123456", "commands": [
+	err := tpl.UnmarshalJSON([]byte(`{"id": "123456", "rollbackOf": "654321", "commands": [
 	  {"errors": ["first error"], "results": ["vpc-12345"], "line": "create vpc cidr=10.0.0.0/24"},
 	   {"line": "create subnet"},
 	   {"errors": ["third error"], "results": ["i-12345"], "line": "create instance type=t2.micro count=4"}
@@ -29,6 +29,9 @@ func TestUnmarshalFromJSON(t *testing.T) {
 	if got, want := tpl.ID, "123456"; got != want {
 		t.Fatalf("got %s, want %s", got, want)
 	}
+	if got, want := tpl.RollbackOf, "654321"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
 
 	if got, want := cmds[0].CmdResult, "vpc-12345"; got != want {
 		t.Fatalf("got %v, want %v", got, want)
@@ -77,6 +80,17 @@ func TestUnmarshalFromJSON(t *testing.T) {
 	if got, want := cmds[2].CmdErr.Error(), "third error"; got != want {
 		t.Fatalf("got %v, want %v", got, want)
 	}
+
+	updated := &Template{}
+	if err = updated.UnmarshalJSON([]byte(`{"id": "123456", "commands": [
+	  {"previous": {"group": ["sg-1", "sg-2"], "type": "t2.micro"}, "line": "update instance id=i-12345 group=sg-3 type=t2.small"}
+	]}`)); err != nil {
+		t.Fatal(err)
+	}
+	expPrevious := map[string]interface{}{"group": []string{"sg-1", "sg-2"}, "type": "t2.micro"}
+	if got, want := updated.CommandNodesIterator()[0].CmdPrevious, expPrevious; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
 }
 
 func TestMarshalToJSON(t *testing.T) {
@@ -120,6 +134,58 @@ func TestMarshalToJSON(t *testing.T) {
 		},
 	}
 
+	rolledBack := MustParse("create subnet cidr=10.0.0.0/24")
+	rolledBack.ID, rolledBack.RollbackID = "12345", "67890"
+	tcases = append(tcases, struct {
+		templ *Template
+		out   string
+	}{
+		rolledBack,
+		`{
+		  "id": "12345",
+		  "rollbackID": "67890",
+		  "commands": [
+		    {"line": "create subnet cidr=10.0.0.0/24"}
+		  ]
+		}`,
+	})
+
+	retried := MustParse("create instance name=web retry=5")
+	retried.ID = "12345"
+	for _, cmd := range retried.CommandNodesIterator() {
+		cmd.CmdResult, cmd.CmdAttempts = "i-12345", 3
+	}
+	tcases = append(tcases, struct {
+		templ *Template
+		out   string
+	}{
+		retried,
+		`{
+		  "id": "12345",
+		  "commands": [
+		    {"results": ["i-12345"], "attempts": 3, "line": "create instance name=web retry=5"}
+		  ]
+		}`,
+	})
+
+	updated := MustParse("update instance id=i-12345 group=sg-3")
+	updated.ID = "12345"
+	for _, cmd := range updated.CommandNodesIterator() {
+		cmd.CmdPrevious = map[string]interface{}{"group": []string{"sg-1", "sg-2"}}
+	}
+	tcases = append(tcases, struct {
+		templ *Template
+		out   string
+	}{
+		updated,
+		`{
+		  "id": "12345",
+		  "commands": [
+		    {"previous": {"group": ["sg-1", "sg-2"]}, "line": "update instance group=sg-3 id=i-12345"}
+		  ]
+		}`,
+	})
+
 	for _, c := range tcases {
 		actual, err := c.templ.MarshalJSON()
 		if err != nil {
@@ -0,0 +1,140 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// ParseParamsFile parses holes fillers from the content of a params file.
+// The format is deduced from the file extension: JSON (.json), YAML (.yml,
+// .yaml) or 'key=value' otherwise. Nested maps are flattened with dots, so
+// that {"instance": {"type": "t2.micro"}} fills the hole {instance.type}.
+func ParseParamsFile(name string, content []byte) (map[string]interface{}, error) {
+	var params map[string]interface{}
+	var err error
+
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".json":
+		params, err = parseJSONParams(content)
+	case ".yml", ".yaml":
+		params, err = parseYAMLParams(content)
+	default:
+		params, err = parseKeyValueParams(content)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("params file %s: %s", name, err)
+	}
+
+	return params, nil
+}
+
+func parseKeyValueParams(content []byte) (map[string]interface{}, error) {
+	var lines []string
+	for _, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) == 0 {
+		return make(map[string]interface{}), nil
+	}
+
+	return ParseParams(strings.Join(lines, " "))
+}
+
+func parseJSONParams(content []byte) (map[string]interface{}, error) {
+	var decoded map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, err
+	}
+
+	params := make(map[string]interface{})
+	if err := flattenParams("", decoded, params); err != nil {
+		return nil, err
+	}
+
+	return params, nil
+}
+
+func flattenParams(prefix string, values map[string]interface{}, params map[string]interface{}) error {
+	for k, v := range values {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+		switch v.(type) {
+		case map[string]interface{}:
+			if err := flattenParams(key, v.(map[string]interface{}), params); err != nil {
+				return err
+			}
+		case map[interface{}]interface{}:
+			nested := make(map[string]interface{})
+			for nk, nv := range v.(map[interface{}]interface{}) {
+				nested[fmt.Sprint(nk)] = nv
+			}
+			if err := flattenParams(key, nested, params); err != nil {
+				return err
+			}
+		case []interface{}:
+			var list []string
+			for _, elem := range v.([]interface{}) {
+				list = append(list, fmt.Sprint(elem))
+			}
+			params[key] = list
+		case int:
+			params[key] = v
+		case json.Number:
+			if i, err := strconv.Atoi(v.(json.Number).String()); err == nil {
+				params[key] = i
+			} else {
+				params[key] = v.(json.Number).String()
+			}
+		case nil:
+			return fmt.Errorf("null value for '%s'", key)
+		default:
+			params[key] = fmt.Sprint(v)
+		}
+	}
+
+	return nil
+}
+
+func parseYAMLParams(content []byte) (map[string]interface{}, error) {
+	var values map[string]interface{}
+	if err := yaml.Unmarshal(content, &values); err != nil {
+		return nil, err
+	}
+
+	params := make(map[string]interface{})
+	if err := flattenParams("", values, params); err != nil {
+		return nil, err
+	}
+
+	return params, nil
+}
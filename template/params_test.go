@@ -0,0 +1,69 @@
+package template
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseParamsFile(t *testing.T) {
+	exp := map[string]interface{}{
+		"instance.type":  "t2.micro",
+		"instance.count": 2,
+		"instance.name":  "my web # server",
+		"vpc.cidr":       "10.0.0.0/16",
+		"subnet.ids":     []string{"subnet-1", "subnet-2"},
+	}
+
+	tcases := []struct {
+		name, content string
+	}{
+		{
+			name: "prod.yml",
+			content: `# prod params
+instance:
+  type: t2.micro
+  count: 2 # comment
+  name: "my web # server"
+vpc.cidr: 10.0.0.0/16
+subnet:
+  ids:
+    - subnet-1
+    - subnet-2
+`,
+		},
+		{
+			name:    "prod.yaml",
+			content: "instance:\n  type: t2.micro\n  count: 2\n  name: 'my web # server'\nvpc:\n  cidr: 10.0.0.0/16\nsubnet.ids: [subnet-1, subnet-2]",
+		},
+		{
+			name:    "flow.yml",
+			content: "instance: {type: t2.micro, count: 2}\nvpc.cidr: 10.0.0.0/16\nsubnet: {ids: [subnet-1, subnet-2]}\ninstance.name: >-\n  my web\n  # server\n",
+		},
+		{
+			name:    "prod.json",
+			content: `{"instance": {"type": "t2.micro", "count": 2, "name": "my web # server"}, "vpc.cidr": "10.0.0.0/16", "subnet": {"ids": ["subnet-1", "subnet-2"]}}`,
+		},
+		{
+			name:    "prod.params",
+			content: "# prod params\ninstance.type=t2.micro instance.count=2\ninstance.name=\"my web # server\"\n\nvpc.cidr=10.0.0.0/16\nsubnet.ids=subnet-1,subnet-2",
+		},
+	}
+
+	for _, tcase := range tcases {
+		params, err := ParseParamsFile(tcase.name, []byte(tcase.content))
+		if err != nil {
+			t.Fatalf("%s: %s", tcase.name, err)
+		}
+		if got, want := params, exp; !reflect.DeepEqual(got, want) {
+			t.Fatalf("%s: got %#v, want %#v", tcase.name, got, want)
+		}
+	}
+
+	if _, err := ParseParamsFile("invalid.yml", []byte("instance\n  type: t2.micro")); err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Fatalf("expected error on invalid yaml, got %v", err)
+	}
+	if _, err := ParseParamsFile("invalid.json", []byte(`{"instance": `)); err == nil || !strings.Contains(err.Error(), "invalid.json") {
+		t.Fatalf("expected error on invalid json, got %v", err)
+	}
+}
@@ -82,6 +82,36 @@ func ParseParams(text string) (map[string]interface{}, error) {
 	}
 }
 
+// QuoteParamsArgs joins 'key=value' command line arguments into a params text,
+// quoting the values the template grammar does not accept unquoted (ex: containing spaces or '=')
+func QuoteParamsArgs(args []string) string {
+	var quoted []string
+	for _, arg := range args {
+		splits := strings.SplitN(arg, "=", 2)
+		if len(splits) != 2 || isUnquotedParamValue(splits[0], splits[1]) {
+			quoted = append(quoted, arg)
+			continue
+		}
+		escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(splits[1])
+		quoted = append(quoted, fmt.Sprintf("%s=\"%s\"", splits[0], escaped))
+	}
+	return strings.Join(quoted, " ")
+}
+
+// isUnquotedParamValue returns whether the grammar parses 'key=value' as a
+// single param (ex: 'name=web-{env}' or 'value=a=b' are not, as is)
+func isUnquotedParamValue(key, value string) bool {
+	n, err := parseStatement(fmt.Sprintf("none none %s=%s", key, value))
+	if err != nil {
+		return false
+	}
+	cmd, ok := n.(*ast.CommandNode)
+	if !ok {
+		return false
+	}
+	return len(cmd.Params)+len(cmd.Refs)+len(cmd.Holes) == 1
+}
+
 func parseStatement(text string) (ast.Node, error) {
 	templ, err := Parse(text)
 	if err != nil {
@@ -85,6 +85,21 @@ func TestWrapPegParseError(t *testing.T) {
 	})
 }
 
+func TestParsingStatementsPositions(t *testing.T) {
+	text := "# comment\nvpc = create vpc cidr=10.0.0.0/16\n\n  for i in 1,2 {\n create tag key=k{i}\n}\ncreate subnet vpc=$vpc"
+	tpl := MustParse(text)
+
+	exp := []string{"vpc = create vpc cidr=10.0.0.0/16", "for i in 1,2 {\n create tag key=k{i}\n}", "create subnet vpc=$vpc"}
+	if got, want := len(tpl.Statements), len(exp); got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	for i, sts := range tpl.Statements {
+		if got, want := string([]rune(text)[sts.Pos:sts.End]), exp[i]; got != want {
+			t.Fatalf("%d: got %q, want %q", i+1, got, want)
+		}
+	}
+}
+
 func TestParamsOnlyParsing(t *testing.T) {
 	params, err := ParseParams("type=t2.micro subnet=@my-subnet count=4")
 	if err != nil {
@@ -95,6 +110,38 @@ func TestParamsOnlyParsing(t *testing.T) {
 	if got, want := params, exp; !reflect.DeepEqual(got, want) {
 		t.Fatalf("\ngot\n%v\n\nwant\n%v\n", got, want)
 	}
+
+	params, err = ParseParams(QuoteParamsArgs([]string{"name=my web server", "description=say \"hi\"", "type=t2.micro"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exp = map[string]interface{}{"name": "my web server", "description": "say \"hi\"", "type": "t2.micro"}
+	if got, want := params, exp; !reflect.DeepEqual(got, want) {
+		t.Fatalf("\ngot\n%v\n\nwant\n%v\n", got, want)
+	}
+
+	if got, want := QuoteParamsArgs([]string{"value=a=b", "name=web-{env}", "subnet=@my-subnet", "vpc=$vpc", "count=4", "threshold=75.5"}), `value="a=b" name="web-{env}" subnet=@my-subnet vpc=$vpc count=4 threshold="75.5"`; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	params, err = ParseParams(QuoteParamsArgs([]string{"value=a=b", "threshold=75.5"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exp = map[string]interface{}{"value": "a=b", "threshold": "75.5"}
+	if got, want := params, exp; !reflect.DeepEqual(got, want) {
+		t.Fatalf("\ngot\n%v\n\nwant\n%v\n", got, want)
+	}
+
+	tpl, err := Parse("create instance " + QuoteParamsArgs([]string{"name=web-{env}"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := tpl.CommandNodesIterator()[0].Params["name"].(fmt.Stringer).String(), `"web-{env}"`; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
 }
 
 func TestTemplateParsing(t *testing.T) {
@@ -400,6 +447,246 @@ create instance count=1 instance.type=t2.micro subnet=$mysubnet image=ami-9398d3
 			}
 		}
 	})
+	t.Run("For loop parsing", func(t *testing.T) {
+		tcases := []struct {
+			input string
+			exp   *ast.ForNode
+		}{
+			{
+				input: "for i in 1-3 {\n  create subnet cidr=10.0.{i}.0/24\n}",
+				exp:   &ast.ForNode{Variable: "i", Values: []string{"1", "2", "3"}, Body: "create subnet cidr=10.0.{i}.0/24"},
+			},
+			{
+				input: "for env in staging, prod {\n\n  sub_{env} = create subnet name={env}\n  create instance subnet=$sub_{env} type={instance.type}\n}",
+				exp:   &ast.ForNode{Variable: "env", Values: []string{"staging", "prod"}, Body: "sub_{env} = create subnet name={env}\ncreate instance subnet=$sub_{env} type={instance.type}"},
+			},
+			{
+				input: "for a in x,y {\nfor b in 1-2 {\ncreate tag key={a} value={b}\n}\n}",
+				exp:   &ast.ForNode{Variable: "a", Values: []string{"x", "y"}, Body: "for b in 1-2 {\ncreate tag key={a} value={b}\n}"},
+			},
+		}
+
+		for i, tcase := range tcases {
+			templ, err := Parse(tcase.input)
+			if err != nil {
+				t.Fatalf("%d: %s", i+1, err)
+			}
+			if got, want := len(templ.Statements), 1; got != want {
+				t.Fatalf("%d: got %d, want %d", i+1, got, want)
+			}
+			if got, want := templ.Statements[0].Node, tcase.exp; !reflect.DeepEqual(got, want) {
+				t.Fatalf("%d: got %#v, want %#v", i+1, got, want)
+			}
+			if got, want := MustParse(templ.String()), templ; !want.IsSameAs(got) {
+				t.Fatalf("%d: got %s, want %s", i+1, got, want)
+			}
+		}
+
+		if _, err := Parse("for i in 3-1 {\ncreate vpc\n}"); err == nil {
+			t.Fatal("expected error on invalid range")
+		}
+		if _, err := Parse("for i in 1-3 {\n\n}"); err == nil {
+			t.Fatal("expected error on empty loop body")
+		}
+	})
+
+	t.Run("Conditional block parsing", func(t *testing.T) {
+		tcases := []struct {
+			input string
+			exp   *ast.IfNode
+		}{
+			{
+				input: "unless exists securitygroup name=my-sg {\n  create securitygroup name=my-sg\n}",
+				exp:   &ast.IfNode{Negate: true, Entity: "securitygroup", Filters: map[string]string{"name": "my-sg"}, Body: "create securitygroup name=my-sg"},
+			},
+			{
+				input: "if exists instance id=i-12345 state=running {\nstop instance id=i-12345\n}",
+				exp:   &ast.IfNode{Entity: "instance", Filters: map[string]string{"id": "i-12345", "state": "running"}, Body: "stop instance id=i-12345"},
+			},
+			{
+				input: "if exists vpc{\ncreate subnet name={subnet.name}\n}",
+				exp:   &ast.IfNode{Entity: "vpc", Filters: map[string]string{}, Body: "create subnet name={subnet.name}"},
+			},
+		}
+
+		for i, tcase := range tcases {
+			templ, err := Parse(tcase.input)
+			if err != nil {
+				t.Fatalf("%d: %s", i+1, err)
+			}
+			if got, want := templ.Statements[0].Node, tcase.exp; !reflect.DeepEqual(got, want) {
+				t.Fatalf("%d: got %#v, want %#v", i+1, got, want)
+			}
+			if got, want := MustParse(templ.String()), templ; !want.IsSameAs(got) {
+				t.Fatalf("%d: got %s, want %s", i+1, got, want)
+			}
+		}
+
+		if _, err := Parse("if exists unknownentity name=x {\ncreate vpc\n}"); err == nil {
+			t.Fatal("expected error on unknown entity")
+		}
+	})
+
+	t.Run("Include parsing", func(t *testing.T) {
+		tcases := []struct {
+			input string
+			exp   *ast.IncludeNode
+		}{
+			{
+				input: "include ./vpc.awls",
+				exp:   &ast.IncludeNode{Source: "./vpc.awls", Args: &ast.CommandNode{Refs: map[string]string{}, Params: map[string]interface{}{}, Holes: map[string]string{}}},
+			},
+			{
+				input: "net = use repo:network vpc.cidr=10.0.0.0/16 subnet.vpc=$vpc instance.name={app.name}",
+				exp: &ast.IncludeNode{Ident: "net", Source: "repo:network", Args: &ast.CommandNode{
+					Refs:   map[string]string{"subnet.vpc": "vpc"},
+					Params: map[string]interface{}{"vpc.cidr": "10.0.0.0/16"},
+					Holes:  map[string]string{"instance.name": "app.name"},
+				}},
+			},
+			{
+				input: `include "https://example.com/my templates/vpc.awls" count=2`,
+				exp:   &ast.IncludeNode{Source: "https://example.com/my templates/vpc.awls", Args: &ast.CommandNode{Refs: map[string]string{}, Params: map[string]interface{}{"count": 2}, Holes: map[string]string{}}},
+			},
+		}
+
+		for i, tcase := range tcases {
+			templ, err := Parse(tcase.input)
+			if err != nil {
+				t.Fatalf("%d: %s", i+1, err)
+			}
+			if got, want := templ.Statements[0].Node, tcase.exp; !reflect.DeepEqual(got, want) {
+				t.Fatalf("%d: got %#v, want %#v", i+1, got, want)
+			}
+			if got, want := MustParse(templ.String()), templ; !want.IsSameAs(got) {
+				t.Fatalf("%d: got %s, want %s", i+1, got, want)
+			}
+		}
+
+		templ := MustParse("user = create user name=bob\nupdate securitygroup id=sg-1234")
+		if got, want := len(templ.Statements), 2; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		if _, ok := templ.Statements[0].Node.(*ast.DeclarationNode); !ok {
+			t.Fatalf("expected declaration node, got %T", templ.Statements[0].Node)
+		}
+	})
+
+	t.Run("Typed holes parsing", func(t *testing.T) {
+		templ, err := Parse(`create instance count={instance.count:int=2 "number of web nodes"} subnet={instance.subnet:id(subnet)} name={name="my \"web\" server"} type={instance.type}`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cmd := extractCommandNode(templ.Statements[0].Node)
+		if err := assertHoles(cmd, map[string]string{"count": "instance.count", "subnet": "instance.subnet", "name": "name", "type": "instance.type"}); err != nil {
+			t.Fatal(err)
+		}
+		exp := map[string]ast.HoleSpec{
+			"instance.count":  {Type: "int", Default: "2", Description: "number of web nodes"},
+			"instance.subnet": {Type: "id(subnet)"},
+			"name":            {Default: `my "web" server`},
+		}
+		if got, want := cmd.HoleSpecs, exp; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %#v, want %#v", got, want)
+		}
+		if got, want := MustParse(templ.String()), templ; !want.IsSameAs(got) {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+
+		if cmd := extractCommandNode(MustParse("create instance type={instance.type}").Statements[0].Node); cmd.HoleSpecs != nil {
+			t.Fatalf("expected no hole specs, got %#v", cmd.HoleSpecs)
+		}
+		if _, err := Parse("create instance count={instance.count:float}"); err == nil || !strings.Contains(err.Error(), "unknown hole type 'float'") {
+			t.Fatalf("expected error on unknown hole type, got %v", err)
+		}
+		if _, err := Parse("create instance subnet={instance.subnet:id(unknown)}"); err == nil || !strings.Contains(err.Error(), "unknown entity") {
+			t.Fatalf("expected error on unknown hole entity, got %v", err)
+		}
+	})
+
+	t.Run("Quoted values parsing", func(t *testing.T) {
+		tcases := []struct {
+			input  string
+			params map[string]interface{}
+			holes  map[string]string
+			refs   map[string]string
+		}{
+			{
+				input:  `create instance name="my web server" type=t2.micro`,
+				params: map[string]interface{}{"name": "my web server", "type": "t2.micro"},
+			},
+			{
+				input:  `create instance name="say \"hi\"\n\{not a hole\} \$notaref"`,
+				params: map[string]interface{}{"name": "say \"hi\"\n{not a hole} $notaref"},
+			},
+			{
+				input:  `create instance name="42" count=42`,
+				params: map[string]interface{}{"name": "42", "count": 42},
+			},
+			{
+				input: `create instance name="{instance.name}" subnet="$subnet"`,
+				holes: map[string]string{"name": "instance.name"},
+				refs:  map[string]string{"subnet": "subnet"},
+			},
+			{
+				input: `create instance name="web-{env}-${my_ref}.$suffix"`,
+				params: map[string]interface{}{"name": ast.Interpolation{Parts: []ast.InterpolationPart{
+					{Text: "web-"}, {Hole: "env"}, {Text: "-"}, {Ref: "my_ref"}, {Text: "."}, {Ref: "suffix"},
+				}}},
+			},
+		}
+
+		for i, tcase := range tcases {
+			templ, err := Parse(tcase.input)
+			if err != nil {
+				t.Fatalf("%d: %s", i+1, err)
+			}
+			cmd := extractCommandNode(templ.Statements[0].Node)
+			if tcase.params == nil {
+				tcase.params = make(map[string]interface{})
+			}
+			if tcase.holes == nil {
+				tcase.holes = make(map[string]string)
+			}
+			if tcase.refs == nil {
+				tcase.refs = make(map[string]string)
+			}
+			if err := assertParams(cmd, tcase.params); err != nil {
+				t.Fatalf("%d: %s", i+1, err)
+			}
+			if err := assertHoles(cmd, tcase.holes); err != nil {
+				t.Fatalf("%d: %s", i+1, err)
+			}
+			if err := assertRefs(cmd, tcase.refs); err != nil {
+				t.Fatalf("%d: %s", i+1, err)
+			}
+			if got, want := MustParse(templ.String()), templ; !want.IsSameAs(got) {
+				t.Fatalf("%d: got %s, want %s", i+1, got, want)
+			}
+		}
+
+		templ := MustParse(`create instance name="web-{env}-$subnet"`)
+		cmd := extractCommandNode(templ.Statements[0].Node)
+		if got, want := cmd.UsedHoles(), []string{"env"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		if got, want := cmd.UsedRefs(), []string{"subnet"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+
+		cmd.ProcessHoles(map[string]interface{}{"env": "prod"})
+		if got, want := fmt.Sprint(cmd.Params["name"]), `"web-prod-${subnet}"`; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		cmd.ProcessRefs(map[string]interface{}{"subnet": "subnet-1234"})
+		if got, want := cmd.Params["name"], "web-prod-subnet-1234"; got != want {
+			t.Fatalf("got %#v, want %#v", got, want)
+		}
+
+		if _, err := Parse(`create instance name="unterminated`); err == nil {
+			t.Fatal("expected error on unterminated quoted value")
+		}
+	})
 }
 
 func assertParams(n ast.Node, expected map[string]interface{}) error {
@@ -0,0 +1,230 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+// Plan is the predicted effect of a template on a local graph
+type Plan struct {
+	// Diff between the local graph and the graph predicted after the template run
+	Diff *graph.Diff
+	// Modified are the existing resources whose properties are predicted to change
+	Modified []*graph.Resource
+	// Affected are the existing resources depending on deleted or modified resources
+	Affected []*graph.Resource
+}
+
+// params referencing the parent of the resource to create
+var planParentParams = []string{"subnet", "vpc", "zone", "bucket", "loadbalancer"}
+
+// Plan predicts the resources created, deleted or modified by the template
+// on the local graph, from the root resource (ex: the region). The lookup
+// gives the properties planned by the commands definitions.
+func (s *Template) Plan(local *graph.Graph, root *graph.Resource, lookup DefinitionLookupFunc) (*Plan, error) {
+	from, to := graph.NewGraph(), graph.NewGraph()
+	from.AddGraph(local)
+	to.AddGraph(local)
+
+	plan := &Plan{}
+	predicted := make(map[string]string)
+	affected, modified := make(map[string]bool), make(map[string]bool)
+	var modifiedIds []string
+
+	addAffected := func(res *graph.Resource) error {
+		dependents, err := from.ListResourcesDependingOn(res)
+		if err != nil {
+			return err
+		}
+		for _, dep := range dependents {
+			if !affected[dep.Id()] {
+				affected[dep.Id()] = true
+				plan.Affected = append(plan.Affected, dep)
+			}
+		}
+		return nil
+	}
+
+	paramValue := func(cmd *ast.CommandNode, key string) string {
+		if v, ok := cmd.Params[key]; ok {
+			return fmt.Sprint(v)
+		}
+		if ref, ok := cmd.Refs[key]; ok {
+			decl, _ := splitRefProperty(ref, func(r string) bool { _, ok := predicted[r]; return ok })
+			return predicted[decl]
+		}
+		return ""
+	}
+
+	for i, sts := range s.Statements {
+		var cmd *ast.CommandNode
+		var ident string
+		switch sts.Node.(type) {
+		case *ast.CommandNode:
+			cmd = sts.Node.(*ast.CommandNode)
+		case *ast.DeclarationNode:
+			decl := sts.Node.(*ast.DeclarationNode)
+			if c, ok := decl.Expr.(*ast.CommandNode); ok {
+				cmd, ident = c, decl.Ident
+			}
+		}
+		if cmd == nil {
+			continue
+		}
+
+		switch cmd.Action {
+		case "create":
+			id := fmt.Sprintf("new-%s-%d", cmd.Entity, i+1)
+			if cmd.CmdResult != nil {
+				id = fmt.Sprint(cmd.CmdResult)
+			} else if ident != "" {
+				id = "$" + ident
+			}
+			if ident != "" {
+				predicted[ident] = id
+			}
+
+			res := graph.InitResource(cmd.Entity, id)
+			res.Properties[properties.ID] = id
+			if name := paramValue(cmd, "name"); name != "" {
+				res.Properties[properties.Name] = name
+			}
+			if err := to.AddResource(res); err != nil {
+				return plan, err
+			}
+
+			parent := root
+			for _, p := range planParentParams {
+				if parentId := paramValue(cmd, p); parentId != "" {
+					if found, err := to.FindResource(parentId); err == nil && found != nil {
+						parent = found
+						break
+					}
+				}
+			}
+			if err := to.AddParentRelation(parent, res); err != nil {
+				return plan, err
+			}
+		case "delete":
+			res, err := to.FindResource(paramValue(cmd, "id"))
+			if err != nil {
+				return plan, err
+			}
+			if res == nil {
+				continue
+			}
+			if err := addAffected(res); err != nil {
+				return plan, err
+			}
+			to.DeleteResource(res)
+		case "start", "stop", "update":
+			res, err := to.FindResource(paramValue(cmd, "id"))
+			if err != nil {
+				return plan, err
+			}
+			if res == nil {
+				continue
+			}
+
+			var def Definition
+			if lookup != nil {
+				def, _ = lookup(fmt.Sprintf("%s%s", cmd.Action, cmd.Entity))
+			}
+			changes := make(map[string]interface{})
+			for k, v := range def.PlannedProperties {
+				changes[k] = v
+			}
+			if cmd.Action == "update" {
+				for k, v := range cmd.Params {
+					if k != "id" {
+						changes[paramProperty(def, k)] = v
+					}
+				}
+			}
+
+			var changed bool
+			for k, v := range changes {
+				previous, exists := res.Properties[k]
+				if exists && fmt.Sprint(previous) == fmt.Sprint(v) {
+					continue
+				}
+				res.Properties[k] = v
+				if err := to.UpdateResource(res); err != nil { // not a known property
+					if exists {
+						res.Properties[k] = previous
+					} else {
+						delete(res.Properties, k)
+					}
+					continue
+				}
+				changed = true
+			}
+			if changed && !modified[res.Id()] {
+				modified[res.Id()] = true
+				modifiedIds = append(modifiedIds, res.Id())
+				if err := addAffected(res); err != nil {
+					return plan, err
+				}
+			}
+		}
+	}
+
+	for _, id := range modifiedIds {
+		res, err := to.FindResource(id)
+		if err != nil {
+			return plan, err
+		}
+		if res != nil {
+			plan.Modified = append(plan.Modified, res)
+		}
+	}
+
+	diff, err := graph.DefaultDiffer.Run(root.Id(), from, to)
+	if err != nil {
+		return plan, err
+	}
+	plan.Diff = diff
+
+	return plan, nil
+}
+
+// paramProperty returns the property of the resource set by an update
+// param: the one declared with the definition revert rule, otherwise the
+// param name in CamelCase (ex: desired-capacity: DesiredCapacity)
+func paramProperty(def Definition, param string) string {
+	if def.Revert != nil {
+		if prop, ok := def.Revert.Previous[param]; ok {
+			return prop
+		}
+	}
+	var prop string
+	for _, part := range strings.Split(param, "-") {
+		prop += strings.Title(part)
+	}
+	return prop
+}
+
+// HasChanges returns true when resources are predicted to be created, deleted or modified
+func (p *Plan) HasChanges() bool {
+	return p.Diff.HasDiff() || len(p.Modified) > 0
+}
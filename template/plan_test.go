@@ -0,0 +1,137 @@
+package template
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+)
+
+func TestPlan(t *testing.T) {
+	local := graph.NewGraph()
+	local.AddResource(
+		resourcetest.Region("eu-west-1").Build(),
+		resourcetest.VPC("vpc-1").Build(),
+		resourcetest.Subnet("sub-1").Build(),
+		resourcetest.Instance("i-1").Prop("State", "running").Build(),
+		resourcetest.Instance("i-2").Prop("State", "running").Build(),
+		resourcetest.SecGroup("sg-1").Build(),
+	)
+	resourcetest.AddParents(local, "eu-west-1 -> vpc-1", "vpc-1 -> sub-1", "sub-1 -> i-1", "sub-1 -> i-2", "vpc-1 -> sg-1")
+	local.AddAppliesOnRelation(graph.InitResource("securitygroup", "sg-1"), graph.InitResource("instance", "i-1"))
+
+	tpl := MustParse("sub = create subnet vpc=vpc-1 name=priv\ncreate instance subnet=$sub name=web\ndelete instance id=i-1\nstop instance id=i-2\nstart instance id=i-2\nstop instance id=i-2")
+	root := graph.InitResource("region", "eu-west-1")
+
+	plan, err := tpl.Plan(local, root, planLookup)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !plan.HasChanges() {
+		t.Fatal("expected changes")
+	}
+
+	diffMeta := func(g *graph.Graph, typ, id string) interface{} {
+		res, err := g.GetResource(typ, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res.Meta["diff"]
+	}
+	if got, want := diffMeta(plan.Diff.ToGraph(), "subnet", "$sub"), "extra"; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := diffMeta(plan.Diff.ToGraph(), "instance", "new-instance-2"), "extra"; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := diffMeta(plan.Diff.FromGraph(), "instance", "i-1"), "extra"; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got := diffMeta(plan.Diff.ToGraph(), "instance", "i-2"); got != nil {
+		t.Fatalf("got %v, want nil", got)
+	}
+
+	var parents []*graph.Resource
+	err = plan.Diff.ToGraph().Accept(&graph.ParentsVisitor{From: graph.InitResource("instance", "new-instance-2"), Each: graph.VisitorCollectFunc(&parents)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := graph.Resources(parents).Map(func(r *graph.Resource) string { return r.Id() }), []string{"$sub", "vpc-1", "eu-west-1"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	if got, want := len(plan.Modified), 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := plan.Modified[0].Properties["State"], "stopped"; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	if got, want := len(plan.Affected), 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := plan.Affected[0].Id(), "sg-1"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
+
+func TestPlanStopAlarm(t *testing.T) {
+	local := graph.NewGraph()
+	local.AddResource(
+		resourcetest.Region("eu-west-1").Build(),
+		resourcetest.Alarm("cpu-high").Prop("State", "OK").Prop("ActionsEnabled", true).Build(),
+	)
+	resourcetest.AddParents(local, "eu-west-1 -> cpu-high")
+
+	plan, err := MustParse("stop alarm id=cpu-high").Plan(local, graph.InitResource("region", "eu-west-1"), planLookup)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(plan.Modified), 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := plan.Modified[0].Properties["ActionsEnabled"], false; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := plan.Modified[0].Properties["State"], "OK"; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestPlanUpdateHyphenatedParams(t *testing.T) {
+	local := graph.NewGraph()
+	local.AddResource(
+		resourcetest.Region("eu-west-1").Build(),
+		resourcetest.ScalingGroup("asg-1").Prop("DesiredCapacity", 2).Prop("MaxSize", 4).Prop("LaunchConfigurationName", "lc-1").Build(),
+	)
+	resourcetest.AddParents(local, "eu-west-1 -> asg-1")
+
+	plan, err := MustParse("update scalinggroup id=asg-1 desired-capacity=3 max-size=6 launchconfiguration=lc-2").Plan(local, graph.InitResource("region", "eu-west-1"), planLookup)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(plan.Modified), 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	for prop, want := range map[string]interface{}{"DesiredCapacity": 3, "MaxSize": 6, "LaunchConfigurationName": "lc-2"} {
+		if got := plan.Modified[0].Properties[prop]; got != want {
+			t.Fatalf("%s: got %v, want %v", prop, got, want)
+		}
+	}
+}
+
+var planDefs = map[string]Definition{
+	"startinstance":      {Action: "start", Entity: "instance", PlannedProperties: map[string]interface{}{"State": "running"}},
+	"stopinstance":       {Action: "stop", Entity: "instance", PlannedProperties: map[string]interface{}{"State": "stopped"}},
+	"stopalarm":          {Action: "stop", Entity: "alarm", PlannedProperties: map[string]interface{}{"ActionsEnabled": false}},
+	"updatescalinggroup": {Action: "update", Entity: "scalinggroup", Revert: &RevertRule{Action: "update", Previous: map[string]string{"desired-capacity": "DesiredCapacity", "launchconfiguration": "LaunchConfigurationName"}}},
+}
+
+func planLookup(key string) (Definition, bool) {
+	def, ok := planDefs[key]
+	return def, ok
+}
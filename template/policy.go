@@ -0,0 +1,295 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/template/internal/ast"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Effects of policy rules
+const (
+	PolicyDeny = "deny"
+	PolicyWarn = "warn"
+)
+
+// Policy is a set of guardrails evaluated on templates before they run, ex:
+//   rules:
+//   - name: no-prod-deletion
+//     effect: deny
+//     action: delete
+//     entity: [instance, volume]
+//     tags:
+//       env: prod
+//   - name: no-public-ssh
+//     effect: deny
+//     action: update
+//     entity: securitygroup
+//     params:
+//       cidr: 0.0.0.0/0
+//       portrange: [22, any]
+type Policy struct {
+	Rules []*PolicyRule `json:"rules" yaml:"rules"`
+}
+
+// PolicyRule matches the commands given their action, entity, params and the
+// properties or tags of the resources they target (found by their 'id' param
+// in the local graph). All the given conditions have to match for the rule to
+// fire. Conditions are patterns, or lists of patterns of which one has to
+// match, where '*' matches any characters. A param set with a reference is
+// only known at run time and does not match. A targeted resource that cannot
+// be resolved (reference, or not found locally) fires the rules with property
+// or tag conditions, since they cannot be checked. Tags are only synced for
+// EC2 resources (instance, volume, vpc, subnet, ...).
+type PolicyRule struct {
+	Name       string              `json:"name" yaml:"name"`
+	Effect     string              `json:"effect" yaml:"effect"`
+	Message    string              `json:"message,omitempty" yaml:"message,omitempty"`
+	Action     patterns            `json:"action,omitempty" yaml:"action,omitempty"`
+	Entity     patterns            `json:"entity,omitempty" yaml:"entity,omitempty"`
+	Params     map[string]patterns `json:"params,omitempty" yaml:"params,omitempty"`
+	Properties map[string]patterns `json:"properties,omitempty" yaml:"properties,omitempty"`
+	Tags       map[string]patterns `json:"tags,omitempty" yaml:"tags,omitempty"`
+}
+
+// ParsePolicy parses a policy from the content of a file, in JSON (.json or
+// content starting with '{') or YAML
+func ParsePolicy(name string, content []byte) (*Policy, error) {
+	policy := &Policy{}
+	var err error
+	if DetectFormat(name, content) == JSONFormat {
+		decoder := json.NewDecoder(bytes.NewReader(content))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(policy)
+	} else {
+		err = yaml.UnmarshalStrict(content, policy)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("policy: %s", err)
+	}
+	for i, rule := range policy.Rules {
+		if rule == nil || rule.Name == "" {
+			return nil, fmt.Errorf("policy: rule %d: missing name", i+1)
+		}
+		if rule.Effect != PolicyDeny && rule.Effect != PolicyWarn {
+			return nil, fmt.Errorf("policy: rule '%s': invalid effect '%s', expecting %s or %s", rule.Name, rule.Effect, PolicyDeny, PolicyWarn)
+		}
+	}
+
+	return policy, nil
+}
+
+// PolicyViolation is the error of a command matching a policy rule
+type PolicyViolation struct {
+	Rule    *PolicyRule
+	Command string
+	// Unresolved is true when the rule fired on a target that could not be checked
+	Unresolved bool
+}
+
+func (v *PolicyViolation) Error() string {
+	verb := "denied"
+	if v.Rule.Effect == PolicyWarn {
+		verb = "warned"
+	}
+	msg := fmt.Sprintf("%s: %s by policy rule '%s'", v.Command, verb, v.Rule.Name)
+	if v.Rule.Message != "" {
+		msg = fmt.Sprintf("%s: %s", msg, v.Rule.Message)
+	}
+	if v.Unresolved {
+		msg += " (target not found in local resources, its properties and tags could not be checked)"
+	}
+	return msg
+}
+
+// IsDenied returns true when the errors contain a violation of a deny rule
+func IsDenied(errs []error) bool {
+	for _, err := range errs {
+		if v, ok := err.(*PolicyViolation); ok && v.Rule.Effect == PolicyDeny {
+			return true
+		}
+	}
+	return false
+}
+
+// PolicyValidator returns a PolicyViolation for each command matching a rule
+type PolicyValidator struct {
+	Policy      *Policy
+	LookupGraph LookupGraphFunc
+}
+
+func (v *PolicyValidator) Execute(t *Template) (errs []error) {
+	for _, cmd := range t.CommandNodesIterator() {
+		for _, rule := range v.Policy.Rules {
+			if matched, unresolved := rule.matches(cmd, v.LookupGraph); matched {
+				errs = append(errs, &PolicyViolation{Rule: rule, Command: cmd.String(), Unresolved: unresolved})
+			}
+		}
+	}
+	return
+}
+
+// matches returns true when the rule fires on the command, and whether it
+// fired because a targeted resource could not be resolved
+func (r *PolicyRule) matches(cmd *ast.CommandNode, lookup LookupGraphFunc) (bool, bool) {
+	if len(r.Action) > 0 && !r.Action.match(cmd.Action) {
+		return false, false
+	}
+	if len(r.Entity) > 0 && !r.Entity.match(cmd.Entity) {
+		return false, false
+	}
+	for key, p := range r.Params {
+		if !p.matchAny(cmd.Params[key]) {
+			return false, false
+		}
+	}
+	if len(r.Properties) == 0 && len(r.Tags) == 0 {
+		return true, false
+	}
+
+	_, isRef := cmd.Refs["id"]
+	_, isHole := cmd.Holes["id"]
+	unresolved := isRef || isHole
+	for _, id := range printedValues(cmd.Params["id"]) {
+		res, err := findResource(cmd.Entity, map[string]string{"id": id}, lookup)
+		if err != nil || res == nil {
+			unresolved = true
+			continue
+		}
+		if r.matchResource(res) {
+			return true, false
+		}
+	}
+	return unresolved, unresolved
+}
+
+func (r *PolicyRule) matchResource(res *graph.Resource) bool {
+	for key, p := range r.Properties {
+		var value interface{}
+		for prop, v := range res.Properties {
+			if strings.EqualFold(prop, key) {
+				value = v
+			}
+		}
+		if !p.matchAny(value) {
+			return false
+		}
+	}
+
+	tags := make(map[string]string)
+	if name, ok := res.Properties[properties.Name]; ok {
+		tags["Name"] = fmt.Sprint(name)
+	}
+	for _, tag := range printedValues(res.Properties[properties.Tags]) {
+		if kv := strings.SplitN(tag, "=", 2); len(kv) == 2 {
+			tags[kv[0]] = kv[1]
+		}
+	}
+	for key, p := range r.Tags {
+		value, ok := tags[key]
+		if !ok || !p.match(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// patterns are unmarshaled from a single value or a list of values
+type patterns []string
+
+func (p *patterns) UnmarshalJSON(b []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	return p.set(v)
+}
+
+func (p *patterns) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v interface{}
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	return p.set(v)
+}
+
+func (p *patterns) set(v interface{}) error {
+	switch v.(type) {
+	case []interface{}:
+		for _, e := range v.([]interface{}) {
+			switch e.(type) {
+			case map[string]interface{}, map[interface{}]interface{}, []interface{}, nil:
+				return errors.New("expected a list of values")
+			}
+			*p = append(*p, fmt.Sprint(e))
+		}
+	case map[string]interface{}, map[interface{}]interface{}, nil:
+		return errors.New("expected a value or a list of values")
+	default:
+		*p = patterns{fmt.Sprint(v)}
+	}
+	return nil
+}
+
+// matchAny returns true when one of the values (ex: list param) matches
+func (p patterns) matchAny(value interface{}) bool {
+	for _, v := range printedValues(value) {
+		if p.match(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p patterns) match(s string) bool {
+	for _, pattern := range p {
+		if globRegex(pattern).MatchString(s) {
+			return true
+		}
+	}
+	return false
+}
+
+func globRegex(pattern string) *regexp.Regexp {
+	return regexp.MustCompile("^" + strings.Replace(regexp.QuoteMeta(pattern), `\*`, ".*", -1) + "$")
+}
+
+func printedValues(value interface{}) []string {
+	switch value.(type) {
+	case nil:
+		return nil
+	case []string:
+		return value.([]string)
+	case []interface{}:
+		var values []string
+		for _, v := range value.([]interface{}) {
+			values = append(values, fmt.Sprint(v))
+		}
+		return values
+	default:
+		return []string{fmt.Sprint(value)}
+	}
+}
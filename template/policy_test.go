@@ -0,0 +1,138 @@
+package template_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+	"github.com/wallix/awless/template"
+)
+
+const policyYAML = `rules:
+- name: no-prod-deletion
+  effect: deny
+  message: production resources cannot be deleted
+  action: delete
+  entity: [instance, volume]
+  tags:
+    env: prod
+- name: no-public-ssh
+  effect: deny
+  action: update
+  entity: securitygroup
+  params:
+    cidr: 0.0.0.0/0
+    portrange: [22, any]
+- name: large-instances
+  effect: warn
+  action: create
+  entity: instance
+  params:
+    type: "*.*xlarge"
+- name: stopped-instances
+  effect: warn
+  action: "*"
+  entity: instance
+  properties:
+    state: stopped
+`
+
+func TestPolicyValidator(t *testing.T) {
+	policy, err := template.ParsePolicy("policy.yml", []byte(policyYAML))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g := graph.NewGraph()
+	g.AddResource(
+		resourcetest.Instance("inst_prod").Prop("Name", "web").Prop("State", "running").Prop("Tags", []string{"env=prod", "team=web"}).Build(),
+		resourcetest.Instance("inst_dev").Prop("Name", "dev").Prop("State", "stopped").Prop("Tags", []string{"env=dev"}).Build(),
+	)
+	rule := &template.PolicyValidator{Policy: policy, LookupGraph: func(key string) (*graph.Graph, bool) { return g, true }}
+
+	tcases := []struct {
+		text string
+		exp  []string
+	}{
+		{"delete instance id=inst_prod", []string{"delete instance id=inst_prod: denied by policy rule 'no-prod-deletion': production resources cannot be deleted"}},
+		{"delete instance id=inst_dev,inst_prod", []string{
+			"delete instance id=inst_dev,inst_prod: denied by policy rule 'no-prod-deletion': production resources cannot be deleted",
+			"delete instance id=inst_dev,inst_prod: warned by policy rule 'stopped-instances'",
+		}},
+		{"delete instance id=inst_unknown", []string{
+			"delete instance id=inst_unknown: denied by policy rule 'no-prod-deletion': production resources cannot be deleted (target not found in local resources, its properties and tags could not be checked)",
+			"delete instance id=inst_unknown: warned by policy rule 'stopped-instances' (target not found in local resources, its properties and tags could not be checked)",
+		}},
+		{"delete instance id=$web", []string{
+			"delete instance id=$web: denied by policy rule 'no-prod-deletion': production resources cannot be deleted (target not found in local resources, its properties and tags could not be checked)",
+			"delete instance id=$web: warned by policy rule 'stopped-instances' (target not found in local resources, its properties and tags could not be checked)",
+		}},
+		{"delete instance id=inst_dev", []string{"delete instance id=inst_dev: warned by policy rule 'stopped-instances'"}},
+		{"delete database id=inst_prod", nil},
+		{"delete subnet id=inst_prod", nil},
+		{"start instance id=inst_dev", []string{"start instance id=inst_dev: warned by policy rule 'stopped-instances'"}},
+		{"update securitygroup id=sg-1 inbound=authorize protocol=tcp cidr=0.0.0.0/0 portrange=22", []string{"update securitygroup cidr=0.0.0.0/0 id=sg-1 inbound=authorize portrange=22 protocol=tcp: denied by policy rule 'no-public-ssh'"}},
+		{"update securitygroup id=sg-1 inbound=authorize protocol=tcp cidr=0.0.0.0/0 portrange=443", nil},
+		{"update securitygroup id=sg-1 inbound=authorize protocol=tcp cidr=10.0.0.0/16 portrange=22", nil},
+		{"create instance type=m4.2xlarge name=big", []string{"create instance name=big type=m4.2xlarge: warned by policy rule 'large-instances'"}},
+		{"create instance type=t2.micro name=small", nil},
+	}
+	for _, tcase := range tcases {
+		errs := template.MustParse(tcase.text).Validate(rule)
+		var got []string
+		for _, err := range errs {
+			got = append(got, err.Error())
+		}
+		if want := tcase.exp; !reflect.DeepEqual(got, want) {
+			t.Fatalf("%s: got %q, want %q", tcase.text, got, want)
+		}
+		if got, want := template.IsDenied(errs), len(tcase.exp) > 0 && strings.Contains(tcase.exp[0], "denied"); got != want {
+			t.Fatalf("%s: denied: got %t, want %t", tcase.text, got, want)
+		}
+	}
+}
+
+func TestParsePolicy(t *testing.T) {
+	t.Run("json", func(t *testing.T) {
+		policy, err := template.ParsePolicy("policy.json", []byte(`{"rules": [{"name": "no-deletion", "effect": "deny", "action": "delete"}]}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := policy.Rules[0].Action, []string{"delete"}; !reflect.DeepEqual([]string(got), want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("yaml", func(t *testing.T) {
+		policy, err := template.ParsePolicy("policy.yml", []byte("rules:\n- {name: no-deletion, effect: deny, action: delete, entity: [instance, volume]}\n- name: no-public-ssh\n  effect: warn\n  message: |\n    SSH must not be\n    open to the world\n"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := policy.Rules[0].Entity, []string{"instance", "volume"}; !reflect.DeepEqual([]string(got), want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		if got, want := policy.Rules[1].Message, "SSH must not be\nopen to the world\n"; got != want {
+			t.Fatalf("got %q, want %q", got, want)
+		}
+	})
+
+	tcases := []struct {
+		content, expErr string
+	}{
+		{"rules:\n- effect: deny\n", "policy: rule 1: missing name"},
+		{"rules:\n- name: any\n  effect: block\n", "policy: rule 'any': invalid effect 'block', expecting deny or warn"},
+		{"rules:\n- name: any\n  effect: deny\n  actions: delete\n", "policy: yaml: unmarshal errors:\n  line 4: field actions not found in type template.PolicyRule"},
+		{"rules:\n- name: any\n  effect: deny\n  params:\n    cidr:\n      ip: 0.0.0.0\n", "policy: expected a value or a list of values"},
+	}
+	for _, tcase := range tcases {
+		_, err := template.ParsePolicy("policy.yml", []byte(tcase.content))
+		if err == nil {
+			t.Fatalf("%q: expected error", tcase.content)
+		}
+		if got, want := err.Error(), tcase.expErr; got != want {
+			t.Fatalf("got %q, want %q", got, want)
+		}
+	}
+}
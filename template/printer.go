@@ -19,9 +19,10 @@ type Printer interface {
 	Print(*Template)
 }
 
-func NewLogPrinter(w io.Writer) *logPrinter {
+func NewLogPrinter(w io.Writer, lookup DefinitionLookupFunc) *logPrinter {
 	return &logPrinter{
 		w:        w,
+		lookup:   lookup,
 		RenderOK: renderNoop,
 		RenderKO: renderNoop,
 	}
@@ -76,7 +77,8 @@ func (p *defaultPrinter) Print(t *Template) {
 }
 
 type logPrinter struct {
-	w io.Writer
+	w      io.Writer
+	lookup DefinitionLookupFunc
 
 	RenderOK renderFunc
 	RenderKO renderFunc
@@ -87,11 +89,23 @@ func (p *logPrinter) Print(t *Template) {
 
 	buff.WriteString(fmt.Sprintf("Date: %s", parseULIDDate(t.ID)))
 
-	if IsRevertible(t) {
+	switch {
+	case t.RollbackID != "":
+		buff.WriteString(fmt.Sprintf(", RevertID: <rolled back by %s>", t.RollbackID))
+	case IsRevertible(t, p.lookup):
 		buff.WriteString(fmt.Sprintf(", RevertID: %s", t.ID))
-	} else {
+	default:
 		buff.WriteString(", RevertID: <not revertible>")
 	}
+	if t.RollbackOf != "" {
+		buff.WriteString(fmt.Sprintf(", RollbackOf: %s", t.RollbackOf))
+	}
+	if t.ResumeOf != "" {
+		buff.WriteString(fmt.Sprintf(", ResumeOf: %s", t.ResumeOf))
+	}
+	if t.ResumedBy != "" {
+		buff.WriteString(fmt.Sprintf(", ResumedBy: %s", t.ResumedBy))
+	}
 	buff.WriteString("\n")
 
 	tabw := tabwriter.NewWriter(buff, 0, 8, 0, '\t', 0)
@@ -0,0 +1,87 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"errors"
+
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+// Resume returns the template continuing a failed run: its failed statements
+// followed by its pending ones. References to the successful declarations of
+// the failed run are bound to their results and outputs. The given params
+// override the params of the first failed statement.
+func (s *Template) Resume(overrides map[string]interface{}) (*Template, error) {
+	values := make(map[string]interface{})
+	var statements []*ast.Statement
+
+	for _, sts := range s.Statements {
+		cmd := commandNode(sts)
+		if cmd == nil {
+			continue
+		}
+		if cmd.CmdErr != nil {
+			statements = append(statements, sts.Clone())
+			continue
+		}
+		if decl, ok := sts.Node.(*ast.DeclarationNode); ok && cmd.CmdResult != nil {
+			values[decl.Ident] = cmd.CmdResult
+			for prop, v := range cmd.CmdOutputs {
+				values[decl.Ident+"."+prop] = v
+			}
+		}
+	}
+	failedCount := len(statements)
+	for _, sts := range s.Pending {
+		statements = append(statements, sts.Clone())
+	}
+
+	if len(statements) == 0 {
+		return nil, errors.New("resume: no failed or pending statements")
+	}
+	if len(overrides) > 0 && failedCount == 0 {
+		return nil, errors.New("resume: no failed statement to override params")
+	}
+
+	for i, sts := range statements {
+		cmd := commandNode(sts)
+		cmd.ProcessRefs(values)
+		if i == 0 && failedCount > 0 {
+			for k, v := range overrides {
+				cmd.Params[k] = v
+				delete(cmd.Refs, k)
+				delete(cmd.Holes, k)
+			}
+		}
+	}
+
+	return &Template{ResumeOf: s.ID, AST: &ast.AST{Statements: statements}}, nil
+}
+
+// MergeRuns returns a template holding the statements of the given runs in
+// order (ex: a failed run and the runs resuming it), to revert them together
+func MergeRuns(runs ...*Template) *Template {
+	merged := &Template{AST: &ast.AST{}}
+	for i, run := range runs {
+		if i == 0 {
+			merged.ID = run.ID
+		}
+		merged.Statements = append(merged.Statements, run.Statements...)
+	}
+	return merged
+}
@@ -0,0 +1,104 @@
+package template
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func failedRun(t *testing.T) *Template {
+	tpl := MustParse("vpc = create vpc cidr=10.0.0.0/16\nsub = create subnet cidr=10.0.0.0/24 vpc=$vpc\ncreate instance subnet=$sub name=web")
+	tpl.ID = "tpl-1"
+	cmds := tpl.CommandNodesIterator()
+	cmds[0].CmdResult = "vpc-1234"
+	cmds[1].CmdErr = errors.New("create subnet: InvalidParameterValue")
+	tpl.Pending = tpl.Statements[2:]
+	tpl.Statements = tpl.Statements[:2]
+	return tpl
+}
+
+func TestResumeTemplate(t *testing.T) {
+	t.Run("Failed and pending statements", func(t *testing.T) {
+		resumed, err := failedRun(t).Resume(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := resumed.ResumeOf, "tpl-1"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		exp := "sub = create subnet cidr=10.0.0.0/24 vpc=vpc-1234\ncreate instance name=web subnet=$sub"
+		if got, want := resumed.String(), exp; got != want {
+			t.Fatalf("got: %s\nwant: %s\n", got, want)
+		}
+		for _, cmd := range resumed.CommandNodesIterator() {
+			if cmd.CmdErr != nil || cmd.CmdResult != nil {
+				t.Fatalf("expected statement reset, got %#v", cmd)
+			}
+		}
+	})
+
+	t.Run("Override params of failed statement", func(t *testing.T) {
+		resumed, err := failedRun(t).Resume(map[string]interface{}{"cidr": "10.0.1.0/24"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		exp := "sub = create subnet cidr=10.0.1.0/24 vpc=vpc-1234\ncreate instance name=web subnet=$sub"
+		if got, want := resumed.String(), exp; got != want {
+			t.Fatalf("got: %s\nwant: %s\n", got, want)
+		}
+	})
+
+	t.Run("Nothing to resume", func(t *testing.T) {
+		tpl := MustParse("create vpc cidr=10.0.0.0/16")
+		for _, cmd := range tpl.CommandNodesIterator() {
+			cmd.CmdResult = "vpc-1234"
+		}
+		if _, err := tpl.Resume(nil); err == nil {
+			t.Fatal("expected error got none")
+		}
+	})
+
+	t.Run("Override without failed statement", func(t *testing.T) {
+		tpl := failedRun(t)
+		tpl.Statements = tpl.Statements[:1]
+		if _, err := tpl.Resume(map[string]interface{}{"name": "db"}); err == nil {
+			t.Fatal("expected error got none")
+		}
+	})
+
+	t.Run("Resume after storage", func(t *testing.T) {
+		b, err := json.Marshal(failedRun(t))
+		if err != nil {
+			t.Fatal(err)
+		}
+		stored := &Template{}
+		if err = json.Unmarshal(b, stored); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := len(stored.Pending), 1; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		resumed, err := stored.Resume(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		exp := "sub = create subnet cidr=10.0.0.0/24 vpc=vpc-1234\ncreate instance name=web subnet=$sub"
+		if got, want := resumed.String(), exp; got != want {
+			t.Fatalf("got: %s\nwant: %s\n", got, want)
+		}
+	})
+}
+
+func TestMergeRuns(t *testing.T) {
+	first := failedRun(t)
+	second := MustParse("create subnet cidr=10.0.0.0/24 vpc=vpc-1234")
+	second.ID = "tpl-2"
+
+	merged := MergeRuns(first, second)
+	if got, want := merged.ID, "tpl-1"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := len(merged.Statements), 3; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+}
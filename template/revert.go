@@ -7,60 +7,19 @@ import (
 	"github.com/wallix/awless/template/internal/ast"
 )
 
-func (te *Template) Revert() (*Template, error) {
+// Revert returns the template undoing, in reverse order, the successful
+// commands of the template according to the revert rules of their definitions
+func (te *Template) Revert(lookup DefinitionLookupFunc) (*Template, error) {
 	var lines []string
 
 	for _, cmd := range te.CmdNodesReverseIterator() {
-		if isRevertible(cmd) {
-			var revertAction string
-			var params []string
-
-			switch cmd.Action {
-			case "create":
-				revertAction = "delete"
-			case "start":
-				revertAction = "stop"
-			case "stop":
-				revertAction = "start"
-			case "detach":
-				revertAction = "attach"
-			case "attach":
-				revertAction = "detach"
-			case "delete":
-				revertAction = "create"
-			}
-
-			switch cmd.Action {
-			case "start", "stop", "attach", "detach":
-				for k, v := range cmd.Params {
-					params = append(params, fmt.Sprintf("%s=%v", k, v))
-				}
-			case "create":
-				switch cmd.Entity {
-				case "record", "tag":
-					for k, v := range cmd.Params {
-						params = append(params, fmt.Sprintf("%s=%v", k, v))
-					}
-				case "database":
-					params = append(params, fmt.Sprintf("id=%s", cmd.CmdResult))
-					params = append(params, "skipsnapshot=true")
-				default:
-					params = append(params, fmt.Sprintf("id=%s", cmd.CmdResult))
-				}
-			case "delete":
-				switch cmd.Entity {
-				case "record":
-					for k, v := range cmd.Params {
-						params = append(params, fmt.Sprintf("%s=%v", k, v))
-					}
-				}
-			}
-
-			lines = append(lines, fmt.Sprintf("%s %s %s", revertAction, cmd.Entity, strings.Join(params, " ")))
-
-			if cmd.Action == "create" && cmd.Entity == "instance" {
-				lines = append(lines, fmt.Sprintf("check instance id=%s state=terminated timeout=180", cmd.CmdResult))
-			}
+		inverse, check := revertCommand(cmd, lookup)
+		if inverse == nil {
+			continue
+		}
+		lines = append(lines, inverse.String())
+		if check != nil {
+			lines = append(lines, check.String())
 		}
 	}
 
@@ -73,33 +32,101 @@ func (te *Template) Revert() (*Template, error) {
 	return tpl, nil
 }
 
-func IsRevertible(t *Template) bool {
+func IsRevertible(t *Template, lookup DefinitionLookupFunc) bool {
 	revertible := false
 	t.visitCommandNodes(func(cmd *ast.CommandNode) {
-		if isRevertible(cmd) {
+		if isRevertible(cmd, lookup) {
 			revertible = true
 		}
 	})
 	return revertible
 }
 
-func isRevertible(cmd *ast.CommandNode) bool {
-	if cmd.CmdErr != nil {
-		return false
+func isRevertible(cmd *ast.CommandNode, lookup DefinitionLookupFunc) bool {
+	inverse, _ := revertCommand(cmd, lookup)
+	return inverse != nil
+}
+
+// revertCommand returns the inverse command of a successful command and,
+// for resources whose deletion is asynchronous, the check waiting for it.
+// It returns nil when the command cannot be reverted, for instance when
+// the inverse command would miss required params.
+func revertCommand(cmd *ast.CommandNode, lookup DefinitionLookupFunc) (inverse *ast.CommandNode, check *ast.CommandNode) {
+	if cmd.CmdErr != nil || lookup == nil {
+		return nil, nil
 	}
+	def, ok := lookup(fmt.Sprintf("%s%s", cmd.Action, cmd.Entity))
+	if !ok || def.Revert == nil {
+		return nil, nil
+	}
+	rule := def.Revert
+
+	inverse = &ast.CommandNode{Action: rule.Action, Entity: cmd.Entity, Params: make(map[string]interface{})}
 
-	if cmd.Action == "check" {
-		return false
+	result, _ := cmd.CmdResult.(string)
+	if rule.ResultParam != "" {
+		if result == "" {
+			return nil, nil
+		}
+		inverse.Params[rule.ResultParam] = result
 	}
-	if cmd.Entity == "record" && (cmd.Action == "create" || cmd.Action == "delete") {
-		return true
+
+	copied := make(map[string]bool)
+	for to, from := range rule.Params {
+		if v, ok := cmd.Params[from]; ok {
+			inverse.Params[to] = v
+		}
+		copied[from] = true
+	}
+	for k, v := range rule.Constants {
+		inverse.Params[k] = v
+	}
+	for k, values := range rule.Inverted {
+		v, ok := cmd.Params[k]
+		if !ok {
+			continue
+		}
+		inverted, ok := values[fmt.Sprint(v)]
+		if !ok {
+			return nil, nil
+		}
+		inverse.Params[k] = inverted
+		copied[k] = true
 	}
 
-	if v, ok := cmd.CmdResult.(string); ok && v != "" {
-		if cmd.Action == "create" || cmd.Action == "start" || cmd.Action == "stop" {
-			return true
+	if rule.Previous != nil {
+		var restored int
+		for k := range cmd.Params {
+			if copied[k] {
+				continue
+			}
+			previous, ok := cmd.CmdPrevious[k]
+			if _, restorable := rule.Previous[k]; !restorable || !ok {
+				return nil, nil
+			}
+			inverse.Params[k] = previous
+			restored++
+		}
+		if restored == 0 {
+			return nil, nil
 		}
 	}
 
-	return cmd.Action == "attach" || cmd.Action == "detach" || cmd.Action == "check" || (cmd.Action == "create" && cmd.Entity == "tag")
+	inverseDef, ok := lookup(fmt.Sprintf("%s%s", inverse.Action, inverse.Entity))
+	if !ok {
+		return nil, nil
+	}
+	for _, required := range inverseDef.Required() {
+		if _, ok := inverse.Params[required]; !ok {
+			return nil, nil
+		}
+	}
+
+	if rule.CheckState != "" && result != "" {
+		check = &ast.CommandNode{Action: "check", Entity: cmd.Entity, Params: map[string]interface{}{
+			"id": result, "state": rule.CheckState, "timeout": rule.CheckTimeout,
+		}}
+	}
+
+	return inverse, check
 }
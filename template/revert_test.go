@@ -2,6 +2,7 @@ package template
 
 import (
 	"errors"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -14,7 +15,7 @@ func TestRevertTemplate(t *testing.T) {
 		for _, cmd := range tpl.CommandNodesIterator() {
 			cmd.CmdResult = "i-54321"
 		}
-		reverted, err := tpl.Revert()
+		reverted, err := tpl.Revert(revertLookup)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -25,6 +26,60 @@ func TestRevertTemplate(t *testing.T) {
 		}
 	})
 
+	t.Run("Template with asynchronous deletion", func(t *testing.T) {
+		tpl := MustParse("create database engine=mysql")
+		for _, cmd := range tpl.CommandNodesIterator() {
+			cmd.CmdResult = "my-db"
+		}
+		reverted, err := tpl.Revert(revertLookup)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		exp := "delete database id=my-db skipsnapshot=true\ncheck database id=my-db state=not-found timeout=900"
+		if got, want := reverted.String(), exp; got != want {
+			t.Fatalf("got: %s\nwant: %s\n", got, want)
+		}
+	})
+
+	t.Run("Template with expanded loop", func(t *testing.T) {
+		tpl := MustParse("for name in web,db {\ncreate user name={name}\n}")
+		tpl, _, err := expandBlocksPass(tpl, NewEnv())
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, cmd := range tpl.CommandNodesIterator() {
+			cmd.CmdResult = "user-" + cmd.Params["name"].(string)
+		}
+		reverted, err := tpl.Revert(revertLookup)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		exp := "delete user name=db\ndelete user name=web"
+		if got, want := reverted.String(), exp; got != want {
+			t.Fatalf("got: %s\nwant: %s\n", got, want)
+		}
+	})
+
+	t.Run("Template with updates", func(t *testing.T) {
+		tpl := MustParse("update securitygroup id=sg-1234 cidr=10.0.0.0/24 protocol=tcp portrange=22 inbound=authorize\nupdate instance id=i-1234 type=t2.small\nupdate instance id=i-5678 lock=true")
+		for i, cmd := range tpl.CommandNodesIterator() {
+			if i == 1 {
+				cmd.CmdPrevious = map[string]interface{}{"type": "t2.micro"}
+			}
+		}
+		reverted, err := tpl.Revert(revertLookup)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		exp := "update instance id=i-1234 type=t2.micro\nupdate securitygroup cidr=10.0.0.0/24 id=sg-1234 inbound=revoke portrange=22 protocol=tcp"
+		if got, want := reverted.String(), exp; got != want {
+			t.Fatalf("got: %s\nwant: %s\n", got, want)
+		}
+	})
+
 	t.Run("More advanced template", func(t *testing.T) {
 		tpl := MustParse("attach policy arn=stuff user=mrT\ncreate vpc\ncreate subnet\nstart instance id=i-54g3hj\ncreate tag key=Key resource=myinst value=Value\ncreate instance")
 		for i, cmd := range tpl.CommandNodesIterator() {
@@ -42,24 +97,45 @@ func TestRevertTemplate(t *testing.T) {
 			}
 		}
 
-		reverted, err := tpl.Revert()
+		reverted, err := tpl.Revert(revertLookup)
 		if err != nil {
 			t.Fatal(err)
 		}
 
-		exp := "delete tag key=Key resource=myinst value=Value\nstop instance id=i-54g3hj\ndelete subnet id=sub-12345\ndelete vpc id=vpc-12345\ndetach policy arn=stuff user=mrT"
+		exp := "delete tag key=Key resource=myinst value=Value\nstop instance id=i-12345\ndelete subnet id=sub-12345\ndelete vpc id=vpc-12345\ndetach policy arn=stuff user=mrT"
 		if got, want := reverted.String(), exp; got != want {
 			t.Fatalf("got: %s\nwant: %s\n", got, want)
 		}
 	})
 
+	t.Run("Reverted teardown runs in order", func(t *testing.T) {
+		tpl := MustParse("create vpc cidr=10.0.0.0/16\ncreate subnet cidr=10.0.0.0/24\ncreate instance type=t2.micro")
+		for i, cmd := range tpl.CommandNodesIterator() {
+			cmd.CmdResult = []string{"vpc-1", "sub-1", "i-1"}[i]
+		}
+		reverted, err := tpl.Revert(revertLookup)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		exp := "delete instance id=i-1\ncheck instance id=i-1 state=terminated timeout=180\ndelete subnet id=sub-1\ndelete vpc id=vpc-1"
+		if got, want := reverted.String(), exp; got != want {
+			t.Fatalf("got: %s\nwant: %s\n", got, want)
+		}
+		if got, want := statementsDependencies(reverted.Statements), [][]int{nil, {0}, {1}, {1, 2}}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+
 }
 
 func TestCmdNodeIsRevertible(t *testing.T) {
+	recordParams := map[string]interface{}{"zone": "Z1", "name": "www", "type": "A", "value": "1.2.3.4", "ttl": 60}
 	tcases := []struct {
-		line, result string
-		err          error
-		revertible   bool
+		line, result     string
+		params, previous map[string]interface{}
+		err              error
+		revertible       bool
 	}{
 		{line: "update vpc", result: "any", revertible: false},
 		{line: "delete vpc", result: "any", revertible: false},
@@ -68,18 +144,54 @@ func TestCmdNodeIsRevertible(t *testing.T) {
 		{line: "start instance", revertible: false},
 		{line: "create vpc", result: "any", revertible: true},
 		{line: "stop instance", result: "any", revertible: true},
-		{line: "attach policy", revertible: true},
-		{line: "detach policy", revertible: true},
-		{line: "create record", revertible: true},
-		{line: "delete record", revertible: true},
+		{line: "attach policy", params: map[string]interface{}{"arn": "arn:1"}, revertible: true},
+		{line: "detach policy", params: map[string]interface{}{"arn": "arn:1"}, revertible: true},
+		{line: "attach policy", revertible: false},
+		{line: "create record", params: recordParams, revertible: true},
+		{line: "delete record", params: recordParams, revertible: true},
+		{line: "create record", params: map[string]interface{}{"zone": "Z1"}, revertible: false},
+		{line: "create user", params: map[string]interface{}{"name": "john"}, revertible: true},
+		{line: "update instance", params: map[string]interface{}{"id": "i-1", "type": "t2.small"}, revertible: false},
+		{line: "update instance", params: map[string]interface{}{"id": "i-1", "type": "t2.small"}, previous: map[string]interface{}{"type": "t2.micro"}, revertible: true},
+		{line: "update instance", params: map[string]interface{}{"id": "i-1", "lock": "true"}, previous: map[string]interface{}{"lock": "false"}, revertible: false},
+		{line: "update securitygroup", params: map[string]interface{}{"id": "sg-1", "inbound": "authorize"}, revertible: true},
+		{line: "update securitygroup", params: map[string]interface{}{"id": "sg-1", "inbound": "unknown"}, revertible: false},
 	}
 
 	for _, tc := range tcases {
 		splits := strings.SplitN(tc.line, " ", 2)
 		action, entity := splits[0], splits[1]
-		cmd := &ast.CommandNode{Action: action, Entity: entity, CmdResult: tc.result, CmdErr: tc.err}
-		if tc.revertible != isRevertible(cmd) {
+		cmd := &ast.CommandNode{Action: action, Entity: entity, Params: tc.params, CmdResult: tc.result, CmdErr: tc.err, CmdPrevious: tc.previous}
+		if tc.revertible != isRevertible(cmd, revertLookup) {
 			t.Fatalf("expected '%s' to have revertible=%t", cmd, tc.revertible)
 		}
 	}
 }
+
+var revertDefs = map[string]Definition{
+	"createvpc":           {Action: "create", Entity: "vpc", Revert: &RevertRule{Action: "delete", ResultParam: "id"}},
+	"deletevpc":           {Action: "delete", Entity: "vpc", RequiredParams: []string{"id"}},
+	"createsubnet":        {Action: "create", Entity: "subnet", Revert: &RevertRule{Action: "delete", ResultParam: "id"}},
+	"deletesubnet":        {Action: "delete", Entity: "subnet", RequiredParams: []string{"id"}},
+	"createinstance":      {Action: "create", Entity: "instance", Revert: &RevertRule{Action: "delete", ResultParam: "id", CheckState: "terminated", CheckTimeout: 180}},
+	"updateinstance":      {Action: "update", Entity: "instance", RequiredParams: []string{"id"}, Revert: &RevertRule{Action: "update", Params: map[string]string{"id": "id"}, Previous: map[string]string{"type": "Type"}}},
+	"deleteinstance":      {Action: "delete", Entity: "instance", RequiredParams: []string{"id"}},
+	"startinstance":       {Action: "start", Entity: "instance", Revert: &RevertRule{Action: "stop", ResultParam: "id"}},
+	"stopinstance":        {Action: "stop", Entity: "instance", Revert: &RevertRule{Action: "start", ResultParam: "id"}},
+	"updatesecuritygroup": {Action: "update", Entity: "securitygroup", RequiredParams: []string{"id"}, Revert: &RevertRule{Action: "update", Params: map[string]string{"id": "id", "cidr": "cidr", "protocol": "protocol", "portrange": "portrange"}, Inverted: map[string]map[string]string{"inbound": {"authorize": "revoke", "revoke": "authorize"}}}},
+	"createdatabase":      {Action: "create", Entity: "database", Revert: &RevertRule{Action: "delete", ResultParam: "id", Constants: map[string]string{"skipsnapshot": "true"}, CheckState: "not-found", CheckTimeout: 900}},
+	"deletedatabase":      {Action: "delete", Entity: "database", RequiredParams: []string{"id"}},
+	"createuser":          {Action: "create", Entity: "user", Revert: &RevertRule{Action: "delete", Params: map[string]string{"name": "name"}}},
+	"deleteuser":          {Action: "delete", Entity: "user", RequiredParams: []string{"name"}},
+	"attachpolicy":        {Action: "attach", Entity: "policy", Revert: &RevertRule{Action: "detach", Params: map[string]string{"arn": "arn", "user": "user", "group": "group"}}},
+	"detachpolicy":        {Action: "detach", Entity: "policy", RequiredParams: []string{"arn"}, Revert: &RevertRule{Action: "attach", Params: map[string]string{"arn": "arn", "user": "user", "group": "group"}}},
+	"createtag":           {Action: "create", Entity: "tag", Revert: &RevertRule{Action: "delete", Params: map[string]string{"key": "key", "resource": "resource", "value": "value"}}},
+	"deletetag":           {Action: "delete", Entity: "tag", RequiredParams: []string{"key", "resource", "value"}},
+	"createrecord":        {Action: "create", Entity: "record", Revert: &RevertRule{Action: "delete", Params: map[string]string{"zone": "zone", "name": "name", "type": "type", "value": "value", "ttl": "ttl"}}},
+	"deleterecord":        {Action: "delete", Entity: "record", RequiredParams: []string{"zone", "name", "type", "value", "ttl"}, Revert: &RevertRule{Action: "create", Params: map[string]string{"zone": "zone", "name": "name", "type": "type", "value": "value", "ttl": "ttl"}}},
+}
+
+func revertLookup(key string) (Definition, bool) {
+	def, ok := revertDefs[key]
+	return def, ok
+}
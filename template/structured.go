@@ -0,0 +1,283 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/wallix/awless/template/internal/ast"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Formats of template files
+const (
+	DSLFormat  = "awless"
+	JSONFormat = "json"
+	YAMLFormat = "yaml"
+)
+
+var identifierRegex = regexp.MustCompile(`^[a-zA-Z0-9-_.]+$`)
+
+// structuredTemplate is the JSON/YAML representation of a template, ex:
+//   {"statements": [
+//     {"ident": "vpc", "action": "create", "entity": "vpc", "params": {"cidr": "10.0.0.0/16"}},
+//     {"action": "create", "entity": "subnet", "refs": {"vpc": "vpc"}, "holes": {"cidr": "subnet.cidr"}}
+//   ]}
+// String params are read as the content of quoted values, so that holes and
+// references can be interpolated (ex: "web-{env}"), and '\', '{' and '$' are
+// escaped with '\'. Holes can be declared with their spec (ex: "count:int=1").
+type structuredTemplate struct {
+	Statements []*structuredStatement `json:"statements" yaml:"statements"`
+}
+
+type structuredStatement struct {
+	Ident  string                 `json:"ident,omitempty" yaml:"ident,omitempty"`
+	Action string                 `json:"action" yaml:"action"`
+	Entity string                 `json:"entity" yaml:"entity"`
+	Params map[string]interface{} `json:"params,omitempty" yaml:"params,omitempty"`
+	Refs   map[string]string      `json:"refs,omitempty" yaml:"refs,omitempty"`
+	Holes  map[string]string      `json:"holes,omitempty" yaml:"holes,omitempty"`
+}
+
+// ParseFile parses a template from the content of a file. The format is
+// deduced from the file extension: JSON (.json), YAML (.yml, .yaml) or the
+// awless template syntax otherwise, unless the content is a JSON document
+// or a YAML document of statements.
+func ParseFile(name string, content []byte) (*Template, error) {
+	switch DetectFormat(name, content) {
+	case JSONFormat:
+		return ParseJSON(content)
+	case YAMLFormat:
+		return ParseYAML(content)
+	default:
+		return Parse(string(content))
+	}
+}
+
+// DetectFormat returns the format of a template file given its name and content
+func DetectFormat(name string, content []byte) string {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".json":
+		return JSONFormat
+	case ".yml", ".yaml":
+		return YAMLFormat
+	}
+	trimmed := strings.TrimSpace(string(content))
+	switch {
+	case strings.HasPrefix(trimmed, "{"):
+		return JSONFormat
+	case strings.HasPrefix(trimmed, "---"), strings.HasPrefix(trimmed, "statements:"):
+		return YAMLFormat
+	default:
+		return DSLFormat
+	}
+}
+
+// ParseJSON parses a template from its JSON representation
+func ParseJSON(content []byte) (*Template, error) {
+	var doc structuredTemplate
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("json template: %s", err)
+	}
+
+	return doc.template()
+}
+
+// ParseYAML parses a template from its YAML representation
+func ParseYAML(content []byte) (*Template, error) {
+	var doc structuredTemplate
+	if err := yaml.UnmarshalStrict(content, &doc); err != nil {
+		return nil, fmt.Errorf("yaml template: %s", err)
+	}
+
+	return doc.template()
+}
+
+func (doc *structuredTemplate) template() (*Template, error) {
+	if len(doc.Statements) == 0 {
+		return nil, errors.New("empty template")
+	}
+
+	tpl := &Template{AST: &ast.AST{}}
+	for i, sts := range doc.Statements {
+		if sts == nil {
+			return nil, fmt.Errorf("statement %d: empty statement", i+1)
+		}
+		node, err := sts.node()
+		if err != nil {
+			return nil, fmt.Errorf("statement %d: %s", i+1, err)
+		}
+		tpl.Statements = append(tpl.Statements, &ast.Statement{Node: node})
+	}
+
+	return tpl, nil
+}
+
+func (sts *structuredStatement) node() (ast.Node, error) {
+	// holes and references are parsed from their template syntax, to share its validation
+	var text bytes.Buffer
+	fmt.Fprintf(&text, "%s %s", sts.Action, sts.Entity)
+	for k, ref := range sts.Refs {
+		fmt.Fprintf(&text, " %s=$%s", k, strings.TrimPrefix(ref, "$"))
+	}
+	for k, hole := range sts.Holes {
+		fmt.Fprintf(&text, " %s={%s}", k, strings.Trim(hole, "{}"))
+	}
+	parsed, err := Parse(text.String())
+	if err != nil {
+		return nil, err
+	}
+	if len(parsed.Statements) != 1 {
+		return nil, fmt.Errorf("invalid statement '%s'", strings.TrimSpace(text.String()))
+	}
+	cmd, ok := parsed.Statements[0].Node.(*ast.CommandNode)
+	if !ok {
+		return nil, fmt.Errorf("invalid statement '%s'", strings.TrimSpace(text.String()))
+	}
+	if cmd.Params == nil {
+		cmd.Params, cmd.Refs, cmd.Holes = make(map[string]interface{}), make(map[string]string), make(map[string]string)
+	}
+
+	for k, v := range sts.Params {
+		if !identifierRegex.MatchString(k) {
+			return nil, fmt.Errorf("%s %s: invalid param key '%s'", sts.Action, sts.Entity, k)
+		}
+		if _, ok := cmd.Refs[k]; ok {
+			return nil, fmt.Errorf("%s %s: param '%s' is also a reference", sts.Action, sts.Entity, k)
+		}
+		if _, ok := cmd.Holes[k]; ok {
+			return nil, fmt.Errorf("%s %s: param '%s' is also a hole", sts.Action, sts.Entity, k)
+		}
+		switch v.(type) {
+		case string:
+			if err := cmd.AddQuotedParam(k, v.(string)); err != nil {
+				return nil, fmt.Errorf("%s %s: param '%s': %s", sts.Action, sts.Entity, k, err)
+			}
+		case json.Number:
+			if i, err := strconv.Atoi(v.(json.Number).String()); err == nil {
+				cmd.Params[k] = i
+			} else {
+				cmd.Params[k] = v.(json.Number).String()
+			}
+		case int:
+			cmd.Params[k] = v
+		case float64:
+			cmd.Params[k] = strconv.FormatFloat(v.(float64), 'f', -1, 64)
+		case bool:
+			cmd.Params[k] = strconv.FormatBool(v.(bool))
+		case []interface{}:
+			list := []string{}
+			for _, elem := range v.([]interface{}) {
+				switch elem.(type) {
+				case map[string]interface{}, map[interface{}]interface{}, []interface{}, nil:
+					return nil, fmt.Errorf("%s %s: param '%s': expected a list of values", sts.Action, sts.Entity, k)
+				}
+				list = append(list, fmt.Sprint(elem))
+			}
+			cmd.Params[k] = list
+		default:
+			return nil, fmt.Errorf("%s %s: param '%s': unsupported value %v", sts.Action, sts.Entity, k, v)
+		}
+	}
+
+	if sts.Ident != "" {
+		if !identifierRegex.MatchString(sts.Ident) {
+			return nil, fmt.Errorf("%s %s: invalid ident '%s'", sts.Action, sts.Entity, sts.Ident)
+		}
+		return &ast.DeclarationNode{Ident: sts.Ident, Expr: cmd}, nil
+	}
+	return cmd, nil
+}
+
+// EncodeJSON returns the JSON representation of the template
+func (s *Template) EncodeJSON() ([]byte, error) {
+	doc, err := s.structured()
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+// EncodeYAML returns the YAML representation of the template
+func (s *Template) EncodeYAML() ([]byte, error) {
+	doc, err := s.structured()
+	if err != nil {
+		return nil, err
+	}
+
+	return yaml.Marshal(doc)
+}
+
+func (s *Template) structured() (*structuredTemplate, error) {
+	doc := &structuredTemplate{}
+	for _, sts := range s.Statements {
+		var ident string
+		var cmd *ast.CommandNode
+		switch sts.Node.(type) {
+		case *ast.CommandNode:
+			cmd = sts.Node.(*ast.CommandNode)
+		case *ast.DeclarationNode:
+			decl := sts.Node.(*ast.DeclarationNode)
+			if expr, ok := decl.Expr.(*ast.CommandNode); ok {
+				ident, cmd = decl.Ident, expr
+			}
+		}
+		if cmd == nil {
+			return nil, fmt.Errorf("cannot convert statement '%s': only commands and declarations are supported", strings.SplitN(sts.String(), "\n", 2)[0])
+		}
+
+		structured := &structuredStatement{Ident: ident, Action: cmd.Action, Entity: cmd.Entity}
+		for k, v := range cmd.Params {
+			if structured.Params == nil {
+				structured.Params = make(map[string]interface{})
+			}
+			switch v.(type) {
+			case nil:
+				continue
+			case int, []string:
+				structured.Params[k] = v
+			default:
+				structured.Params[k] = ast.QuotedContent(v)
+			}
+		}
+		for k, v := range cmd.Refs {
+			if structured.Refs == nil {
+				structured.Refs = make(map[string]string)
+			}
+			structured.Refs[k] = v
+		}
+		for k := range cmd.Holes {
+			if structured.Holes == nil {
+				structured.Holes = make(map[string]string)
+			}
+			structured.Holes[k] = cmd.HoleString(k)
+		}
+		doc.Statements = append(doc.Statements, structured)
+	}
+
+	return doc, nil
+}
@@ -0,0 +1,164 @@
+package template
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseStructuredTemplates(t *testing.T) {
+	exp := MustParse("vpc = create vpc cidr=10.0.0.0/16 name=\"my vpc\"\ncreate subnet cidr={subnet.cidr:cidr=10.0.0.0/24} count=2 groups=sg-1,sg-2 name=\"sub-{env}\" vpc=$vpc")
+
+	jsonDoc := `{"statements": [
+  {"ident": "vpc", "action": "create", "entity": "vpc", "params": {"cidr": "10.0.0.0/16", "name": "my vpc"}},
+  {"action": "create", "entity": "subnet",
+   "params": {"count": 2, "groups": ["sg-1", "sg-2"], "name": "sub-{env}"},
+   "refs": {"vpc": "vpc"},
+   "holes": {"cidr": "subnet.cidr:cidr=10.0.0.0/24"}}
+]}`
+	yamlDoc := `# my infra
+statements:
+- ident: vpc
+  action: create
+  entity: vpc
+  params:
+    cidr: 10.0.0.0/16
+    name: "my vpc"
+- action: create
+  entity: subnet
+  params:
+    count: 2
+    groups: [sg-1, sg-2]
+    name: sub-{env}
+  refs:
+    vpc: $vpc
+  holes:
+    cidr: subnet.cidr:cidr=10.0.0.0/24
+`
+
+	for name, content := range map[string]string{"infra.json": jsonDoc, "infra.yml": yamlDoc, "infra": jsonDoc} {
+		tpl, err := ParseFile(name, []byte(content))
+		if err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+		if got, want := tpl.String(), exp.String(); got != want {
+			t.Fatalf("%s: got\n%s\nwant\n%s", name, got, want)
+		}
+		if !tpl.IsSameAs(exp) {
+			t.Fatalf("%s: expected same template as parsed from template syntax", name)
+		}
+	}
+
+	tcases := []struct {
+		doc, expErr string
+	}{
+		{doc: `{"statements": []}`, expErr: "empty template"},
+		{doc: `{"statements": [{"action": "create", "entity": "vpc", "parms": {}}]}`, expErr: "unknown field"},
+		{doc: `{"statements": [{"action": "creat", "entity": "vpc"}]}`, expErr: "statement 1: unknown action 'creat'"},
+		{doc: `{"statements": [{"action": "create", "entity": "vpc", "params": {"name": {"a": "b"}}}]}`, expErr: "param 'name': unsupported value"},
+		{doc: `{"statements": [{"action": "create", "entity": "vpc", "params": {"vpc": "a"}, "refs": {"vpc": "b"}}]}`, expErr: "param 'vpc' is also a reference"},
+		{doc: `{"statements": [{"ident": "my vpc", "action": "create", "entity": "vpc"}]}`, expErr: "invalid ident 'my vpc'"},
+	}
+	for i, tcase := range tcases {
+		if _, err := ParseJSON([]byte(tcase.doc)); err == nil || !strings.Contains(err.Error(), tcase.expErr) {
+			t.Fatalf("%d: got %v, want error containing %s", i+1, err, tcase.expErr)
+		}
+	}
+}
+
+func TestEncodeStructuredTemplates(t *testing.T) {
+	tpl := MustParse("vpc = create vpc cidr=10.0.0.0/16 name=\"my vpc\"\ncreate subnet cidr={subnet.cidr:cidr=10.0.0.0/24} count=2 groups=sg-1,sg-2 name=\"sub-{env}\" vpc=$vpc\ncreate tag key=a value=\"10\"")
+
+	b, err := tpl.EncodeYAML()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expYAML := `statements:
+- ident: vpc
+  action: create
+  entity: vpc
+  params:
+    cidr: 10.0.0.0/16
+    name: my vpc
+- action: create
+  entity: subnet
+  params:
+    count: 2
+    groups:
+    - sg-1
+    - sg-2
+    name: sub-{env}
+  refs:
+    vpc: vpc
+  holes:
+    cidr: subnet.cidr:cidr=10.0.0.0/24
+- action: create
+  entity: tag
+  params:
+    key: a
+    value: "10"
+`
+	if got, want := string(b), expYAML; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+
+	decoded, err := ParseYAML(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.IsSameAs(tpl) {
+		t.Fatalf("got %s, want %s", decoded, tpl)
+	}
+
+	b, err = tpl.EncodeJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err = ParseJSON(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.IsSameAs(tpl) {
+		t.Fatalf("got %s, want %s", decoded, tpl)
+	}
+
+	if _, err = MustParse("for i in 1,2 {\ncreate vpc cidr=10.0.{i}.0/24\n}").EncodeJSON(); err == nil {
+		t.Fatal("expected error got none")
+	}
+}
+
+func TestParseYAMLTemplates(t *testing.T) {
+	exp := MustParse("create instance count=1 name=web subnet=sub-1 userdata=\"#!/bin/bash\necho hi\n\"\ncreate vpc cidr=10.0.0.0/16 name=\"my vpc\"")
+
+	yamlDoc := `statements:
+- action: create
+  entity: instance
+  params:
+    count: 1
+    name: web
+    subnet: sub-1
+    userdata: |
+      #!/bin/bash
+      echo hi
+- {action: create, entity: vpc, params: {cidr: 10.0.0.0/16, name: my vpc}}
+`
+	tpl, err := ParseYAML([]byte(yamlDoc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := tpl.String(), exp.String(); got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+
+	tcases := []struct {
+		doc, expErr string
+	}{
+		{doc: "statements:\n- {action: create, entity: vpc, parms: {}}\n", expErr: "field parms not found"},
+		{doc: "statements:\n- action: create\n  entity: vpc\n  params:\n    name: {a: b}\n", expErr: "param 'name': unsupported value"},
+		{doc: "- action: create\n", expErr: "yaml template: yaml: unmarshal errors"},
+	}
+	for i, tcase := range tcases {
+		if _, err := ParseYAML([]byte(tcase.doc)); err == nil || !strings.Contains(err.Error(), tcase.expErr) {
+			t.Fatalf("%d: got %v, want error containing %s", i+1, err, tcase.expErr)
+		}
+	}
+}
@@ -17,8 +17,12 @@ limitations under the License.
 package template
 
 import (
+	"context"
 	"crypto/rand"
+	"errors"
 	"fmt"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/oklog/ulid"
@@ -28,58 +32,263 @@ import (
 
 type Template struct {
 	ID string
+
+	// RollbackOf is the ID of the failed template this template rolled back
+	RollbackOf string
+	// RollbackID is the ID of the template that rolled back this template
+	RollbackID string
+	// ResumeOf is the ID of the failed template this template resumed
+	ResumeOf string
+	// ResumedBy is the ID of the template that resumed this template
+	ResumedBy string
+
+	// Pending are the statements not run because of a failure
+	Pending []*ast.Statement
+
+	// Hooks are called on the events of the runs of the template
+	Hooks []Hook
+
 	*ast.AST
 }
 
-func (s *Template) Run(d driver.Driver) (*Template, error) {
-	vars := map[string]interface{}{}
+// ErrCancelled is the error of the pending statements of a cancelled run
+var ErrCancelled = errors.New("cancelled")
 
+func (s *Template) Run(ctx context.Context, d driver.Driver) (*Template, error) {
+	return s.RunConcurrently(ctx, d, 1)
+}
+
+// RunConcurrently runs the template statements with at most `concurrency`
+// driver calls in flight. A statement only starts once all the statements
+// it depends on (see statementsDependencies) have successfully completed.
+// On failure, no new statement is started and the returned template holds
+// the statements that have been run, in their original order.
+// When the context is cancelled (ex: on interruption or expired timeout),
+// no new statement is started either, the running ones are waited for and
+// the pending ones are marked with ErrCancelled.
+func (s *Template) RunConcurrently(ctx context.Context, d driver.Driver, concurrency int) (*Template, error) {
+	return s.run(ctx, d, concurrency, false)
+}
+
+func (s *Template) run(ctx context.Context, d driver.Driver, concurrency int, dryRun bool) (*Template, error) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	start := time.Now()
 	current := &Template{AST: &ast.AST{}}
-	current.ID = ulid.MustNew(ulid.Timestamp(time.Now()), rand.Reader).String()
+	current.ID = ulid.MustNew(ulid.Timestamp(start), rand.Reader).String()
 
-	for _, sts := range s.Statements {
-		clone := sts.Clone()
-		current.Statements = append(current.Statements, clone)
-		switch clone.Node.(type) {
-		case *ast.CommandNode:
-			cmd := clone.Node.(*ast.CommandNode)
-			fn, err := d.Lookup(cmd.Action, cmd.Entity)
-			if err != nil {
-				return current, err
+	clones := make([]*ast.Statement, len(s.Statements))
+	for i, sts := range s.Statements {
+		clones[i] = sts.Clone()
+	}
+	deps := statementsDependencies(clones)
+
+	vars := &runVars{values: make(map[string]interface{}), sensitive: make(map[string]bool), dryRun: dryRun}
+	if !dryRun {
+		vars.hooks = s.Hooks
+		if err := fireEvent(ctx, s.Hooks, &Event{Type: BeforeRun, TemplateID: current.ID, Template: s, Start: start}); err != nil {
+			current.Pending = clones
+			return current, &VetoError{Event: BeforeRun, Err: err}
+		}
+	}
+	started := make([]bool, len(clones))
+	done := make([]bool, len(clones))
+	errs := make([]error, len(clones))
+	finished := make(chan int)
+
+	isReady := func(i int) bool {
+		for _, dep := range deps[i] {
+			if !done[dep] {
+				return false
 			}
-			cmd.ProcessRefs(vars)
+		}
+		return true
+	}
 
-			if cmd.CmdResult, cmd.CmdErr = fn(cmd.Params); cmd.CmdErr != nil {
-				return current, cmd.CmdErr
+	var running int
+	var failed bool
+	for {
+		for i := 0; !failed && ctx.Err() == nil && i < len(clones) && running < concurrency; i++ {
+			if started[i] || !isReady(i) {
+				continue
 			}
-		case *ast.DeclarationNode:
-			ident := clone.Node.(*ast.DeclarationNode).Ident
-			expr := clone.Node.(*ast.DeclarationNode).Expr
-			switch expr.(type) {
-			case *ast.CommandNode:
-				cmd := expr.(*ast.CommandNode)
-				fn, err := d.Lookup(cmd.Action, cmd.Entity)
-				if err != nil {
-					return current, err
-				}
-				cmd.ProcessRefs(vars)
-
-				if cmd.CmdResult, cmd.CmdErr = fn(cmd.Params); cmd.CmdErr != nil {
-					return current, cmd.CmdErr
-				}
-				vars[ident] = cmd.CmdResult
+			started[i] = true
+			running++
+			go func(i int) {
+				errs[i] = runStatement(ctx, current.ID, clones[i], d, vars)
+				finished <- i
+			}(i)
+		}
+		if running == 0 {
+			break
+		}
+		i := <-finished
+		running--
+		done[i] = true
+		if errs[i] != nil {
+			failed = true
+		}
+	}
+
+	for i, sts := range clones {
+		if started[i] {
+			current.Statements = append(current.Statements, sts)
+		} else {
+			current.Pending = append(current.Pending, sts)
+		}
+	}
+	var err error
+	for _, e := range errs {
+		if e != nil {
+			err = e
+			break
+		}
+	}
+	if err == nil && ctx.Err() != nil && len(current.Pending) > 0 {
+		for _, sts := range current.Pending {
+			if cmd := commandNode(sts); cmd != nil {
+				cmd.CmdErr = ErrCancelled
 			}
 		}
+		err = ctx.Err()
+	}
+
+	if !dryRun {
+		fireEvent(context.Background(), s.Hooks, &Event{Type: AfterRun, TemplateID: current.ID, Template: current, Err: err, Start: start, Duration: time.Since(start)})
+	}
+
+	return current, err
+}
+
+type runVars struct {
+	mu        sync.Mutex
+	values    map[string]interface{}
+	sensitive map[string]bool
+	dryRun    bool
+	hooks     []Hook
+}
+
+func runStatement(ctx context.Context, templateID string, sts *ast.Statement, d driver.Driver, vars *runVars) error {
+	var ident string
+	var cmd *ast.CommandNode
+
+	switch n := sts.Node.(type) {
+	case *ast.CommandNode:
+		cmd = n
+	case *ast.DeclarationNode:
+		expr, ok := n.Expr.(*ast.CommandNode)
+		if !ok {
+			return nil
+		}
+		ident, cmd = n.Ident, expr
+	default:
+		return nil
+	}
+
+	fn, err := d.Lookup(cmd.Action, cmd.Entity)
+	if err != nil {
+		return err
+	}
+
+	vars.mu.Lock()
+	for _, ref := range cmd.UsedRefs() {
+		if _, ok := vars.values[ref]; ok {
+			continue
+		}
+		decl, prop := splitRefProperty(ref, func(r string) bool { _, ok := vars.values[r]; return ok })
+		switch {
+		case prop == "":
+		case vars.dryRun:
+			vars.values[ref] = fmt.Sprintf("dryrun-%s-%s", decl, prop)
+		default:
+			vars.mu.Unlock()
+			cmd.CmdErr = fmt.Errorf("%s %s: no property '%s' in outputs of '%s'", cmd.Action, cmd.Entity, prop, decl)
+			return cmd.CmdErr
+		}
+	}
+	cmd.CmdSensitive = cmd.KeysUsingRefs(func(ref string) bool { return vars.sensitive[ref] })
+	cmd.ProcessRefs(vars.values)
+	vars.mu.Unlock()
+
+	start := time.Now()
+	if err := fireEvent(ctx, vars.hooks, &Event{Type: BeforeStatement, TemplateID: templateID, Ident: ident, Command: cmd, Start: start}); err != nil {
+		cmd.CmdErr = &VetoError{Event: BeforeStatement, Err: err}
+		return cmd.CmdErr
+	}
+
+	result, err := fn(ctx, cmd.Params)
+	var sensitive map[string]interface{}
+	if out, ok := result.(*driver.Output); ok {
+		cmd.CmdResult, cmd.CmdOutputs, cmd.CmdAttempts = out.ID, out.Properties, out.Attempts
+		sensitive = out.Sensitive
+	} else {
+		cmd.CmdResult = result
+	}
+	if retryErr, ok := err.(*driver.RetryError); ok {
+		cmd.CmdAttempts = retryErr.Attempts
+	}
+	cmd.CmdErr = err
+	fireEvent(ctx, vars.hooks, &Event{Type: AfterStatement, TemplateID: templateID, Ident: ident, Command: cmd, Result: cmd.CmdResult, Err: err, Start: start, Duration: time.Since(start)})
+	if cmd.CmdErr != nil {
+		return cmd.CmdErr
+	}
+
+	if ident != "" {
+		vars.mu.Lock()
+		vars.values[ident] = cmd.CmdResult
+		for prop, v := range cmd.CmdOutputs {
+			vars.values[ident+"."+prop] = v
+		}
+		for prop, v := range sensitive {
+			vars.values[ident+"."+prop] = v
+			vars.sensitive[ident+"."+prop] = true
+		}
+		vars.mu.Unlock()
 	}
 
-	return current, nil
+	return nil
+}
+
+// splitRefProperty splits a reference into its declaration and the property
+// accessed on the declaration outputs (ex: $inst.PrivateIP). The declaration
+// is the longest prefix of the reference being declared. An empty property
+// is returned when the reference is a declaration or none is found.
+func splitRefProperty(ref string, declared func(string) bool) (string, string) {
+	if declared(ref) {
+		return ref, ""
+	}
+	for i := strings.LastIndex(ref, "."); i > 0; i = strings.LastIndex(ref[:i], ".") {
+		if declared(ref[:i]) {
+			return ref[:i], ref[i+1:]
+		}
+	}
+	return ref, ""
+}
+
+// Outputs returns the results of the declarations of a run template along
+// with their named properties (ex: inst and inst.PrivateIP)
+func (s *Template) Outputs() map[string]interface{} {
+	outputs := make(map[string]interface{})
+	s.visitCommandDeclarationNodes(func(decl *ast.DeclarationNode) {
+		cmd, ok := decl.Expr.(*ast.CommandNode)
+		if !ok || cmd.CmdErr != nil || cmd.CmdResult == nil {
+			return
+		}
+		outputs[decl.Ident] = cmd.CmdResult
+		for prop, v := range cmd.CmdOutputs {
+			outputs[decl.Ident+"."+prop] = v
+		}
+	})
+	return outputs
 }
 
 func (s *Template) DryRun(d driver.Driver) error {
 	defer d.SetDryRun(false)
 	d.SetDryRun(true)
 
-	_, err := s.Run(d)
+	_, err := s.run(context.Background(), d, 1, true)
 	return err
 }
 
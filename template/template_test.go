@@ -17,10 +17,15 @@ limitations under the License.
 package template
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/wallix/awless/logger"
 	"github.com/wallix/awless/template/driver"
@@ -30,7 +35,7 @@ import (
 type noopDriver struct{}
 
 func (d *noopDriver) Lookup(lookups ...string) (driver.DriverFn, error) {
-	return func(map[string]interface{}) (interface{}, error) { return nil, nil }, nil
+	return func(context.Context, map[string]interface{}) (interface{}, error) { return nil, nil }, nil
 }
 func (d *noopDriver) SetLogger(*logger.Logger) {}
 func (d *noopDriver) SetDryRun(bool)           {}
@@ -40,7 +45,7 @@ type errorDriver struct {
 }
 
 func (d *errorDriver) Lookup(lookups ...string) (driver.DriverFn, error) {
-	return func(map[string]interface{}) (interface{}, error) { return nil, d.err }, nil
+	return func(context.Context, map[string]interface{}) (interface{}, error) { return nil, d.err }, nil
 }
 func (d *errorDriver) SetLogger(*logger.Logger) {}
 func (d *errorDriver) SetDryRun(bool)           {}
@@ -81,7 +86,7 @@ func TestRunDriverReportsInStatement(t *testing.T) {
 		if err != nil {
 			t.Fatal(err)
 		}
-		ran, _ := templ.Run(tcase.driver)
+		ran, _ := templ.Run(context.Background(), tcase.driver)
 
 		for i, cmd := range ran.CommandNodesIterator() {
 			if got, want := cmd.String(), tcase.lines[i].expString; got != want {
@@ -145,7 +150,7 @@ func TestRunDriverOnTemplate(t *testing.T) {
 		},
 		}
 
-		if _, err := s.Run(mDriver); err != nil {
+		if _, err := s.Run(context.Background(), mDriver); err != nil {
 			t.Fatal(err)
 		}
 		if err := mDriver.lookupsCalled(); err != nil {
@@ -165,7 +170,7 @@ func TestRunDriverOnTemplate(t *testing.T) {
 		},
 		}
 
-		if _, err := s.Run(mDriver); err != nil {
+		if _, err := s.Run(context.Background(), mDriver); err != nil {
 			t.Fatal(err)
 		}
 		if err := mDriver.lookupsCalled(); err != nil {
@@ -188,7 +193,7 @@ func TestRunDriverOnTemplate(t *testing.T) {
 		}},
 		}
 
-		if _, err := s.Run(mDriver); err != nil {
+		if _, err := s.Run(context.Background(), mDriver); err != nil {
 			t.Fatal(err)
 		}
 		if err := mDriver.lookupsCalled(); err != nil {
@@ -214,7 +219,7 @@ func TestRunDriverOnTemplate(t *testing.T) {
 		}},
 		}
 
-		executedTemplate, err := s.Run(mDriver)
+		executedTemplate, err := s.Run(context.Background(), mDriver)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -278,7 +283,7 @@ func (r *mockDriver) Lookup(lookups ...string) (driver.DriverFn, error) {
 		if lookups[0] == expect.action && lookups[1] == expect.entity {
 			expect.lookupDone = true
 
-			return func(params map[string]interface{}) (interface{}, error) {
+			return func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 				if got, want := expect.expectedParams, params; !reflect.DeepEqual(got, want) {
 					return nil, fmt.Errorf("[%s %s] params mismatch: expected %v, got %v", expect.action, expect.entity, got, want)
 				}
@@ -287,10 +292,287 @@ func (r *mockDriver) Lookup(lookups ...string) (driver.DriverFn, error) {
 		}
 	}
 
-	return func(params map[string]interface{}) (interface{}, error) {
+	return func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 		return nil, errors.New("Unexpected lookup fallthrough")
 	}, nil
 }
 
 func (r *mockDriver) SetLogger(*logger.Logger) {}
 func (r *mockDriver) SetDryRun(bool)           {}
+
+func TestStatementsDependencies(t *testing.T) {
+	tcases := []struct {
+		tpl  string
+		deps [][]int
+	}{
+		{
+			tpl:  "create vpc cidr=10.0.0.0/16\ncreate vpc cidr=10.1.0.0/16",
+			deps: [][]int{nil, nil},
+		},
+		{
+			tpl:  "vpc = create vpc\nsub = create subnet vpc=$vpc\ncreate instance subnet=$sub\ncreate subnet vpc=$vpc",
+			deps: [][]int{nil, {0}, {1}, {0}},
+		},
+		{
+			tpl:  "create user name=bob\ncreate user name=alice\nattach policy user=bob arn=stuff",
+			deps: [][]int{nil, nil, {0}},
+		},
+		{
+			tpl:  "delete instance id=i-1\ncheck instance id=i-1 state=terminated\ndelete securitygroup id=sg-1\ndelete subnet id=sub-1",
+			deps: [][]int{nil, {0}, {1}, {1, 2}},
+		},
+		{
+			tpl:  "delete instance id=i-1\ncheck instance id=i-1 state=terminated\ndelete subnet id=sub-1\ndelete vpc id=vpc-1",
+			deps: [][]int{nil, {0}, {1}, {1, 2}},
+		},
+		{
+			tpl:  "delete subnet id=sub-1\ndelete subnet id=sub-2\ncreate vpc cidr=10.0.0.0/16\ndelete vpc id=vpc-1",
+			deps: [][]int{nil, {0}, nil, {1}},
+		},
+	}
+
+	for i, tcase := range tcases {
+		tpl := MustParse(tcase.tpl)
+		if got, want := statementsDependencies(tpl.Statements), tcase.deps; !reflect.DeepEqual(got, want) {
+			t.Fatalf("%d: got %v, want %v", i+1, got, want)
+		}
+	}
+}
+
+func TestRunConcurrently(t *testing.T) {
+	t.Run("independent statements run concurrently", func(t *testing.T) {
+		tpl := MustParse("create subnet cidr=10.0.1.0/24\ncreate subnet cidr=10.0.2.0/24\ncreate subnet cidr=10.0.3.0/24")
+		d := &concurrentDriver{release: make(chan struct{})}
+
+		go func() {
+			for d.waitInFlight(3) {
+				time.Sleep(time.Millisecond)
+			}
+			close(d.release)
+		}()
+
+		ran, err := tpl.RunConcurrently(context.Background(), d, 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := d.maxInFlight, 3; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		if got, want := ran.String(), tpl.String(); got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	})
+
+	t.Run("references are resolved in dependency order", func(t *testing.T) {
+		tpl := MustParse("vpc = create vpc cidr=10.0.0.0/16\nsub1 = create subnet vpc=$vpc cidr=10.0.1.0/24\nsub2 = create subnet vpc=$vpc cidr=10.0.2.0/24\ncreate instance subnet=$sub1\ncreate instance subnet=$sub2")
+		d := &concurrentDriver{release: make(chan struct{})}
+		close(d.release)
+
+		ran, err := tpl.RunConcurrently(context.Background(), d, 4)
+		if err != nil {
+			t.Fatal(err)
+		}
+		exp := []string{
+			"create vpc cidr=10.0.0.0/16",
+			"create subnet cidr=10.0.1.0/24 vpc=vpc-1",
+			"create subnet cidr=10.0.2.0/24 vpc=vpc-1",
+			"create instance subnet=subnet-10.0.1.0/24",
+			"create instance subnet=subnet-10.0.2.0/24",
+		}
+		for i, cmd := range ran.CommandNodesIterator() {
+			if got, want := cmd.String(), exp[i]; got != want {
+				t.Fatalf("%d: got %s, want %s", i+1, got, want)
+			}
+		}
+	})
+
+	t.Run("stop scheduling on failure", func(t *testing.T) {
+		tpl := MustParse("vpc = create vpc cidr=10.0.0.0/16\ncreate subnet vpc=$vpc\ncreate subnet vpc=$vpc")
+		anErr := errors.New("my error message")
+
+		ran, err := tpl.RunConcurrently(context.Background(), &errorDriver{anErr}, 4)
+		if got, want := err, anErr; got != want {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		if got, want := len(ran.Statements), 1; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+	})
+
+	t.Run("stop scheduling on cancellation", func(t *testing.T) {
+		tpl := MustParse("create subnet cidr=10.0.1.0/24\ncreate subnet cidr=10.0.2.0/24\ncreate subnet cidr=10.0.3.0/24")
+		ctx, cancel := context.WithCancel(context.Background())
+
+		ran, err := tpl.RunConcurrently(ctx, &cancellingDriver{cancel}, 1)
+		if got, want := err, context.Canceled; got != want {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		if got, want := len(ran.Statements), 1; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		if ran.HasErrors() {
+			t.Fatal("expected the statement running on cancellation to succeed")
+		}
+		if got, want := len(ran.Pending), 2; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		for _, sts := range ran.Pending {
+			if got, want := commandNode(sts).CmdErr, ErrCancelled; got != want {
+				t.Fatalf("got %v, want %v", got, want)
+			}
+		}
+	})
+}
+
+type cancellingDriver struct {
+	cancel func()
+}
+
+func (d *cancellingDriver) Lookup(lookups ...string) (driver.DriverFn, error) {
+	return func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+		d.cancel()
+		return "subnet-1", nil
+	}, nil
+}
+
+func (d *cancellingDriver) SetDryRun(bool)           {}
+func (d *cancellingDriver) SetLogger(*logger.Logger) {}
+
+type concurrentDriver struct {
+	release     chan struct{}
+	mu          sync.Mutex
+	inFlight    int
+	maxInFlight int
+}
+
+func (d *concurrentDriver) waitInFlight(n int) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.inFlight < n
+}
+
+func (d *concurrentDriver) Lookup(lookups ...string) (driver.DriverFn, error) {
+	return func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+		d.mu.Lock()
+		d.inFlight++
+		if d.inFlight > d.maxInFlight {
+			d.maxInFlight = d.inFlight
+		}
+		d.mu.Unlock()
+
+		<-d.release
+
+		d.mu.Lock()
+		d.inFlight--
+		d.mu.Unlock()
+
+		if cidr, ok := params["cidr"]; ok && lookups[1] == "subnet" {
+			return fmt.Sprintf("subnet-%s", cidr), nil
+		}
+		return lookups[1] + "-1", nil
+	}, nil
+}
+func (d *concurrentDriver) SetLogger(*logger.Logger) {}
+func (d *concurrentDriver) SetDryRun(bool)           {}
+
+type outputsDriver struct{}
+
+func (d *outputsDriver) Lookup(lookups ...string) (driver.DriverFn, error) {
+	return func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+		switch lookups[1] {
+		case "instance":
+			return &driver.Output{ID: "i-1234", Properties: map[string]interface{}{"PrivateIP": "10.0.0.12"}}, nil
+		case "accesskey":
+			return &driver.Output{ID: "AKIA1234", Sensitive: map[string]interface{}{"Secret": "s3cr3t"}}, nil
+		default:
+			return fmt.Sprintf("%s-1", lookups[1]), nil
+		}
+	}, nil
+}
+func (d *outputsDriver) SetLogger(*logger.Logger) {}
+func (d *outputsDriver) SetDryRun(bool)           {}
+
+func TestRunWithStructuredOutputs(t *testing.T) {
+	tpl := MustParse("inst = create instance\ncreate record value=$inst.PrivateIP name=\"web at ${inst.PrivateIP}\"")
+	if _, _, err := checkReferencesDeclaration(tpl, NewEnv()); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := statementsDependencies(tpl.Statements), [][]int{nil, {0}}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	ran, err := tpl.Run(context.Background(), &outputsDriver{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds := ran.CommandNodesIterator()
+	if got, want := cmds[0].CmdResult, "i-1234"; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := cmds[1].String(), `create record name="web at 10.0.0.12" value=10.0.0.12`; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	exp := map[string]interface{}{"inst": "i-1234", "inst.PrivateIP": "10.0.0.12"}
+	if got, want := ran.Outputs(), exp; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	if err := MustParse("inst = create instance\ncreate record value=$inst.PrivateIP").DryRun(&noopDriver{}); err != nil {
+		t.Fatalf("expected dry run to fake outputs, got %s", err)
+	}
+
+	_, err = MustParse("sub = create subnet\ncreate instance subnet=$sub.Unknown").Run(context.Background(), &outputsDriver{})
+	if err == nil || err.Error() != "create instance: no property 'Unknown' in outputs of 'sub'" {
+		t.Fatalf("expected unknown property error, got %v", err)
+	}
+
+	if _, _, err := checkReferencesDeclaration(MustParse("create instance subnet=$sub.Unknown"), NewEnv()); err == nil {
+		t.Fatal("expected undefined reference error")
+	}
+}
+
+func TestRunWithSensitiveOutputs(t *testing.T) {
+	tpl := MustParse("key = create accesskey user=bob\ncreate record value=$key.Secret name=\"key ${key.Secret}\" type=TXT")
+	var events [][]byte
+	tpl.Hooks = []Hook{func(ctx context.Context, e *Event) error {
+		b, err := json.Marshal(e)
+		events = append(events, b)
+		return err
+	}}
+
+	ran, err := tpl.Run(context.Background(), &outputsDriver{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds := ran.CommandNodesIterator()
+	if got, want := cmds[1].Params["value"], "s3cr3t"; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := ran.Outputs(), map[string]interface{}{"key": "AKIA1234"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	b, err := json.Marshal(ran)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), "s3cr3t") {
+		t.Fatalf("sensitive output stored: %s", b)
+	}
+	var stored Template
+	if err := json.Unmarshal(b, &stored); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := stored.CommandNodesIterator()[1].String(), `create record name="******" type=TXT value="******"`; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	if got, want := len(events), 6; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	for _, e := range events {
+		if strings.Contains(string(e), "s3cr3t") {
+			t.Fatalf("sensitive output sent to hooks: %s", e)
+		}
+	}
+}